@@ -34,8 +34,14 @@ import (
 	"github.com/custodia-labs/sercha-core/internal/adapters/driven/ai"
 	"github.com/custodia-labs/sercha-core/internal/adapters/driven/auth"
 	"github.com/custodia-labs/sercha-core/internal/adapters/driven/connectors"
+	"github.com/custodia-labs/sercha-core/internal/adapters/driven/connectors/confluence"
 	"github.com/custodia-labs/sercha-core/internal/adapters/driven/connectors/github"
+	"github.com/custodia-labs/sercha-core/internal/adapters/driven/connectors/gitlab"
+	"github.com/custodia-labs/sercha-core/internal/adapters/driven/connectors/jira"
 	"github.com/custodia-labs/sercha-core/internal/adapters/driven/connectors/localfs"
+	"github.com/custodia-labs/sercha-core/internal/adapters/driven/connectors/notion"
+	"github.com/custodia-labs/sercha-core/internal/adapters/driven/connectors/slack"
+	"github.com/custodia-labs/sercha-core/internal/adapters/driven/embedded"
 	pipelineexec "github.com/custodia-labs/sercha-core/internal/adapters/driven/pipeline/executor"
 	pipelinereg "github.com/custodia-labs/sercha-core/internal/adapters/driven/pipeline/registry"
 	indexingstages "github.com/custodia-labs/sercha-core/internal/adapters/driven/pipeline/stages/indexing"
@@ -51,6 +57,7 @@ import (
 	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
 	"github.com/custodia-labs/sercha-core/internal/core/ports/driving"
 	"github.com/custodia-labs/sercha-core/internal/core/services"
+	"github.com/custodia-labs/sercha-core/internal/extractors"
 	"github.com/custodia-labs/sercha-core/internal/normalisers"
 	"github.com/custodia-labs/sercha-core/internal/postprocessors"
 	"github.com/custodia-labs/sercha-core/internal/runtime"
@@ -71,10 +78,10 @@ func (r *redisPinger) Ping(ctx context.Context) error {
 
 // capabilityProvider wraps a service as a capability provider
 type capabilityProvider struct {
-	capType         pipeline.CapabilityType
-	id              string
-	instance        any
-	avail           func() bool
+	capType          pipeline.CapabilityType
+	id               string
+	instance         any
+	avail            func() bool
 	instanceResolver func() any // Optional: resolve instance dynamically
 }
 
@@ -112,6 +119,8 @@ func main() {
 	vespaConfigURL := getEnv("VESPA_CONFIG_URL", "http://localhost:19071")      // Config server (deployment)
 	vespaContainerURL := getEnv("VESPA_CONTAINER_URL", "http://localhost:8080") // Container cluster (document/search API)
 	baseURL := getEnv("BASE_URL", fmt.Sprintf("http://localhost:%d", port))
+	searchEngineKind := getEnv("SEARCH_ENGINE", "vespa")                // vespa, postgres or embedded
+	searchIndexDir := getEnv("SEARCH_INDEX_DIR", "./data/search-index") // Embedded engine only
 
 	// Single org
 	const teamID = "default"
@@ -170,15 +179,6 @@ func main() {
 		log.Println("Redis connected")
 	}
 
-	// ===== Initialize Vespa =====
-	log.Println("Connecting to Vespa...")
-	searchEngine := vespa.NewSearchEngine(vespa.DefaultConfig(vespaContainerURL))
-	if err := searchEngine.HealthCheck(ctx); err != nil {
-		log.Printf("Warning: Vespa health check failed: %v (search may not work)", err)
-	} else {
-		log.Println("Vespa connected")
-	}
-
 	// ===== Driven adapters (infrastructure) =====
 	authAdapter := auth.NewAdapter(jwtSecret)
 	aiFactory := ai.NewFactory()
@@ -189,10 +189,54 @@ func main() {
 	chunkStore := postgres.NewChunkStore(db)
 	sourceStore := postgres.NewSourceStore(db)
 	syncStore := postgres.NewSyncStateStore(db)
+	syncRunStore := postgres.NewSyncRunStore(db)
+	documentErrorStore := postgres.NewDocumentErrorStore(db)
+	dryRunStore := postgres.NewDryRunStore(db)
 	settingsStore := postgres.NewSettingsStore(db)
 	schedulerStore := postgres.NewSchedulerStore(db)
 	vespaConfigStore := postgres.NewVespaConfigStore(db)
 
+	// ===== Initialize Search Engine =====
+	// Vespa by default; "postgres" indexes into the main database (requires
+	// pgvector); "embedded" keeps the index in process for single-binary
+	// installs (API and worker must then run in one process)
+	var searchEngine driven.SearchEngine
+	switch searchEngineKind {
+	case "embedded":
+		log.Printf("Opening embedded search index at %s...", searchIndexDir)
+		if mode != "all" {
+			log.Printf("Warning: embedded search engine in %s mode; other processes will not see this index", mode)
+		}
+		embeddedEngine, err := embedded.NewSearchEngine(embedded.Config{
+			Dir:       searchIndexDir,
+			Documents: documentStore,
+		})
+		if err != nil {
+			log.Fatalf("Failed to open embedded search index: %v", err)
+		}
+		defer embeddedEngine.Close()
+		searchEngine = embeddedEngine
+		log.Println("Embedded search index opened")
+	case "postgres":
+		log.Println("Initializing PostgreSQL search index...")
+		postgresEngine := postgres.NewSearchEngine(db)
+		if err := postgresEngine.EnsureSchema(ctx); err != nil {
+			log.Fatalf("Failed to initialize PostgreSQL search index: %v", err)
+		}
+		searchEngine = postgresEngine
+		log.Println("PostgreSQL search index initialized")
+	case "vespa":
+		log.Println("Connecting to Vespa...")
+		searchEngine = vespa.NewSearchEngine(vespa.DefaultConfig(vespaContainerURL))
+		if err := searchEngine.HealthCheck(ctx); err != nil {
+			log.Printf("Warning: Vespa health check failed: %v (search may not work)", err)
+		} else {
+			log.Println("Vespa connected")
+		}
+	default:
+		log.Fatalf("Unknown SEARCH_ENGINE %q (expected vespa, postgres or embedded)", searchEngineKind)
+	}
+
 	// ===== Vespa Deployer =====
 	vespaDeployer := vespa.NewDeployer()
 
@@ -263,6 +307,75 @@ func main() {
 		return github.NewOAuthHandler().RefreshToken(ctx, cfg.Secrets.ClientID, cfg.Secrets.ClientSecret, refreshToken)
 	})
 
+	// GitLab.com by default; GITLAB_BASE_URL points at a self-managed instance
+	gitlabConfig := gitlab.DefaultConfig()
+	gitlabConfig.BaseURL = getEnv("GITLAB_BASE_URL", gitlab.DefaultBaseURL)
+	gitlabOAuthHandler := gitlab.NewOAuthHandler(gitlabConfig.BaseURL)
+	tokenProviderFactory.RegisterRefresher(domain.ProviderTypeGitLab, func(ctx context.Context, refreshToken string) (*driven.OAuthToken, error) {
+		cfg, err := providerConfigStore.Get(ctx, domain.ProviderTypeGitLab)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get gitlab provider config: %w", err)
+		}
+		if cfg == nil || cfg.Secrets == nil || cfg.Secrets.ClientID == "" {
+			return nil, fmt.Errorf("gitlab provider not configured - use POST /api/v1/providers/gitlab/config")
+		}
+		return gitlabOAuthHandler.RefreshToken(ctx, cfg.Secrets.ClientID, cfg.Secrets.ClientSecret, refreshToken)
+	})
+
+	// Slack bot tokens only need refreshing when token rotation is enabled
+	tokenProviderFactory.RegisterRefresher(domain.ProviderTypeSlack, func(ctx context.Context, refreshToken string) (*driven.OAuthToken, error) {
+		cfg, err := providerConfigStore.Get(ctx, domain.ProviderTypeSlack)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get slack provider config: %w", err)
+		}
+		if cfg == nil || cfg.Secrets == nil || cfg.Secrets.ClientID == "" {
+			return nil, fmt.Errorf("slack provider not configured - use POST /api/v1/providers/slack/config")
+		}
+		return slack.NewOAuthHandler().RefreshToken(ctx, cfg.Secrets.ClientID, cfg.Secrets.ClientSecret, refreshToken)
+	})
+
+	// CONFLUENCE_BASE_URL selects the Confluence site, e.g.
+	// https://acme.atlassian.net/wiki; it is required for API tokens
+	confluenceConfig := confluence.DefaultConfig()
+	confluenceConfig.BaseURL = getEnv("CONFLUENCE_BASE_URL", "")
+	tokenProviderFactory.RegisterRefresher(domain.ProviderTypeConfluence, func(ctx context.Context, refreshToken string) (*driven.OAuthToken, error) {
+		cfg, err := providerConfigStore.Get(ctx, domain.ProviderTypeConfluence)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get confluence provider config: %w", err)
+		}
+		if cfg == nil || cfg.Secrets == nil || cfg.Secrets.ClientID == "" {
+			return nil, fmt.Errorf("confluence provider not configured - use POST /api/v1/providers/confluence/config")
+		}
+		return confluence.NewOAuthHandler().RefreshToken(ctx, cfg.Secrets.ClientID, cfg.Secrets.ClientSecret, refreshToken)
+	})
+
+	// JIRA_BASE_URL selects the Jira site, e.g. https://acme.atlassian.net;
+	// it is required for API tokens
+	jiraConfig := jira.DefaultConfig()
+	jiraConfig.BaseURL = getEnv("JIRA_BASE_URL", "")
+	tokenProviderFactory.RegisterRefresher(domain.ProviderTypeJira, func(ctx context.Context, refreshToken string) (*driven.OAuthToken, error) {
+		cfg, err := providerConfigStore.Get(ctx, domain.ProviderTypeJira)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get jira provider config: %w", err)
+		}
+		if cfg == nil || cfg.Secrets == nil || cfg.Secrets.ClientID == "" {
+			return nil, fmt.Errorf("jira provider not configured - use POST /api/v1/providers/jira/config")
+		}
+		return jira.NewOAuthHandler().RefreshToken(ctx, cfg.Secrets.ClientID, cfg.Secrets.ClientSecret, refreshToken)
+	})
+
+	// Notion access tokens only need refreshing when they expire
+	tokenProviderFactory.RegisterRefresher(domain.ProviderTypeNotion, func(ctx context.Context, refreshToken string) (*driven.OAuthToken, error) {
+		cfg, err := providerConfigStore.Get(ctx, domain.ProviderTypeNotion)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get notion provider config: %w", err)
+		}
+		if cfg == nil || cfg.Secrets == nil || cfg.Secrets.ClientID == "" {
+			return nil, fmt.Errorf("notion provider not configured - use POST /api/v1/providers/notion/config")
+		}
+		return notion.NewOAuthHandler().RefreshToken(ctx, cfg.Secrets.ClientID, cfg.Secrets.ClientSecret, refreshToken)
+	})
+
 	// Create connector factory
 	factory := connectors.NewFactory(tokenProviderFactory)
 
@@ -270,6 +383,26 @@ func main() {
 	factory.Register(github.NewBuilder())
 	factory.RegisterOAuthHandler(domain.ProviderTypeGitHub, github.NewOAuthHandler())
 
+	// Register GitLab connector
+	factory.Register(gitlab.NewBuilderWithConfig(gitlabConfig))
+	factory.RegisterOAuthHandler(domain.ProviderTypeGitLab, gitlabOAuthHandler)
+
+	// Register Slack connector
+	factory.Register(slack.NewBuilder())
+	factory.RegisterOAuthHandler(domain.ProviderTypeSlack, slack.NewOAuthHandler())
+
+	// Register Confluence connector
+	factory.Register(confluence.NewBuilderWithConfig(confluenceConfig))
+	factory.RegisterOAuthHandler(domain.ProviderTypeConfluence, confluence.NewOAuthHandler())
+
+	// Register Jira connector
+	factory.Register(jira.NewBuilderWithConfig(jiraConfig))
+	factory.RegisterOAuthHandler(domain.ProviderTypeJira, jira.NewOAuthHandler())
+
+	// Register Notion connector
+	factory.Register(notion.NewBuilder())
+	factory.RegisterOAuthHandler(domain.ProviderTypeNotion, notion.NewOAuthHandler())
+
 	// Register LocalFS connector (for testing/development)
 	localfsAllowedRoots := []string{"/data", "/tmp"}
 	if envRoots := getEnv("LOCALFS_ALLOWED_ROOTS", ""); envRoots != "" {
@@ -286,6 +419,21 @@ func main() {
 	// Register GitHub container lister factory
 	containerListerFactory.Register(domain.ProviderTypeGitHub,
 		github.NewContainerListerFactory(installationStore, tokenProviderFactory, ""))
+	// Register GitLab container lister factory
+	containerListerFactory.Register(domain.ProviderTypeGitLab,
+		gitlab.NewContainerListerFactory(installationStore, tokenProviderFactory, gitlabConfig.BaseURL))
+	// Register Slack container lister factory
+	containerListerFactory.Register(domain.ProviderTypeSlack,
+		slack.NewContainerListerFactory(installationStore, tokenProviderFactory))
+	// Register Confluence container lister factory
+	containerListerFactory.Register(domain.ProviderTypeConfluence,
+		confluence.NewContainerListerFactory(installationStore, tokenProviderFactory, confluenceConfig.BaseURL))
+	// Register Jira container lister factory
+	containerListerFactory.Register(domain.ProviderTypeJira,
+		jira.NewContainerListerFactory(installationStore, tokenProviderFactory, jiraConfig.BaseURL))
+	// Register Notion container lister factory
+	containerListerFactory.Register(domain.ProviderTypeNotion,
+		notion.NewContainerListerFactory(installationStore, tokenProviderFactory))
 
 	// Register LocalFS container lister factory
 	containerListerFactory.Register(domain.ProviderTypeLocalFS,
@@ -301,6 +449,7 @@ func main() {
 
 	// Initialize registries (shared across all modes)
 	normaliserRegistry := normalisers.DefaultRegistry()
+	contentExtractor := extractors.DefaultRegistry()
 	postProcessorPipeline := postprocessors.DefaultPipeline()
 
 	// ===== Pipeline Infrastructure =====
@@ -326,6 +475,9 @@ func main() {
 	if err := stageRegistry.Register(searchstages.NewQueryParserFactory()); err != nil {
 		log.Fatalf("Failed to register query-parser stage: %v", err)
 	}
+	if err := stageRegistry.Register(searchstages.NewQueryExpanderFactory()); err != nil {
+		log.Fatalf("Failed to register query-expander stage: %v", err)
+	}
 	if err := stageRegistry.Register(searchstages.NewBM25RetrieverFactory()); err != nil {
 		log.Fatalf("Failed to register bm25-retriever stage: %v", err)
 	}
@@ -343,10 +495,10 @@ func main() {
 	}
 
 	// Register capability providers
-	// Vector store (Vespa) - always available
+	// Vector store (Vespa, PostgreSQL or embedded) - always available
 	if err := capabilityRegistry.Register(&capabilityProvider{
 		capType:  pipeline.CapabilityVectorStore,
-		id:       "vespa",
+		id:       searchEngineKind,
 		instance: searchEngine,
 		avail:    func() bool { return true },
 	}); err != nil {
@@ -368,6 +520,21 @@ func main() {
 		log.Fatalf("Failed to register embedder capability: %v", err)
 	}
 
+	// LLM - dynamically available via runtimeServices
+	// Optional for search: the query expander passes through when absent
+	if err := capabilityRegistry.Register(&capabilityProvider{
+		capType: pipeline.CapabilityLLM,
+		id:      "default",
+		instanceResolver: func() any {
+			return runtimeServices.LLMService()
+		},
+		avail: func() bool {
+			return runtimeServices.LLMService() != nil
+		},
+	}); err != nil {
+		log.Fatalf("Failed to register LLM capability: %v", err)
+	}
+
 	// Register default indexing pipeline
 	indexingPipeline := pipeline.PipelineDefinition{
 		ID:   "default-indexing",
@@ -386,13 +553,15 @@ func main() {
 		log.Fatalf("Failed to set default indexing pipeline: %v", err)
 	}
 
-	// Register default search pipeline (BM25-only, no embedding required)
+	// Register default search pipeline (BM25, no embedding required)
+	// The query expander uses the LLM when configured and passes through otherwise
 	searchPipelineBM25 := pipeline.PipelineDefinition{
 		ID:   "default-search-bm25",
 		Name: "Default Search Pipeline (BM25)",
 		Type: pipeline.PipelineTypeSearch,
 		Stages: []pipeline.StageConfig{
 			{StageID: "query-parser", Enabled: true},
+			{StageID: "query-expander", Enabled: true, Parameters: map[string]any{"max_terms": 5, "weight": 0.5}},
 			{StageID: "bm25-retriever", Enabled: true, Parameters: map[string]any{"top_k": 100}},
 			{StageID: "ranker", Enabled: true, Parameters: map[string]any{"limit": 20}},
 			{StageID: "presenter", Enabled: true, Parameters: map[string]any{"snippet_length": 200}},
@@ -418,6 +587,7 @@ func main() {
 	sourceService := services.NewSourceService(sourceStore, documentStore, syncStore, searchEngine)
 	documentService := services.NewDocumentService(documentStore, chunkStore)
 	searchService := services.NewSearchService(searchEngine, documentStore, runtimeServices, searchExecutor, nil)
+	answerService := services.NewAnswerService(searchService, chunkStore, runtimeServices)
 	settingsService := services.NewSettingsService(settingsStore, aiFactory, runtimeServices, teamID)
 	vespaAdminService := services.NewVespaAdminService(vespaDeployer, vespaConfigStore, settingsStore, searchEngine, runtimeServices, teamID, vespaConfigURL)
 
@@ -457,14 +627,24 @@ func main() {
 		DocumentStore:    documentStore,
 		ChunkStore:       chunkStore,
 		SyncStore:        syncStore,
+		SyncRunStore:     syncRunStore,
+		DocumentErrors:   documentErrorStore,
+		DryRuns:          dryRunStore,
 		SearchEngine:     searchEngine,
 		ConnectorFactory: connectorFactory,
 		NormaliserReg:    normaliserRegistry,
+		ContentExtractor: contentExtractor,
 		LegacyPipeline:   postProcessorPipeline,
 		Services:         runtimeServices,
 		Logger:           slog.Default(),
 		IndexingExecutor: indexingExecutor,
 		CapabilitySet:    nil, // Built per-execution by executor
+		SyncRunRetention: getEnvInt("SYNC_RUN_RETENTION", 100),
+		Lock:             distributedLock, // One sync per source across workers
+
+		// Parallelism within a source sync
+		ContainerConcurrency: getEnvInt("SYNC_CONTAINER_CONCURRENCY", 4),
+		DocumentConcurrency:  getEnvInt("SYNC_DOCUMENT_CONCURRENCY", 4),
 	})
 
 	// Create scheduler for worker mode (if enabled)
@@ -479,6 +659,10 @@ func main() {
 			Lock:         distributedLock,
 			Logger:       slog.Default(),
 			LockRequired: schedulerLockRequired,
+			Sources:      sourceStore,
+			SyncStates:   syncStore,
+			Settings:     settingsStore,
+			TeamID:       teamID,
 		})
 		log.Printf("Scheduler enabled (lock_required=%t)", schedulerLockRequired)
 	} else {
@@ -492,7 +676,7 @@ func main() {
 		if redisClient != nil {
 			redisPing = &redisPinger{client: redisClient}
 		}
-		runAPI(port, authService, userService, searchService, sourceService, documentService, settingsService, vespaAdminService, providerService, oauthService, installationService, syncOrchestrator, taskQueue, db, redisPing)
+		runAPI(port, authService, userService, searchService, answerService, sourceService, documentService, settingsService, vespaAdminService, providerService, oauthService, installationService, syncOrchestrator, taskQueue, db, redisPing)
 
 	case "worker":
 		// Worker-only mode: Task processing, scheduler, no HTTP server
@@ -507,7 +691,7 @@ func main() {
 		if redisClient != nil {
 			redisPing = &redisPinger{client: redisClient}
 		}
-		runAPI(port, authService, userService, searchService, sourceService, documentService, settingsService, vespaAdminService, providerService, oauthService, installationService, syncOrchestrator, taskQueue, db, redisPing)
+		runAPI(port, authService, userService, searchService, answerService, sourceService, documentService, settingsService, vespaAdminService, providerService, oauthService, installationService, syncOrchestrator, taskQueue, db, redisPing)
 
 	default:
 		log.Fatalf("Unknown mode: %s (use: api, worker, or all)", mode)
@@ -519,6 +703,7 @@ func runAPI(
 	authService driving.AuthService,
 	userService driving.UserService,
 	searchService driving.SearchService,
+	answerService driving.AnswerService,
 	sourceService driving.SourceService,
 	documentService driving.DocumentService,
 	settingsService driving.SettingsService,
@@ -542,6 +727,7 @@ func runAPI(
 		authService,
 		userService,
 		searchService,
+		answerService,
 		sourceService,
 		documentService,
 		settingsService,
@@ -590,6 +776,11 @@ func runWorkerMode(
 	log.Println("Worker handles:")
 	log.Println("  - sync_source: Sync a specific source")
 	log.Println("  - sync_all: Sync all enabled sources")
+	log.Println("  - retry_failed_documents: Refetch the failed documents of a source")
+	log.Println("  - dry_run_sync: Report what a sync of a source would index")
+	if scheduler != nil {
+		log.Println("Scheduled sync_source tasks follow each source's sync_schedule (or the team sync interval)")
+	}
 
 	// Wait for context cancellation
 	<-ctx.Done()
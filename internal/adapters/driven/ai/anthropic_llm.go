@@ -0,0 +1,213 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Ensure AnthropicLLM implements LLMService
+var _ driven.LLMService = (*AnthropicLLM)(nil)
+
+// anthropicAPIVersion is the Messages API version sent with every request
+const anthropicAPIVersion = "2023-06-01"
+
+// AnthropicLLM implements LLMService using Anthropic's Messages API
+type AnthropicLLM struct {
+	promptedLLM
+	apiKey  string
+	baseURL string
+	client  *http.Client
+}
+
+// NewAnthropicLLM creates a new Anthropic LLM service
+func NewAnthropicLLM(apiKey, model, baseURL string) (driven.LLMService, error) {
+	if apiKey == "" {
+		return nil, fmt.Errorf("Anthropic API key is required")
+	}
+
+	if model == "" {
+		model = "claude-3-5-haiku-latest"
+	}
+
+	if baseURL == "" {
+		baseURL = "https://api.anthropic.com/v1"
+	}
+
+	l := &AnthropicLLM{
+		apiKey:  apiKey,
+		baseURL: strings.TrimRight(baseURL, "/"),
+		client: &http.Client{
+			Timeout: llmRequestTimeout + 10*time.Second,
+		},
+	}
+	l.promptedLLM = promptedLLM{model: model, complete: l.complete, stream: l.streamComplete}
+
+	return l, nil
+}
+
+// anthropicMessagesRequest is the request body for Anthropic's Messages API
+type anthropicMessagesRequest struct {
+	Model       string        `json:"model"`
+	System      string        `json:"system,omitempty"`
+	Messages    []chatMessage `json:"messages"`
+	MaxTokens   int           `json:"max_tokens"`
+	Temperature float64       `json:"temperature"`
+	Stream      bool          `json:"stream,omitempty"`
+}
+
+// anthropicMessagesResponse is the response from Anthropic's Messages API
+type anthropicMessagesResponse struct {
+	Content []struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	} `json:"content"`
+	StopReason string `json:"stop_reason"`
+	Error      *struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+// anthropicStreamEvent is a single server-sent event from a streaming message.
+// Only text deltas, the stop event and errors are used.
+type anthropicStreamEvent struct {
+	Type  string `json:"type"`
+	Delta struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	} `json:"delta"`
+	Error *struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+// Close releases resources held by the LLM service
+func (l *AnthropicLLM) Close() error {
+	l.client.CloseIdleConnections()
+	return nil
+}
+
+// messagesRequest builds the Messages API request body
+func (l *AnthropicLLM) messagesRequest(req completionRequest, stream bool) ([]byte, error) {
+	body, err := json.Marshal(anthropicMessagesRequest{
+		Model:       l.model,
+		System:      req.System,
+		Messages:    []chatMessage{{Role: "user", Content: req.User}},
+		MaxTokens:   req.MaxTokens,
+		Temperature: 0,
+		Stream:      stream,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+	return body, nil
+}
+
+// headers returns the authentication and versioning headers
+func (l *AnthropicLLM) headers() map[string]string {
+	return map[string]string{
+		"x-api-key":         l.apiKey,
+		"anthropic-version": anthropicAPIVersion,
+	}
+}
+
+// complete sends a message request to Anthropic
+func (l *AnthropicLLM) complete(ctx context.Context, req completionRequest) (string, error) {
+	body, err := l.messagesRequest(req, false)
+	if err != nil {
+		return "", err
+	}
+
+	status, respBody, err := postJSONWithRetry(ctx, l.client, l.baseURL+"/messages", l.headers(), body)
+	if err != nil {
+		return "", err
+	}
+
+	var msgResp anthropicMessagesResponse
+	if err := json.Unmarshal(respBody, &msgResp); err != nil {
+		return "", fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if msgResp.Error != nil {
+		return "", fmt.Errorf("Anthropic API error: %s (type: %s)", msgResp.Error.Message, msgResp.Error.Type)
+	}
+
+	if status != http.StatusOK {
+		return "", fmt.Errorf("Anthropic API returned status %d", status)
+	}
+
+	var text strings.Builder
+	for _, block := range msgResp.Content {
+		if block.Type == "text" {
+			text.WriteString(block.Text)
+		}
+	}
+
+	return text.String(), nil
+}
+
+// streamComplete sends a streaming message request to Anthropic.
+// Text arrives in content_block_delta events and the stream ends with message_stop.
+func (l *AnthropicLLM) streamComplete(ctx context.Context, req completionRequest, onToken func(string) error) (string, error) {
+	body, err := l.messagesRequest(req, true)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := openJSONWithRetry(ctx, l.client, l.baseURL+"/messages", l.headers(), body)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var errResp anthropicMessagesResponse
+		if json.Unmarshal(readErrorBody(resp.Body), &errResp) == nil && errResp.Error != nil {
+			return "", fmt.Errorf("Anthropic API error: %s (type: %s)", errResp.Error.Message, errResp.Error.Type)
+		}
+		return "", fmt.Errorf("Anthropic API returned status %d", resp.StatusCode)
+	}
+
+	var text strings.Builder
+	err = scanStreamLines(resp.Body, func(line string) (bool, error) {
+		data, ok := sseData(line)
+		if !ok {
+			return false, nil
+		}
+
+		var event anthropicStreamEvent
+		if err := json.Unmarshal([]byte(data), &event); err != nil {
+			return false, fmt.Errorf("failed to parse stream event: %w", err)
+		}
+
+		switch event.Type {
+		case "error":
+			if event.Error != nil {
+				return false, fmt.Errorf("Anthropic API error: %s (type: %s)", event.Error.Message, event.Error.Type)
+			}
+			return false, fmt.Errorf("Anthropic API stream error")
+		case "message_stop":
+			return true, nil
+		case "content_block_delta":
+			if event.Delta.Type != "text_delta" || event.Delta.Text == "" {
+				return false, nil
+			}
+			text.WriteString(event.Delta.Text)
+			return false, onToken(event.Delta.Text)
+		default:
+			return false, nil
+		}
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return text.String(), nil
+}
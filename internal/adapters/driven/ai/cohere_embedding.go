@@ -0,0 +1,199 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Ensure CohereEmbedding implements EmbeddingService
+var _ driven.EmbeddingService = (*CohereEmbedding)(nil)
+
+// cohereEmbedBatchSize is the maximum number of texts Cohere accepts per embed call
+const cohereEmbedBatchSize = 96
+
+// Cohere input types. Documents and queries are embedded into the same space
+// but with different prefixes, so using the wrong one degrades retrieval.
+const (
+	cohereInputTypeDocument = "search_document"
+	cohereInputTypeQuery    = "search_query"
+)
+
+// Model dimensions for Cohere embedding models
+var cohereModelDimensions = map[string]int{
+	"embed-v4.0":                    1536,
+	"embed-english-v3.0":            1024,
+	"embed-multilingual-v3.0":       1024,
+	"embed-english-light-v3.0":      384,
+	"embed-multilingual-light-v3.0": 384,
+	"embed-english-v2.0":            4096,
+	"embed-english-light-v2.0":      1024,
+	"embed-multilingual-v2.0":       768,
+}
+
+// CohereEmbedding implements EmbeddingService using Cohere's v2 embed API
+type CohereEmbedding struct {
+	apiKey     string
+	model      string
+	baseURL    string
+	dimensions int
+	client     *http.Client
+}
+
+// NewCohereEmbedding creates a new Cohere embedding service
+func NewCohereEmbedding(apiKey, model, baseURL string) (driven.EmbeddingService, error) {
+	if apiKey == "" {
+		return nil, fmt.Errorf("Cohere API key is required")
+	}
+
+	if model == "" {
+		model = "embed-english-v3.0"
+	}
+
+	if baseURL == "" {
+		baseURL = "https://api.cohere.com/v2"
+	}
+	baseURL = strings.TrimRight(baseURL, "/")
+
+	dimensions, ok := cohereModelDimensions[model]
+	if !ok {
+		// Default to the v3 size for unknown models
+		dimensions = 1024
+	}
+
+	return &CohereEmbedding{
+		apiKey:     apiKey,
+		model:      model,
+		baseURL:    baseURL,
+		dimensions: dimensions,
+		client: &http.Client{
+			Timeout: 60 * time.Second,
+		},
+	}, nil
+}
+
+// cohereEmbedRequest is the request body for Cohere's embed API
+type cohereEmbedRequest struct {
+	Model          string   `json:"model"`
+	Texts          []string `json:"texts"`
+	InputType      string   `json:"input_type"`
+	EmbeddingTypes []string `json:"embedding_types"`
+	Truncate       string   `json:"truncate,omitempty"`
+}
+
+// cohereEmbedResponse is the response from Cohere's embed API
+type cohereEmbedResponse struct {
+	ID         string `json:"id"`
+	Embeddings struct {
+		Float [][]float32 `json:"float"`
+	} `json:"embeddings"`
+	Message string `json:"message,omitempty"`
+}
+
+// Embed generates document embeddings for multiple texts
+func (e *CohereEmbedding) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	return e.embed(ctx, texts, cohereInputTypeDocument)
+}
+
+// EmbedQuery generates an embedding for a search query
+func (e *CohereEmbedding) EmbedQuery(ctx context.Context, query string) ([]float32, error) {
+	embeddings, err := e.embed(ctx, []string{query}, cohereInputTypeQuery)
+	if err != nil {
+		return nil, err
+	}
+	if len(embeddings) == 0 {
+		return nil, fmt.Errorf("no embedding returned for query")
+	}
+	return embeddings[0], nil
+}
+
+// embed sends texts in batches with the given input type
+func (e *CohereEmbedding) embed(ctx context.Context, texts []string, inputType string) ([][]float32, error) {
+	if len(texts) == 0 {
+		return nil, nil
+	}
+
+	embeddings := make([][]float32, 0, len(texts))
+	for start := 0; start < len(texts); start += cohereEmbedBatchSize {
+		end := start + cohereEmbedBatchSize
+		if end > len(texts) {
+			end = len(texts)
+		}
+
+		resp, err := e.doRequest(ctx, cohereEmbedRequest{
+			Model:          e.model,
+			Texts:          texts[start:end],
+			InputType:      inputType,
+			EmbeddingTypes: []string{"float"},
+			Truncate:       "END",
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(resp.Embeddings.Float) != end-start {
+			return nil, fmt.Errorf("Cohere returned %d embeddings for %d inputs", len(resp.Embeddings.Float), end-start)
+		}
+
+		embeddings = append(embeddings, resp.Embeddings.Float...)
+	}
+
+	return embeddings, nil
+}
+
+// Dimensions returns the embedding dimension size
+func (e *CohereEmbedding) Dimensions() int {
+	return e.dimensions
+}
+
+// Model returns the model name being used
+func (e *CohereEmbedding) Model() string {
+	return e.model
+}
+
+// HealthCheck verifies the embedding service is available
+func (e *CohereEmbedding) HealthCheck(ctx context.Context) error {
+	_, err := e.EmbedQuery(ctx, "health check")
+	return err
+}
+
+// Close releases resources held by the embedding service
+func (e *CohereEmbedding) Close() error {
+	e.client.CloseIdleConnections()
+	return nil
+}
+
+// doRequest makes a request to the Cohere embed API, retrying on rate limits
+func (e *CohereEmbedding) doRequest(ctx context.Context, reqBody cohereEmbedRequest) (*cohereEmbedResponse, error) {
+	body, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	status, respBody, err := postJSONWithRetry(ctx, e.client, e.baseURL+"/embed", map[string]string{
+		"Authorization": "Bearer " + e.apiKey,
+		"Accept":        "application/json",
+	}, body)
+	if err != nil {
+		return nil, err
+	}
+
+	var embResp cohereEmbedResponse
+	if err := json.Unmarshal(respBody, &embResp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if status != http.StatusOK {
+		if embResp.Message != "" {
+			return nil, fmt.Errorf("Cohere API error (status %d): %s", status, embResp.Message)
+		}
+		return nil, fmt.Errorf("Cohere API returned status %d", status)
+	}
+
+	return &embResp, nil
+}
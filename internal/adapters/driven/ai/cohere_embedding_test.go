@@ -0,0 +1,174 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// cohereTestServer returns a server that answers with one vector per input and
+// records the input type of every request it receives
+func cohereTestServer(t *testing.T, inputTypes *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/embed" {
+			t.Errorf("expected /embed, got %s", r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer co-test" {
+			t.Error("expected Authorization header")
+		}
+
+		var req cohereEmbedRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+		if len(req.Texts) > cohereEmbedBatchSize {
+			t.Errorf("batch of %d exceeds limit %d", len(req.Texts), cohereEmbedBatchSize)
+		}
+		*inputTypes = append(*inputTypes, req.InputType)
+
+		var resp cohereEmbedResponse
+		for i := range req.Texts {
+			resp.Embeddings.Float = append(resp.Embeddings.Float, []float32{float32(i), 0.5})
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+}
+
+func TestNewCohereEmbedding_RequiresAPIKey(t *testing.T) {
+	_, err := NewCohereEmbedding("", "embed-english-v3.0", "")
+	if err == nil {
+		t.Error("expected error for empty API key")
+	}
+}
+
+func TestNewCohereEmbedding_Defaults(t *testing.T) {
+	svc, err := NewCohereEmbedding("co-test", "", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	emb := svc.(*CohereEmbedding)
+	if emb.model != "embed-english-v3.0" {
+		t.Errorf("expected default model embed-english-v3.0, got %s", emb.model)
+	}
+	if emb.baseURL != "https://api.cohere.com/v2" {
+		t.Errorf("expected default base URL, got %s", emb.baseURL)
+	}
+}
+
+func TestCohereEmbedding_Dimensions(t *testing.T) {
+	for model, dim := range cohereModelDimensions {
+		t.Run(model, func(t *testing.T) {
+			svc, err := NewCohereEmbedding("co-test", model, "")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if svc.Dimensions() != dim {
+				t.Errorf("expected dimensions %d, got %d", dim, svc.Dimensions())
+			}
+		})
+	}
+
+	svc, _ := NewCohereEmbedding("co-test", "unknown-model", "")
+	if svc.Dimensions() != 1024 {
+		t.Errorf("expected unknown model to default to 1024, got %d", svc.Dimensions())
+	}
+}
+
+func TestCohereEmbedding_InputTypes(t *testing.T) {
+	var inputTypes []string
+	server := cohereTestServer(t, &inputTypes)
+	defer server.Close()
+
+	svc, err := NewCohereEmbedding("co-test", "embed-english-v3.0", server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := svc.Embed(context.Background(), []string{"doc"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := svc.EmbedQuery(context.Background(), "query"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(inputTypes) != 2 || inputTypes[0] != "search_document" || inputTypes[1] != "search_query" {
+		t.Errorf("expected [search_document search_query], got %v", inputTypes)
+	}
+}
+
+func TestCohereEmbedding_Embed_Batching(t *testing.T) {
+	var inputTypes []string
+	server := cohereTestServer(t, &inputTypes)
+	defer server.Close()
+
+	svc, err := NewCohereEmbedding("co-test", "embed-english-v3.0", server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	texts := make([]string, cohereEmbedBatchSize+10)
+	result, err := svc.Embed(context.Background(), texts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(inputTypes) != 2 {
+		t.Errorf("expected 2 requests, got %d", len(inputTypes))
+	}
+	if len(result) != len(texts) {
+		t.Errorf("expected %d embeddings, got %d", len(texts), len(result))
+	}
+}
+
+func TestCohereEmbedding_Embed_RetriesOnRateLimit(t *testing.T) {
+	defer func(d time.Duration) { rateLimitBaseDelay = d }(rateLimitBaseDelay)
+	rateLimitBaseDelay = time.Millisecond
+
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"message": "rate limited"}`))
+			return
+		}
+		var resp cohereEmbedResponse
+		resp.Embeddings.Float = [][]float32{{0.1}}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	svc, err := NewCohereEmbedding("co-test", "embed-english-v3.0", server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := svc.EmbedQuery(context.Background(), "query"); err != nil {
+		t.Fatalf("expected retry to succeed, got %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 calls, got %d", calls)
+	}
+}
+
+func TestCohereEmbedding_Embed_APIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"message": "invalid api token"}`))
+	}))
+	defer server.Close()
+
+	svc, err := NewCohereEmbedding("co-invalid", "embed-english-v3.0", server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = svc.Embed(context.Background(), []string{"test"})
+	if err == nil {
+		t.Error("expected error for API error response")
+	}
+}
@@ -30,9 +30,9 @@ func (f *Factory) CreateEmbeddingService(settings *domain.EmbeddingSettings) (dr
 	case domain.AIProviderOllama:
 		return NewOllamaEmbedding(settings.BaseURL, settings.Model)
 	case domain.AIProviderVoyage:
-		return NewVoyageEmbedding(settings.APIKey, settings.Model)
+		return NewVoyageEmbedding(settings.APIKey, settings.Model, settings.BaseURL)
 	case domain.AIProviderCohere:
-		return NewCohereEmbedding(settings.APIKey, settings.Model)
+		return NewCohereEmbedding(settings.APIKey, settings.Model, settings.BaseURL)
 	default:
 		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidProvider, settings.Provider)
 	}
@@ -48,43 +48,10 @@ func (f *Factory) CreateLLMService(settings *domain.LLMSettings) (driven.LLMServ
 	case domain.AIProviderOpenAI:
 		return NewOpenAILLM(settings.APIKey, settings.Model, settings.BaseURL)
 	case domain.AIProviderAnthropic:
-		return NewAnthropicLLM(settings.APIKey, settings.Model)
+		return NewAnthropicLLM(settings.APIKey, settings.Model, settings.BaseURL)
 	case domain.AIProviderOllama:
 		return NewOllamaLLM(settings.BaseURL, settings.Model)
 	default:
 		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidProvider, settings.Provider)
 	}
 }
-
-// Placeholder constructors - these will be replaced with actual implementations
-// Note: NewOpenAIEmbedding is implemented in openai_embedding.go
-
-func NewOllamaEmbedding(baseURL, model string) (driven.EmbeddingService, error) {
-	// TODO: Implement Ollama embedding adapter
-	return nil, fmt.Errorf("Ollama embedding adapter not yet implemented")
-}
-
-func NewVoyageEmbedding(apiKey, model string) (driven.EmbeddingService, error) {
-	// TODO: Implement Voyage embedding adapter
-	return nil, fmt.Errorf("Voyage embedding adapter not yet implemented")
-}
-
-func NewCohereEmbedding(apiKey, model string) (driven.EmbeddingService, error) {
-	// TODO: Implement Cohere embedding adapter
-	return nil, fmt.Errorf("Cohere embedding adapter not yet implemented")
-}
-
-func NewOpenAILLM(apiKey, model, baseURL string) (driven.LLMService, error) {
-	// TODO: Implement OpenAI LLM adapter
-	return nil, fmt.Errorf("OpenAI LLM adapter not yet implemented")
-}
-
-func NewAnthropicLLM(apiKey, model string) (driven.LLMService, error) {
-	// TODO: Implement Anthropic LLM adapter
-	return nil, fmt.Errorf("Anthropic LLM adapter not yet implemented")
-}
-
-func NewOllamaLLM(baseURL, model string) (driven.LLMService, error) {
-	// TODO: Implement Ollama LLM adapter
-	return nil, fmt.Errorf("Ollama LLM adapter not yet implemented")
-}
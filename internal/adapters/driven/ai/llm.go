@@ -0,0 +1,278 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// LLM request limits shared by all providers
+const (
+	// llmRequestTimeout bounds a single completion call
+	llmRequestTimeout = 60 * time.Second
+
+	// llmMaxInputChars truncates content sent for summarisation (~12k tokens)
+	llmMaxInputChars = 48000
+
+	// Output token budgets per operation
+	llmExpandMaxTokens     = 256
+	llmRewriteMaxTokens    = 128
+	llmSummaryMinTokens    = 64
+	llmSummaryMaxTokens    = 1024
+	llmPingMaxTokens       = 8
+	llmCompleteMaxTokens   = 1024
+	llmDefaultSummaryLen   = 500
+	llmMaxExpansionTerms   = 8
+	llmApproxCharsPerToken = 4
+)
+
+// Prompt templates
+const (
+	expandSystemPrompt = `You expand search queries for an enterprise document search engine.
+Given a query, return related search terms: synonyms, abbreviations and their expansions, and closely related concepts.
+Respond ONLY with a JSON array of strings, for example ["term one", "term two"]. Return at most %d terms. Do not repeat the original query.`
+
+	rewriteSystemPrompt = `You rewrite search queries for an enterprise document search engine.
+Fix spelling, remove filler words and conversational phrasing, and keep every meaningful keyword, identifier and quoted phrase.
+Respond ONLY with the rewritten query on a single line, without quotes or explanation.`
+
+	summariseSystemPrompt = `You summarise documents for search result previews.
+Write a concise, factual summary of the content in plain text. Do not invent details that are not in the content.
+Keep the summary under %d characters.`
+
+	pingUserPrompt = "Reply with the single word OK."
+)
+
+// listMarkerPattern matches bullet and numbered-list prefixes ("- ", "2) ")
+var listMarkerPattern = regexp.MustCompile(`^(?:[-*•]|\d+[.)])\s+`)
+
+// completionRequest is a provider-neutral chat completion request
+type completionRequest struct {
+	System    string
+	User      string
+	MaxTokens int
+}
+
+// completionFunc sends a completion request to a specific provider
+type completionFunc func(ctx context.Context, req completionRequest) (string, error)
+
+// streamFunc sends a streaming completion request to a specific provider,
+// calling onToken for each text fragment and returning the full text
+type streamFunc func(ctx context.Context, req completionRequest, onToken func(string) error) (string, error)
+
+// promptedLLM implements the LLMService operations on top of a provider's
+// completion call. Provider adapters embed it and supply complete, and
+// stream when the provider supports incremental output.
+type promptedLLM struct {
+	model    string
+	complete completionFunc
+	stream   streamFunc // nil falls back to a single-fragment stream
+}
+
+// ExpandQuery takes a search query and returns expanded/related terms
+func (l *promptedLLM) ExpandQuery(ctx context.Context, query string) ([]string, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, nil
+	}
+
+	out, err := l.run(ctx, completionRequest{
+		System:    fmt.Sprintf(expandSystemPrompt, llmMaxExpansionTerms),
+		User:      query,
+		MaxTokens: llmExpandMaxTokens,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return parseExpansionTerms(out, query, llmMaxExpansionTerms), nil
+}
+
+// Summarise generates a summary of the given content
+func (l *promptedLLM) Summarise(ctx context.Context, content string, maxLen int) (string, error) {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return "", nil
+	}
+	if maxLen <= 0 {
+		maxLen = llmDefaultSummaryLen
+	}
+
+	out, err := l.run(ctx, completionRequest{
+		System:    fmt.Sprintf(summariseSystemPrompt, maxLen),
+		User:      domain.TruncateRunes(content, llmMaxInputChars),
+		MaxTokens: summaryTokenBudget(maxLen),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(out), nil
+}
+
+// RewriteQuery rewrites the query for better search results
+func (l *promptedLLM) RewriteQuery(ctx context.Context, query string) (string, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return "", nil
+	}
+
+	out, err := l.run(ctx, completionRequest{
+		System:    rewriteSystemPrompt,
+		User:      query,
+		MaxTokens: llmRewriteMaxTokens,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	rewritten := cleanRewrite(out)
+	if rewritten == "" {
+		// Never return an empty query for a non-empty input
+		return query, nil
+	}
+	return rewritten, nil
+}
+
+// Complete runs a single completion with a caller-built prompt
+func (l *promptedLLM) Complete(ctx context.Context, req driven.CompletionRequest) (string, error) {
+	out, err := l.run(ctx, toCompletionRequest(req))
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(out), nil
+}
+
+// StreamComplete runs a completion and reports text fragments as they arrive
+func (l *promptedLLM) StreamComplete(ctx context.Context, req driven.CompletionRequest, onToken func(string) error) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, llmRequestTimeout)
+	defer cancel()
+
+	if l.stream == nil {
+		out, err := l.complete(ctx, toCompletionRequest(req))
+		if err != nil {
+			return "", err
+		}
+		out = strings.TrimSpace(out)
+		if out != "" {
+			if err := onToken(out); err != nil {
+				return "", err
+			}
+		}
+		return out, nil
+	}
+
+	out, err := l.stream(ctx, toCompletionRequest(req), onToken)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(out), nil
+}
+
+// Model returns the model name being used
+func (l *promptedLLM) Model() string {
+	return l.model
+}
+
+// Ping verifies the LLM service is available with a minimal completion
+func (l *promptedLLM) Ping(ctx context.Context) error {
+	_, err := l.run(ctx, completionRequest{
+		User:      pingUserPrompt,
+		MaxTokens: llmPingMaxTokens,
+	})
+	return err
+}
+
+// run applies the shared request timeout to a completion call
+func (l *promptedLLM) run(ctx context.Context, req completionRequest) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, llmRequestTimeout)
+	defer cancel()
+	return l.complete(ctx, req)
+}
+
+// toCompletionRequest maps a port request onto the provider-neutral request
+func toCompletionRequest(req driven.CompletionRequest) completionRequest {
+	maxTokens := req.MaxTokens
+	if maxTokens <= 0 {
+		maxTokens = llmCompleteMaxTokens
+	}
+	return completionRequest{
+		System:    req.System,
+		User:      domain.TruncateRunes(req.Prompt, llmMaxInputChars),
+		MaxTokens: maxTokens,
+	}
+}
+
+// summaryTokenBudget converts a character limit into an output token budget
+func summaryTokenBudget(maxLen int) int {
+	tokens := maxLen / llmApproxCharsPerToken
+	if tokens < llmSummaryMinTokens {
+		tokens = llmSummaryMinTokens
+	}
+	if tokens > llmSummaryMaxTokens {
+		tokens = llmSummaryMaxTokens
+	}
+	return tokens
+}
+
+// parseExpansionTerms extracts terms from the model's expansion output.
+// The prompt asks for a JSON array; models that ignore this and answer with
+// a bulleted or comma-separated list are handled as a fallback.
+func parseExpansionTerms(out, query string, limit int) []string {
+	var raw []string
+
+	if start, end := strings.Index(out, "["), strings.LastIndex(out, "]"); start >= 0 && end > start {
+		if err := json.Unmarshal([]byte(out[start:end+1]), &raw); err != nil {
+			raw = nil
+		}
+	}
+
+	if raw == nil {
+		for _, line := range strings.Split(out, "\n") {
+			raw = append(raw, strings.Split(line, ",")...)
+		}
+	}
+
+	seen := map[string]bool{strings.ToLower(query): true}
+	terms := make([]string, 0, len(raw))
+	for _, term := range raw {
+		term = cleanTerm(term)
+		key := strings.ToLower(term)
+		if term == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		terms = append(terms, term)
+		if len(terms) >= limit {
+			break
+		}
+	}
+
+	return terms
+}
+
+// cleanTerm strips list markers and quotes from a single expansion term
+func cleanTerm(term string) string {
+	term = strings.TrimSpace(term)
+	term = listMarkerPattern.ReplaceAllString(term, "")
+	term = strings.Trim(term, "\"'` ")
+	return strings.TrimSpace(term)
+}
+
+// cleanRewrite keeps the first non-empty line of a rewrite and strips quotes
+func cleanRewrite(out string) string {
+	for _, line := range strings.Split(out, "\n") {
+		line = strings.Trim(strings.TrimSpace(line), "\"'`")
+		if line != "" {
+			return strings.TrimSpace(line)
+		}
+	}
+	return ""
+}
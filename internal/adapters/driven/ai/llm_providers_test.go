@@ -0,0 +1,367 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+func TestNewOpenAILLM_RequiresAPIKey(t *testing.T) {
+	if _, err := NewOpenAILLM("", "gpt-4o-mini", ""); err == nil {
+		t.Error("expected error for empty API key")
+	}
+}
+
+func TestNewAnthropicLLM_RequiresAPIKey(t *testing.T) {
+	if _, err := NewAnthropicLLM("", "claude-3-5-haiku-latest", ""); err == nil {
+		t.Error("expected error for empty API key")
+	}
+}
+
+func TestLLM_DefaultModels(t *testing.T) {
+	openai, _ := NewOpenAILLM("sk-test", "", "")
+	anthropic, _ := NewAnthropicLLM("sk-ant-test", "", "")
+	ollama, _ := NewOllamaLLM("", "")
+
+	if openai.Model() != "gpt-4o-mini" {
+		t.Errorf("unexpected OpenAI default model %s", openai.Model())
+	}
+	if anthropic.Model() != "claude-3-5-haiku-latest" {
+		t.Errorf("unexpected Anthropic default model %s", anthropic.Model())
+	}
+	if ollama.Model() != "llama3.2" {
+		t.Errorf("unexpected Ollama default model %s", ollama.Model())
+	}
+}
+
+func TestOpenAILLM_ExpandQuery(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/chat/completions" {
+			t.Errorf("expected /chat/completions, got %s", r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer sk-test" {
+			t.Error("expected Authorization header")
+		}
+
+		var req openAIChatRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+		if len(req.Messages) != 2 || req.Messages[0].Role != "system" || req.Messages[1].Content != "k8s" {
+			t.Errorf("unexpected messages: %+v", req.Messages)
+		}
+		if req.MaxTokens != llmExpandMaxTokens {
+			t.Errorf("expected max_tokens %d, got %d", llmExpandMaxTokens, req.MaxTokens)
+		}
+
+		_, _ = w.Write([]byte(`{"choices": [{"message": {"role": "assistant", "content": "[\"kubernetes\"]"}}]}`))
+	}))
+	defer server.Close()
+
+	llm, err := NewOpenAILLM("sk-test", "gpt-4o-mini", server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	terms, err := llm.ExpandQuery(context.Background(), "k8s")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(terms) != 1 || terms[0] != "kubernetes" {
+		t.Errorf("expected [kubernetes], got %v", terms)
+	}
+}
+
+func TestOpenAILLM_APIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error": {"message": "Invalid API key", "type": "invalid_request_error", "code": "invalid_api_key"}}`))
+	}))
+	defer server.Close()
+
+	llm, _ := NewOpenAILLM("sk-invalid", "gpt-4o-mini", server.URL)
+	if err := llm.Ping(context.Background()); err == nil {
+		t.Error("expected error for API error response")
+	}
+}
+
+func TestOpenAILLM_NoChoices(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"choices": []}`))
+	}))
+	defer server.Close()
+
+	llm, _ := NewOpenAILLM("sk-test", "gpt-4o-mini", server.URL)
+	if _, err := llm.RewriteQuery(context.Background(), "q"); err == nil {
+		t.Error("expected error when no choices are returned")
+	}
+}
+
+func TestAnthropicLLM_Summarise(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/messages" {
+			t.Errorf("expected /messages, got %s", r.URL.Path)
+		}
+		if r.Header.Get("x-api-key") != "sk-ant-test" {
+			t.Error("expected x-api-key header")
+		}
+		if r.Header.Get("anthropic-version") != anthropicAPIVersion {
+			t.Error("expected anthropic-version header")
+		}
+
+		var req anthropicMessagesRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+		if req.System == "" {
+			t.Error("expected system prompt to be sent as top-level field")
+		}
+		if len(req.Messages) != 1 || req.Messages[0].Role != "user" {
+			t.Errorf("unexpected messages: %+v", req.Messages)
+		}
+		if req.MaxTokens <= 0 {
+			t.Error("expected max_tokens to be set")
+		}
+
+		_, _ = w.Write([]byte(`{"content": [{"type": "text", "text": "Short "}, {"type": "text", "text": "summary."}]}`))
+	}))
+	defer server.Close()
+
+	llm, err := NewAnthropicLLM("sk-ant-test", "claude-3-5-haiku-latest", server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	summary, err := llm.Summarise(context.Background(), "long content", 300)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if summary != "Short summary." {
+		t.Errorf("expected joined text blocks, got %q", summary)
+	}
+}
+
+func TestAnthropicLLM_APIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"type": "error", "error": {"type": "invalid_request_error", "message": "model not found"}}`))
+	}))
+	defer server.Close()
+
+	llm, _ := NewAnthropicLLM("sk-ant-test", "missing", server.URL)
+	if err := llm.Ping(context.Background()); err == nil {
+		t.Error("expected error for API error response")
+	}
+}
+
+func TestOllamaLLM_RewriteQuery(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/chat" {
+			t.Errorf("expected /api/chat, got %s", r.URL.Path)
+		}
+
+		var req ollamaChatRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+		if req.Stream {
+			t.Error("expected non-streaming request")
+		}
+		if req.Options.NumPredict != llmRewriteMaxTokens {
+			t.Errorf("expected num_predict %d, got %d", llmRewriteMaxTokens, req.Options.NumPredict)
+		}
+
+		_, _ = w.Write([]byte(`{"message": {"role": "assistant", "content": "quarterly revenue report"}, "done": true}`))
+	}))
+	defer server.Close()
+
+	llm, err := NewOllamaLLM(server.URL, "llama3.2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, err := llm.RewriteQuery(context.Background(), "um where is the quarterly revenue report")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "quarterly revenue report" {
+		t.Errorf("unexpected rewrite %q", got)
+	}
+}
+
+func TestOllamaLLM_APIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error": "model \"missing\" not found"}`))
+	}))
+	defer server.Close()
+
+	llm, _ := NewOllamaLLM(server.URL, "missing")
+	if err := llm.Ping(context.Background()); err == nil {
+		t.Error("expected error for API error response")
+	}
+}
+
+func TestLLM_Close(t *testing.T) {
+	openai, _ := NewOpenAILLM("sk-test", "", "")
+	anthropic, _ := NewAnthropicLLM("sk-ant-test", "", "")
+	ollama, _ := NewOllamaLLM("", "")
+
+	for _, l := range []interface{ Close() error }{openai, anthropic, ollama} {
+		if err := l.Close(); err != nil {
+			t.Errorf("expected no error from Close, got %v", err)
+		}
+	}
+}
+
+// collectTokens returns an onToken func that records fragments
+func collectTokens(tokens *[]string) func(string) error {
+	return func(token string) error {
+		*tokens = append(*tokens, token)
+		return nil
+	}
+}
+
+func TestOpenAILLM_StreamComplete(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req openAIChatRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+		if !req.Stream {
+			t.Error("expected streaming request")
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = w.Write([]byte("data: {\"choices\": [{\"delta\": {\"role\": \"assistant\"}}]}\n\n" +
+			"data: {\"choices\": [{\"delta\": {\"content\": \"Hello\"}}]}\n\n" +
+			": keep-alive\n\n" +
+			"data: {\"choices\": [{\"delta\": {\"content\": \" world\"}}]}\n\n" +
+			"data: [DONE]\n\n"))
+	}))
+	defer server.Close()
+
+	llm, _ := NewOpenAILLM("sk-test", "gpt-4o-mini", server.URL)
+
+	var tokens []string
+	out, err := llm.StreamComplete(context.Background(), driven.CompletionRequest{Prompt: "hi"}, collectTokens(&tokens))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out != "Hello world" || len(tokens) != 2 {
+		t.Errorf("expected 2 tokens forming %q, got %v (%q)", "Hello world", tokens, out)
+	}
+}
+
+func TestOpenAILLM_StreamComplete_APIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error": {"message": "Invalid API key", "type": "invalid_request_error", "code": "invalid_api_key"}}`))
+	}))
+	defer server.Close()
+
+	llm, _ := NewOpenAILLM("sk-invalid", "gpt-4o-mini", server.URL)
+	_, err := llm.StreamComplete(context.Background(), driven.CompletionRequest{Prompt: "hi"}, func(string) error { return nil })
+	if err == nil || !strings.Contains(err.Error(), "Invalid API key") {
+		t.Errorf("expected API error message, got %v", err)
+	}
+}
+
+func TestAnthropicLLM_StreamComplete(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req anthropicMessagesRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+		if !req.Stream {
+			t.Error("expected streaming request")
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = w.Write([]byte("event: message_start\ndata: {\"type\": \"message_start\"}\n\n" +
+			"event: content_block_delta\ndata: {\"type\": \"content_block_delta\", \"delta\": {\"type\": \"text_delta\", \"text\": \"Short \"}}\n\n" +
+			"event: ping\ndata: {\"type\": \"ping\"}\n\n" +
+			"event: content_block_delta\ndata: {\"type\": \"content_block_delta\", \"delta\": {\"type\": \"text_delta\", \"text\": \"answer.\"}}\n\n" +
+			"event: message_stop\ndata: {\"type\": \"message_stop\"}\n\n"))
+	}))
+	defer server.Close()
+
+	llm, _ := NewAnthropicLLM("sk-ant-test", "claude-3-5-haiku-latest", server.URL)
+
+	var tokens []string
+	out, err := llm.StreamComplete(context.Background(), driven.CompletionRequest{Prompt: "hi"}, collectTokens(&tokens))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out != "Short answer." || len(tokens) != 2 {
+		t.Errorf("unexpected stream output %q from tokens %v", out, tokens)
+	}
+}
+
+func TestAnthropicLLM_StreamComplete_ErrorEvent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("event: error\ndata: {\"type\": \"error\", \"error\": {\"type\": \"overloaded_error\", \"message\": \"Overloaded\"}}\n\n"))
+	}))
+	defer server.Close()
+
+	llm, _ := NewAnthropicLLM("sk-ant-test", "claude-3-5-haiku-latest", server.URL)
+	_, err := llm.StreamComplete(context.Background(), driven.CompletionRequest{Prompt: "hi"}, func(string) error { return nil })
+	if err == nil || !strings.Contains(err.Error(), "Overloaded") {
+		t.Errorf("expected stream error event to surface, got %v", err)
+	}
+}
+
+func TestOllamaLLM_StreamComplete(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req ollamaChatRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+		if !req.Stream {
+			t.Error("expected streaming request")
+		}
+
+		_, _ = w.Write([]byte(`{"message": {"role": "assistant", "content": "one "}, "done": false}` + "\n" +
+			`{"message": {"role": "assistant", "content": "two"}, "done": false}` + "\n" +
+			`{"message": {"role": "assistant", "content": ""}, "done": true}` + "\n"))
+	}))
+	defer server.Close()
+
+	llm, _ := NewOllamaLLM(server.URL, "llama3.2")
+
+	var tokens []string
+	out, err := llm.StreamComplete(context.Background(), driven.CompletionRequest{Prompt: "count"}, collectTokens(&tokens))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out != "one two" || len(tokens) != 2 {
+		t.Errorf("unexpected stream output %q from tokens %v", out, tokens)
+	}
+}
+
+func TestStreamComplete_CallbackErrorStopsStream(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"message": {"content": "a"}, "done": false}` + "\n" +
+			`{"message": {"content": "b"}, "done": false}` + "\n" +
+			`{"message": {"content": ""}, "done": true}` + "\n"))
+	}))
+	defer server.Close()
+
+	llm, _ := NewOllamaLLM(server.URL, "llama3.2")
+
+	stop := errors.New("client gone")
+	calls := 0
+	_, err := llm.StreamComplete(context.Background(), driven.CompletionRequest{Prompt: "x"}, func(string) error {
+		calls++
+		return stop
+	})
+	if !errors.Is(err, stop) || calls != 1 {
+		t.Errorf("expected stream to stop on first callback error, got %v after %d calls", err, calls)
+	}
+}
@@ -0,0 +1,236 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// stubLLM returns a promptedLLM whose completion call returns out and records the request
+func stubLLM(out string, err error, got *completionRequest) *promptedLLM {
+	return &promptedLLM{
+		model: "stub",
+		complete: func(ctx context.Context, req completionRequest) (string, error) {
+			if got != nil {
+				*got = req
+			}
+			return out, err
+		},
+	}
+}
+
+func TestParseExpansionTerms(t *testing.T) {
+	testCases := []struct {
+		name  string
+		out   string
+		query string
+		want  []string
+	}{
+		{
+			name:  "json array",
+			out:   `["k8s", "container orchestration", "Kubernetes"]`,
+			query: "kubernetes",
+			want:  []string{"k8s", "container orchestration"},
+		},
+		{
+			name:  "json array wrapped in prose",
+			out:   "Here are some terms:\n[\"pto\", \"paid time off\"]\nHope this helps",
+			query: "vacation policy",
+			want:  []string{"pto", "paid time off"},
+		},
+		{
+			name:  "bulleted fallback",
+			out:   "- auth\n- login\n2) sign in",
+			query: "authentication",
+			want:  []string{"auth", "login", "sign in"},
+		},
+		{
+			name:  "comma fallback keeps leading digits",
+			out:   "3d printing, additive manufacturing, 3D Printing",
+			query: "printer",
+			want:  []string{"3d printing", "additive manufacturing"},
+		},
+		{
+			name:  "empty output",
+			out:   "",
+			query: "anything",
+			want:  []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := parseExpansionTerms(tc.out, tc.query, llmMaxExpansionTerms)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestParseExpansionTerms_Limit(t *testing.T) {
+	got := parseExpansionTerms(`["a", "b", "c", "d"]`, "q", 2)
+	if len(got) != 2 {
+		t.Errorf("expected 2 terms, got %v", got)
+	}
+}
+
+func TestPromptedLLM_ExpandQuery(t *testing.T) {
+	var req completionRequest
+	llm := stubLLM(`["pto", "leave"]`, nil, &req)
+
+	terms, err := llm.ExpandQuery(context.Background(), "  vacation  ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(terms) != 2 {
+		t.Errorf("expected 2 terms, got %v", terms)
+	}
+	if req.User != "vacation" {
+		t.Errorf("expected trimmed query in prompt, got %q", req.User)
+	}
+	if req.MaxTokens != llmExpandMaxTokens {
+		t.Errorf("expected max tokens %d, got %d", llmExpandMaxTokens, req.MaxTokens)
+	}
+}
+
+func TestPromptedLLM_ExpandQuery_Empty(t *testing.T) {
+	llm := stubLLM("", errors.New("should not be called"), nil)
+
+	terms, err := llm.ExpandQuery(context.Background(), "   ")
+	if err != nil || terms != nil {
+		t.Errorf("expected nil terms and no error for empty query, got %v, %v", terms, err)
+	}
+}
+
+func TestPromptedLLM_Summarise_TruncatesInputAndBudgetsTokens(t *testing.T) {
+	var req completionRequest
+	llm := stubLLM("  a summary  ", nil, &req)
+
+	summary, err := llm.Summarise(context.Background(), strings.Repeat("x", llmMaxInputChars+100), 200)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if summary != "a summary" {
+		t.Errorf("expected trimmed summary, got %q", summary)
+	}
+	if len(req.User) != llmMaxInputChars {
+		t.Errorf("expected input truncated to %d chars, got %d", llmMaxInputChars, len(req.User))
+	}
+	if req.MaxTokens != llmSummaryMinTokens {
+		t.Errorf("expected minimum token budget %d, got %d", llmSummaryMinTokens, req.MaxTokens)
+	}
+	if !strings.Contains(req.System, "200 characters") {
+		t.Errorf("expected length hint in system prompt, got %q", req.System)
+	}
+}
+
+func TestSummaryTokenBudget(t *testing.T) {
+	if got := summaryTokenBudget(10); got != llmSummaryMinTokens {
+		t.Errorf("expected min budget, got %d", got)
+	}
+	if got := summaryTokenBudget(2000); got != 500 {
+		t.Errorf("expected 500 tokens, got %d", got)
+	}
+	if got := summaryTokenBudget(1000000); got != llmSummaryMaxTokens {
+		t.Errorf("expected max budget, got %d", got)
+	}
+}
+
+func TestPromptedLLM_RewriteQuery(t *testing.T) {
+	llm := stubLLM("\n\"deployment runbook\"\nexplanation that should be dropped", nil, nil)
+
+	got, err := llm.RewriteQuery(context.Background(), "how do i find the deploymnt runbook")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "deployment runbook" {
+		t.Errorf("expected cleaned rewrite, got %q", got)
+	}
+}
+
+func TestPromptedLLM_RewriteQuery_EmptyOutputFallsBack(t *testing.T) {
+	llm := stubLLM("   ", nil, nil)
+
+	got, err := llm.RewriteQuery(context.Background(), "original")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "original" {
+		t.Errorf("expected original query, got %q", got)
+	}
+}
+
+func TestPromptedLLM_PropagatesErrors(t *testing.T) {
+	llm := stubLLM("", errors.New("boom"), nil)
+
+	if _, err := llm.ExpandQuery(context.Background(), "q"); err == nil {
+		t.Error("expected error from ExpandQuery")
+	}
+	if _, err := llm.Summarise(context.Background(), "c", 100); err == nil {
+		t.Error("expected error from Summarise")
+	}
+	if _, err := llm.RewriteQuery(context.Background(), "q"); err == nil {
+		t.Error("expected error from RewriteQuery")
+	}
+	if err := llm.Ping(context.Background()); err == nil {
+		t.Error("expected error from Ping")
+	}
+}
+
+func TestPromptedLLM_AppliesTimeout(t *testing.T) {
+	llm := &promptedLLM{
+		complete: func(ctx context.Context, req completionRequest) (string, error) {
+			if _, ok := ctx.Deadline(); !ok {
+				t.Error("expected completion context to carry a deadline")
+			}
+			return "OK", nil
+		},
+	}
+
+	if err := llm.Ping(context.Background()); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestPromptedLLM_Complete(t *testing.T) {
+	var req completionRequest
+	llm := stubLLM(" answer [1] ", nil, &req)
+
+	out, err := llm.Complete(context.Background(), driven.CompletionRequest{System: "sys", Prompt: "question"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if out != "answer [1]" {
+		t.Errorf("expected trimmed output, got %q", out)
+	}
+	if req.System != "sys" || req.User != "question" {
+		t.Errorf("expected prompt to be passed through, got %+v", req)
+	}
+	if req.MaxTokens != llmCompleteMaxTokens {
+		t.Errorf("expected default token budget %d, got %d", llmCompleteMaxTokens, req.MaxTokens)
+	}
+}
+
+func TestPromptedLLM_StreamComplete_FallsBackWithoutStream(t *testing.T) {
+	llm := stubLLM(" whole answer ", nil, nil)
+
+	var tokens []string
+	out, err := llm.StreamComplete(context.Background(), driven.CompletionRequest{Prompt: "q"}, func(token string) error {
+		tokens = append(tokens, token)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out != "whole answer" || len(tokens) != 1 || tokens[0] != "whole answer" {
+		t.Errorf("expected a single fragment with the full text, got %v (%q)", tokens, out)
+	}
+}
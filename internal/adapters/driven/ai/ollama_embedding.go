@@ -0,0 +1,232 @@
+package ai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Ensure OllamaEmbedding implements EmbeddingService
+var _ driven.EmbeddingService = (*OllamaEmbedding)(nil)
+
+// ollamaDefaultBaseURL is the address a local Ollama server listens on
+const ollamaDefaultBaseURL = "http://localhost:11434"
+
+// ollamaEmbedBatchSize caps the number of inputs sent per /api/embed call.
+// Ollama processes a batch in a single forward pass, so very large batches
+// can exhaust memory on small self-hosted machines.
+const ollamaEmbedBatchSize = 32
+
+// ollamaProbeTimeout bounds the dimension discovery request issued by
+// Dimensions() when the model size is not yet known.
+const ollamaProbeTimeout = 30 * time.Second
+
+// Model dimensions for commonly pulled Ollama embedding models.
+// Models not listed here have their dimensions discovered on first use.
+var ollamaModelDimensions = map[string]int{
+	"nomic-embed-text":       768,
+	"mxbai-embed-large":      1024,
+	"all-minilm":             384,
+	"snowflake-arctic-embed": 1024,
+	"bge-m3":                 1024,
+	"bge-large":              1024,
+}
+
+// OllamaEmbedding implements EmbeddingService using Ollama's /api/embed endpoint
+type OllamaEmbedding struct {
+	model   string
+	baseURL string
+	client  *http.Client
+
+	mu         sync.RWMutex
+	dimensions int
+}
+
+// NewOllamaEmbedding creates a new Ollama embedding service
+func NewOllamaEmbedding(baseURL, model string) (driven.EmbeddingService, error) {
+	if model == "" {
+		model = "nomic-embed-text"
+	}
+
+	if baseURL == "" {
+		baseURL = ollamaDefaultBaseURL
+	}
+	baseURL = strings.TrimRight(baseURL, "/")
+
+	return &OllamaEmbedding{
+		model:      model,
+		baseURL:    baseURL,
+		dimensions: ollamaModelDimensions[ollamaBaseModel(model)],
+		client: &http.Client{
+			// Local models can be slow to load on first request
+			Timeout: 120 * time.Second,
+		},
+	}, nil
+}
+
+// ollamaBaseModel strips the tag from a model reference ("bge-m3:latest" -> "bge-m3")
+func ollamaBaseModel(model string) string {
+	if idx := strings.Index(model, ":"); idx >= 0 {
+		return model[:idx]
+	}
+	return model
+}
+
+// ollamaEmbedRequest is the request body for Ollama's /api/embed endpoint
+type ollamaEmbedRequest struct {
+	Model string   `json:"model"`
+	Input []string `json:"input"`
+}
+
+// ollamaEmbedResponse is the response from Ollama's /api/embed endpoint
+type ollamaEmbedResponse struct {
+	Model           string      `json:"model"`
+	Embeddings      [][]float32 `json:"embeddings"`
+	PromptEvalCount int         `json:"prompt_eval_count"`
+	Error           string      `json:"error,omitempty"`
+}
+
+// Embed generates embeddings for multiple texts, splitting them into batches
+func (e *OllamaEmbedding) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	if len(texts) == 0 {
+		return nil, nil
+	}
+
+	embeddings := make([][]float32, 0, len(texts))
+	for start := 0; start < len(texts); start += ollamaEmbedBatchSize {
+		end := start + ollamaEmbedBatchSize
+		if end > len(texts) {
+			end = len(texts)
+		}
+
+		resp, err := e.doRequest(ctx, ollamaEmbedRequest{
+			Model: e.model,
+			Input: texts[start:end],
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(resp.Embeddings) != end-start {
+			return nil, fmt.Errorf("Ollama returned %d embeddings for %d inputs", len(resp.Embeddings), end-start)
+		}
+
+		embeddings = append(embeddings, resp.Embeddings...)
+	}
+
+	e.recordDimensions(embeddings)
+
+	return embeddings, nil
+}
+
+// EmbedQuery generates an embedding for a search query
+func (e *OllamaEmbedding) EmbedQuery(ctx context.Context, query string) ([]float32, error) {
+	embeddings, err := e.Embed(ctx, []string{query})
+	if err != nil {
+		return nil, err
+	}
+	if len(embeddings) == 0 {
+		return nil, fmt.Errorf("no embedding returned for query")
+	}
+	return embeddings[0], nil
+}
+
+// Dimensions returns the embedding dimension size.
+// For models without a known size, the first call issues a probe embedding
+// to discover it; the result is cached for the lifetime of the service.
+func (e *OllamaEmbedding) Dimensions() int {
+	e.mu.RLock()
+	dim := e.dimensions
+	e.mu.RUnlock()
+	if dim > 0 {
+		return dim
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), ollamaProbeTimeout)
+	defer cancel()
+	if _, err := e.EmbedQuery(ctx, "dimension probe"); err != nil {
+		return 0
+	}
+
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.dimensions
+}
+
+// recordDimensions caches the vector size reported by the server
+func (e *OllamaEmbedding) recordDimensions(embeddings [][]float32) {
+	if len(embeddings) == 0 || len(embeddings[0]) == 0 {
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.dimensions = len(embeddings[0])
+}
+
+// Model returns the model name being used
+func (e *OllamaEmbedding) Model() string {
+	return e.model
+}
+
+// HealthCheck verifies the Ollama server is reachable and the model is pulled.
+// A successful check also discovers the model's embedding dimensions.
+func (e *OllamaEmbedding) HealthCheck(ctx context.Context) error {
+	_, err := e.EmbedQuery(ctx, "health check")
+	return err
+}
+
+// Close releases resources held by the embedding service
+func (e *OllamaEmbedding) Close() error {
+	e.client.CloseIdleConnections()
+	return nil
+}
+
+// doRequest makes a request to the Ollama embed API
+func (e *OllamaEmbedding) doRequest(ctx context.Context, reqBody ollamaEmbedRequest) (*ollamaEmbedResponse, error) {
+	body, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", e.baseURL+"/api/embed", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	var embResp ollamaEmbedResponse
+	if err := json.Unmarshal(respBody, &embResp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if embResp.Error != "" {
+		return nil, fmt.Errorf("Ollama API error: %s", embResp.Error)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Ollama API returned status %d", resp.StatusCode)
+	}
+
+	return &embResp, nil
+}
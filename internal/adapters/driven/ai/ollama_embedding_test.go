@@ -0,0 +1,292 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNewOllamaEmbedding_Defaults(t *testing.T) {
+	svc, err := NewOllamaEmbedding("", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	emb := svc.(*OllamaEmbedding)
+	if emb.model != "nomic-embed-text" {
+		t.Errorf("expected default model nomic-embed-text, got %s", emb.model)
+	}
+	if emb.baseURL != "http://localhost:11434" {
+		t.Errorf("expected default base URL, got %s", emb.baseURL)
+	}
+}
+
+func TestNewOllamaEmbedding_TrimsTrailingSlash(t *testing.T) {
+	svc, err := NewOllamaEmbedding("http://ollama:11434/", "nomic-embed-text")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	emb := svc.(*OllamaEmbedding)
+	if emb.baseURL != "http://ollama:11434" {
+		t.Errorf("expected trimmed base URL, got %s", emb.baseURL)
+	}
+}
+
+func TestOllamaEmbedding_KnownDimensions(t *testing.T) {
+	testCases := []struct {
+		model      string
+		dimensions int
+	}{
+		{"nomic-embed-text", 768},
+		{"nomic-embed-text:latest", 768},
+		{"mxbai-embed-large", 1024},
+		{"all-minilm", 384},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.model, func(t *testing.T) {
+			svc, err := NewOllamaEmbedding("http://localhost:11434", tc.model)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if svc.Dimensions() != tc.dimensions {
+				t.Errorf("expected dimensions %d, got %d", tc.dimensions, svc.Dimensions())
+			}
+		})
+	}
+}
+
+func TestOllamaEmbedding_Embed_EmptyInput(t *testing.T) {
+	svc, err := NewOllamaEmbedding("", "nomic-embed-text")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	result, err := svc.Embed(context.Background(), []string{})
+	if err != nil {
+		t.Errorf("unexpected error for empty input: %v", err)
+	}
+	if result != nil {
+		t.Error("expected nil result for empty input")
+	}
+}
+
+func TestOllamaEmbedding_Embed_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/embed" {
+			t.Errorf("expected /api/embed, got %s", r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "" {
+			t.Error("expected no Authorization header")
+		}
+
+		var req ollamaEmbedRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+		if req.Model != "nomic-embed-text" {
+			t.Errorf("expected model nomic-embed-text, got %s", req.Model)
+		}
+
+		resp := ollamaEmbedResponse{Model: req.Model}
+		for range req.Input {
+			resp.Embeddings = append(resp.Embeddings, []float32{0.1, 0.2, 0.3})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	svc, err := NewOllamaEmbedding(server.URL, "nomic-embed-text")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	result, err := svc.Embed(context.Background(), []string{"hello", "world"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result) != 2 {
+		t.Errorf("expected 2 embeddings, got %d", len(result))
+	}
+	if len(result[0]) != 3 || result[0][0] != 0.1 {
+		t.Error("unexpected embedding values")
+	}
+}
+
+func TestOllamaEmbedding_Embed_Batching(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+
+		var req ollamaEmbedRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		if len(req.Input) > ollamaEmbedBatchSize {
+			t.Errorf("batch of %d exceeds limit %d", len(req.Input), ollamaEmbedBatchSize)
+		}
+
+		resp := ollamaEmbedResponse{Model: req.Model}
+		for range req.Input {
+			resp.Embeddings = append(resp.Embeddings, []float32{float32(calls)})
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	svc, err := NewOllamaEmbedding(server.URL, "nomic-embed-text")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	texts := make([]string, ollamaEmbedBatchSize*2+5)
+	for i := range texts {
+		texts[i] = "text"
+	}
+
+	result, err := svc.Embed(context.Background(), texts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if calls != 3 {
+		t.Errorf("expected 3 requests, got %d", calls)
+	}
+	if len(result) != len(texts) {
+		t.Fatalf("expected %d embeddings, got %d", len(texts), len(result))
+	}
+	if result[0][0] != 1 || result[len(result)-1][0] != 3 {
+		t.Error("expected embeddings to preserve input order across batches")
+	}
+}
+
+func TestOllamaEmbedding_Embed_CountMismatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(ollamaEmbedResponse{
+			Embeddings: [][]float32{{0.1}},
+		})
+	}))
+	defer server.Close()
+
+	svc, err := NewOllamaEmbedding(server.URL, "nomic-embed-text")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = svc.Embed(context.Background(), []string{"a", "b"})
+	if err == nil {
+		t.Error("expected error when embedding count does not match input count")
+	}
+}
+
+func TestOllamaEmbedding_Dimensions_DiscoveredOnFirstCall(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		_ = json.NewEncoder(w).Encode(ollamaEmbedResponse{
+			Embeddings: [][]float32{make([]float32, 512)},
+		})
+	}))
+	defer server.Close()
+
+	svc, err := NewOllamaEmbedding(server.URL, "my-custom-embedder")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if dim := svc.Dimensions(); dim != 512 {
+		t.Errorf("expected discovered dimensions 512, got %d", dim)
+	}
+	if dim := svc.Dimensions(); dim != 512 {
+		t.Errorf("expected cached dimensions 512, got %d", dim)
+	}
+	if calls != 1 {
+		t.Errorf("expected a single probe request, got %d", calls)
+	}
+}
+
+func TestOllamaEmbedding_Dimensions_UnreachableServer(t *testing.T) {
+	svc, err := NewOllamaEmbedding("http://localhost:99999", "my-custom-embedder")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if dim := svc.Dimensions(); dim != 0 {
+		t.Errorf("expected 0 dimensions when discovery fails, got %d", dim)
+	}
+}
+
+func TestOllamaEmbedding_Embed_APIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error": "model \"missing\" not found, try pulling it first"}`))
+	}))
+	defer server.Close()
+
+	svc, err := NewOllamaEmbedding(server.URL, "missing")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = svc.Embed(context.Background(), []string{"test"})
+	if err == nil {
+		t.Error("expected error for API error response")
+	}
+}
+
+func TestOllamaEmbedding_Embed_InvalidJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("invalid json"))
+	}))
+	defer server.Close()
+
+	svc, err := NewOllamaEmbedding(server.URL, "nomic-embed-text")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = svc.Embed(context.Background(), []string{"test"})
+	if err == nil {
+		t.Error("expected error for invalid JSON response")
+	}
+}
+
+func TestOllamaEmbedding_HealthCheck(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(ollamaEmbedResponse{
+			Embeddings: [][]float32{{0.1, 0.2, 0.3, 0.4}},
+		})
+	}))
+	defer server.Close()
+
+	svc, err := NewOllamaEmbedding(server.URL, "my-custom-embedder")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := svc.HealthCheck(context.Background()); err != nil {
+		t.Errorf("expected no error from health check, got %v", err)
+	}
+	if svc.Dimensions() != 4 {
+		t.Errorf("expected health check to discover 4 dimensions, got %d", svc.Dimensions())
+	}
+}
+
+func TestOllamaEmbedding_Close(t *testing.T) {
+	svc, err := NewOllamaEmbedding("", "nomic-embed-text")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := svc.Close(); err != nil {
+		t.Errorf("expected no error from Close, got %v", err)
+	}
+}
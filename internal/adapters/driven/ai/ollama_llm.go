@@ -0,0 +1,166 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Ensure OllamaLLM implements LLMService
+var _ driven.LLMService = (*OllamaLLM)(nil)
+
+// OllamaLLM implements LLMService using Ollama's /api/chat endpoint
+type OllamaLLM struct {
+	promptedLLM
+	baseURL string
+	client  *http.Client
+}
+
+// NewOllamaLLM creates a new Ollama LLM service
+func NewOllamaLLM(baseURL, model string) (driven.LLMService, error) {
+	if model == "" {
+		model = "llama3.2"
+	}
+
+	if baseURL == "" {
+		baseURL = ollamaDefaultBaseURL
+	}
+
+	l := &OllamaLLM{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		client: &http.Client{
+			// Local models can be slow to load on first request
+			Timeout: llmRequestTimeout + 60*time.Second,
+		},
+	}
+	l.promptedLLM = promptedLLM{model: model, complete: l.complete, stream: l.streamComplete}
+
+	return l, nil
+}
+
+// ollamaChatRequest is the request body for Ollama's /api/chat endpoint
+type ollamaChatRequest struct {
+	Model    string        `json:"model"`
+	Messages []chatMessage `json:"messages"`
+	Stream   bool          `json:"stream"`
+	Options  struct {
+		NumPredict  int     `json:"num_predict,omitempty"`
+		Temperature float64 `json:"temperature"`
+	} `json:"options"`
+}
+
+// ollamaChatResponse is the response from Ollama's /api/chat endpoint
+type ollamaChatResponse struct {
+	Model   string `json:"model"`
+	Message struct {
+		Role    string `json:"role"`
+		Content string `json:"content"`
+	} `json:"message"`
+	Done  bool   `json:"done"`
+	Error string `json:"error,omitempty"`
+}
+
+// Close releases resources held by the LLM service
+func (l *OllamaLLM) Close() error {
+	l.client.CloseIdleConnections()
+	return nil
+}
+
+// chatRequest builds the /api/chat request body
+func (l *OllamaLLM) chatRequest(req completionRequest, stream bool) ([]byte, error) {
+	chatReq := ollamaChatRequest{
+		Model:  l.model,
+		Stream: stream,
+	}
+	if req.System != "" {
+		chatReq.Messages = append(chatReq.Messages, chatMessage{Role: "system", Content: req.System})
+	}
+	chatReq.Messages = append(chatReq.Messages, chatMessage{Role: "user", Content: req.User})
+	chatReq.Options.NumPredict = req.MaxTokens
+
+	body, err := json.Marshal(chatReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+	return body, nil
+}
+
+// complete sends a non-streaming chat request to Ollama
+func (l *OllamaLLM) complete(ctx context.Context, req completionRequest) (string, error) {
+	body, err := l.chatRequest(req, false)
+	if err != nil {
+		return "", err
+	}
+
+	status, respBody, err := postJSONWithRetry(ctx, l.client, l.baseURL+"/api/chat", nil, body)
+	if err != nil {
+		return "", err
+	}
+
+	var chatResp ollamaChatResponse
+	if err := json.Unmarshal(respBody, &chatResp); err != nil {
+		return "", fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if chatResp.Error != "" {
+		return "", fmt.Errorf("Ollama API error: %s", chatResp.Error)
+	}
+
+	if status != http.StatusOK {
+		return "", fmt.Errorf("Ollama API returned status %d", status)
+	}
+
+	return chatResp.Message.Content, nil
+}
+
+// streamComplete sends a streaming chat request to Ollama.
+// Ollama streams newline-delimited JSON objects, the last one with done set.
+func (l *OllamaLLM) streamComplete(ctx context.Context, req completionRequest, onToken func(string) error) (string, error) {
+	body, err := l.chatRequest(req, true)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := openJSONWithRetry(ctx, l.client, l.baseURL+"/api/chat", nil, body)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var errResp ollamaChatResponse
+		if json.Unmarshal(readErrorBody(resp.Body), &errResp) == nil && errResp.Error != "" {
+			return "", fmt.Errorf("Ollama API error: %s", errResp.Error)
+		}
+		return "", fmt.Errorf("Ollama API returned status %d", resp.StatusCode)
+	}
+
+	var text strings.Builder
+	err = scanStreamLines(resp.Body, func(line string) (bool, error) {
+		var chunk ollamaChatResponse
+		if err := json.Unmarshal([]byte(line), &chunk); err != nil {
+			return false, fmt.Errorf("failed to parse stream event: %w", err)
+		}
+		if chunk.Error != "" {
+			return false, fmt.Errorf("Ollama API error: %s", chunk.Error)
+		}
+
+		if token := chunk.Message.Content; token != "" {
+			text.WriteString(token)
+			if err := onToken(token); err != nil {
+				return false, err
+			}
+		}
+		return chunk.Done, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return text.String(), nil
+}
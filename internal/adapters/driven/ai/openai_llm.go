@@ -0,0 +1,214 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Ensure OpenAILLM implements LLMService
+var _ driven.LLMService = (*OpenAILLM)(nil)
+
+// OpenAILLM implements LLMService using OpenAI's chat completions API.
+// Any OpenAI-compatible server can be used by setting a custom base URL.
+type OpenAILLM struct {
+	promptedLLM
+	apiKey  string
+	baseURL string
+	client  *http.Client
+}
+
+// NewOpenAILLM creates a new OpenAI LLM service
+func NewOpenAILLM(apiKey, model, baseURL string) (driven.LLMService, error) {
+	if apiKey == "" {
+		return nil, fmt.Errorf("OpenAI API key is required")
+	}
+
+	if model == "" {
+		model = "gpt-4o-mini"
+	}
+
+	if baseURL == "" {
+		baseURL = "https://api.openai.com/v1"
+	}
+
+	l := &OpenAILLM{
+		apiKey:  apiKey,
+		baseURL: strings.TrimRight(baseURL, "/"),
+		client: &http.Client{
+			Timeout: llmRequestTimeout + 10*time.Second,
+		},
+	}
+	l.promptedLLM = promptedLLM{model: model, complete: l.complete, stream: l.streamComplete}
+
+	return l, nil
+}
+
+// chatMessage is a single message in a chat request
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// openAIChatRequest is the request body for OpenAI's chat completions API
+type openAIChatRequest struct {
+	Model       string        `json:"model"`
+	Messages    []chatMessage `json:"messages"`
+	MaxTokens   int           `json:"max_tokens,omitempty"`
+	Temperature float64       `json:"temperature"`
+	Stream      bool          `json:"stream,omitempty"`
+}
+
+// openAIChatResponse is the response from OpenAI's chat completions API
+type openAIChatResponse struct {
+	Choices []struct {
+		Index   int `json:"index"`
+		Message struct {
+			Role    string `json:"role"`
+			Content string `json:"content"`
+		} `json:"message"`
+		FinishReason string `json:"finish_reason"`
+	} `json:"choices"`
+	Error *struct {
+		Message string `json:"message"`
+		Type    string `json:"type"`
+		Code    string `json:"code"`
+	} `json:"error,omitempty"`
+}
+
+// openAIStreamChunk is a single server-sent event from a streaming chat completion
+type openAIStreamChunk struct {
+	Choices []struct {
+		Delta struct {
+			Content string `json:"content"`
+		} `json:"delta"`
+	} `json:"choices"`
+	Error *struct {
+		Message string `json:"message"`
+		Type    string `json:"type"`
+	} `json:"error,omitempty"`
+}
+
+// Close releases resources held by the LLM service
+func (l *OpenAILLM) Close() error {
+	l.client.CloseIdleConnections()
+	return nil
+}
+
+// chatRequest builds the chat completions request body
+func (l *OpenAILLM) chatRequest(req completionRequest, stream bool) ([]byte, error) {
+	messages := make([]chatMessage, 0, 2)
+	if req.System != "" {
+		messages = append(messages, chatMessage{Role: "system", Content: req.System})
+	}
+	messages = append(messages, chatMessage{Role: "user", Content: req.User})
+
+	body, err := json.Marshal(openAIChatRequest{
+		Model:       l.model,
+		Messages:    messages,
+		MaxTokens:   req.MaxTokens,
+		Temperature: 0,
+		Stream:      stream,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+	return body, nil
+}
+
+// complete sends a chat completion request to OpenAI
+func (l *OpenAILLM) complete(ctx context.Context, req completionRequest) (string, error) {
+	body, err := l.chatRequest(req, false)
+	if err != nil {
+		return "", err
+	}
+
+	status, respBody, err := postJSONWithRetry(ctx, l.client, l.baseURL+"/chat/completions", map[string]string{
+		"Authorization": "Bearer " + l.apiKey,
+	}, body)
+	if err != nil {
+		return "", err
+	}
+
+	var chatResp openAIChatResponse
+	if err := json.Unmarshal(respBody, &chatResp); err != nil {
+		return "", fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if chatResp.Error != nil {
+		return "", fmt.Errorf("OpenAI API error: %s (type: %s, code: %s)",
+			chatResp.Error.Message, chatResp.Error.Type, chatResp.Error.Code)
+	}
+
+	if status != http.StatusOK {
+		return "", fmt.Errorf("OpenAI API returned status %d", status)
+	}
+
+	if len(chatResp.Choices) == 0 {
+		return "", fmt.Errorf("OpenAI API returned no choices")
+	}
+
+	return chatResp.Choices[0].Message.Content, nil
+}
+
+// streamComplete sends a streaming chat completion request to OpenAI.
+// The response is a server-sent event stream terminated by "data: [DONE]".
+func (l *OpenAILLM) streamComplete(ctx context.Context, req completionRequest, onToken func(string) error) (string, error) {
+	body, err := l.chatRequest(req, true)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := openJSONWithRetry(ctx, l.client, l.baseURL+"/chat/completions", map[string]string{
+		"Authorization": "Bearer " + l.apiKey,
+	}, body)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var errResp openAIChatResponse
+		if json.Unmarshal(readErrorBody(resp.Body), &errResp) == nil && errResp.Error != nil {
+			return "", fmt.Errorf("OpenAI API error: %s (type: %s, code: %s)",
+				errResp.Error.Message, errResp.Error.Type, errResp.Error.Code)
+		}
+		return "", fmt.Errorf("OpenAI API returned status %d", resp.StatusCode)
+	}
+
+	var text strings.Builder
+	err = scanStreamLines(resp.Body, func(line string) (bool, error) {
+		data, ok := sseData(line)
+		if !ok {
+			return false, nil
+		}
+		if data == "[DONE]" {
+			return true, nil
+		}
+
+		var chunk openAIStreamChunk
+		if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+			return false, fmt.Errorf("failed to parse stream event: %w", err)
+		}
+		if chunk.Error != nil {
+			return false, fmt.Errorf("OpenAI API error: %s (type: %s)", chunk.Error.Message, chunk.Error.Type)
+		}
+		if len(chunk.Choices) == 0 || chunk.Choices[0].Delta.Content == "" {
+			return false, nil
+		}
+
+		token := chunk.Choices[0].Delta.Content
+		text.WriteString(token)
+		return false, onToken(token)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return text.String(), nil
+}
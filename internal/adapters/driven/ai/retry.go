@@ -0,0 +1,90 @@
+package ai
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Retry policy for rate-limited provider APIs.
+// These are variables so tests can shorten the waits.
+var (
+	rateLimitMaxRetries = 3
+	rateLimitBaseDelay  = time.Second
+	rateLimitMaxDelay   = 30 * time.Second
+)
+
+// postJSONWithRetry POSTs a JSON body and retries when the provider signals
+// rate limiting (429) or temporary unavailability (503). The Retry-After
+// header is honoured when present; otherwise an exponential backoff is used.
+// It returns the final status code and response body.
+func postJSONWithRetry(ctx context.Context, client *http.Client, url string, headers map[string]string, body []byte) (int, []byte, error) {
+	resp, err := openJSONWithRetry(ctx, client, url, headers, body)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	return resp.StatusCode, respBody, nil
+}
+
+// openJSONWithRetry applies the same retry policy as postJSONWithRetry but
+// returns the final response unread, so streaming bodies can be consumed as
+// they arrive. The caller must close the response body.
+func openJSONWithRetry(ctx context.Context, client *http.Client, url string, headers map[string]string, body []byte) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(body))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		req.Header.Set("Content-Type", "application/json")
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("request failed: %w", err)
+		}
+
+		retryable := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable
+		if !retryable || attempt >= rateLimitMaxRetries {
+			return resp, nil
+		}
+
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(retryDelay(resp.Header.Get("Retry-After"), attempt)):
+		}
+	}
+}
+
+// retryDelay returns how long to wait before the next attempt
+func retryDelay(retryAfter string, attempt int) time.Duration {
+	if secs, err := strconv.Atoi(retryAfter); err == nil && secs >= 0 {
+		delay := time.Duration(secs) * time.Second
+		if delay > rateLimitMaxDelay {
+			delay = rateLimitMaxDelay
+		}
+		return delay
+	}
+
+	delay := rateLimitBaseDelay << attempt
+	if delay > rateLimitMaxDelay {
+		delay = rateLimitMaxDelay
+	}
+	return delay
+}
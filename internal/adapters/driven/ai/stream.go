@@ -0,0 +1,54 @@
+package ai
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// Streaming response limits
+const (
+	// maxStreamLineBytes bounds a single line of a streamed response
+	maxStreamLineBytes = 1 << 20
+
+	// maxStreamErrorBytes bounds how much of a failed streaming response is read
+	maxStreamErrorBytes = 64 << 10
+)
+
+// scanStreamLines reads a line-delimited streaming body (SSE or NDJSON) and
+// calls fn for each non-empty line until fn reports done or the body ends.
+func scanStreamLines(r io.Reader, fn func(line string) (done bool, err error)) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxStreamLineBytes)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		done, err := fn(line)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+	}
+	return scanner.Err()
+}
+
+// sseData returns the payload of a server-sent event "data:" line.
+// Other SSE fields (event, id, comments) report false.
+func sseData(line string) (string, bool) {
+	data, ok := strings.CutPrefix(line, "data:")
+	if !ok {
+		return "", false
+	}
+	return strings.TrimSpace(data), true
+}
+
+// readErrorBody reads a bounded prefix of a failed response body
+func readErrorBody(r io.Reader) []byte {
+	body, _ := io.ReadAll(io.LimitReader(r, maxStreamErrorBytes))
+	return body
+}
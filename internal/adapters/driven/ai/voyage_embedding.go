@@ -0,0 +1,252 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Ensure VoyageEmbedding implements EmbeddingService
+var _ driven.EmbeddingService = (*VoyageEmbedding)(nil)
+
+// voyageEmbedBatchSize is the maximum number of texts Voyage accepts per request
+const voyageEmbedBatchSize = 128
+
+// voyageBytesPerToken is the bytes per token assumed when estimating the
+// tokens of a batch. It errs low, so that a CJK character (three bytes)
+// counts as a token.
+const voyageBytesPerToken = 3
+
+// Voyage input types. Voyage prepends a retrieval prompt based on the type.
+const (
+	voyageInputTypeDocument = "document"
+	voyageInputTypeQuery    = "query"
+)
+
+// Model dimensions for Voyage embedding models (default output dimension)
+var voyageModelDimensions = map[string]int{
+	"voyage-3.5":            1024,
+	"voyage-3.5-lite":       1024,
+	"voyage-3-large":        1024,
+	"voyage-3":              1024,
+	"voyage-3-lite":         512,
+	"voyage-code-3":         1024,
+	"voyage-finance-2":      1024,
+	"voyage-law-2":          1024,
+	"voyage-multilingual-2": 1024,
+	"voyage-large-2":        1536,
+	"voyage-code-2":         1536,
+	"voyage-2":              1024,
+}
+
+// Total tokens Voyage accepts per request, by model
+var voyageModelTokenLimits = map[string]int{
+	"voyage-3.5":      320_000,
+	"voyage-3.5-lite": 1_000_000,
+	"voyage-3":        320_000,
+	"voyage-3-lite":   1_000_000,
+	"voyage-2":        320_000,
+}
+
+// voyageDefaultTokenLimit is the token limit of other models
+const voyageDefaultTokenLimit = 120_000
+
+// VoyageEmbedding implements EmbeddingService using Voyage AI's embedding API
+type VoyageEmbedding struct {
+	apiKey     string
+	model      string
+	baseURL    string
+	dimensions int
+	tokenLimit int
+	client     *http.Client
+}
+
+// NewVoyageEmbedding creates a new Voyage embedding service
+func NewVoyageEmbedding(apiKey, model, baseURL string) (driven.EmbeddingService, error) {
+	if apiKey == "" {
+		return nil, fmt.Errorf("Voyage API key is required")
+	}
+
+	if model == "" {
+		model = "voyage-3"
+	}
+
+	if baseURL == "" {
+		baseURL = "https://api.voyageai.com/v1"
+	}
+	baseURL = strings.TrimRight(baseURL, "/")
+
+	dimensions, ok := voyageModelDimensions[model]
+	if !ok {
+		// Default to 1024, the size shared by most current Voyage models
+		dimensions = 1024
+	}
+
+	tokenLimit, ok := voyageModelTokenLimits[model]
+	if !ok {
+		tokenLimit = voyageDefaultTokenLimit
+	}
+
+	return &VoyageEmbedding{
+		apiKey:     apiKey,
+		model:      model,
+		baseURL:    baseURL,
+		dimensions: dimensions,
+		tokenLimit: tokenLimit,
+		client: &http.Client{
+			Timeout: 60 * time.Second,
+		},
+	}, nil
+}
+
+// voyageEmbedRequest is the request body for Voyage's embedding API
+type voyageEmbedRequest struct {
+	Input     []string `json:"input"`
+	Model     string   `json:"model"`
+	InputType string   `json:"input_type"`
+}
+
+// voyageEmbedResponse is the response from Voyage's embedding API
+type voyageEmbedResponse struct {
+	Object string `json:"object"`
+	Data   []struct {
+		Object    string    `json:"object"`
+		Index     int       `json:"index"`
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+	Model string `json:"model"`
+	Usage struct {
+		TotalTokens int `json:"total_tokens"`
+	} `json:"usage"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// Embed generates document embeddings for multiple texts
+func (e *VoyageEmbedding) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	return e.embed(ctx, texts, voyageInputTypeDocument)
+}
+
+// EmbedQuery generates an embedding for a search query
+func (e *VoyageEmbedding) EmbedQuery(ctx context.Context, query string) ([]float32, error) {
+	embeddings, err := e.embed(ctx, []string{query}, voyageInputTypeQuery)
+	if err != nil {
+		return nil, err
+	}
+	if len(embeddings) == 0 {
+		return nil, fmt.Errorf("no embedding returned for query")
+	}
+	return embeddings[0], nil
+}
+
+// embed sends texts in batches with the given input type
+func (e *VoyageEmbedding) embed(ctx context.Context, texts []string, inputType string) ([][]float32, error) {
+	if len(texts) == 0 {
+		return nil, nil
+	}
+
+	embeddings := make([][]float32, len(texts))
+	for start := 0; start < len(texts); {
+		end := e.batchEnd(texts, start)
+
+		resp, err := e.doRequest(ctx, voyageEmbedRequest{
+			Input:     texts[start:end],
+			Model:     e.model,
+			InputType: inputType,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(resp.Data) != end-start {
+			return nil, fmt.Errorf("Voyage returned %d embeddings for %d inputs", len(resp.Data), end-start)
+		}
+		// Place by index to ensure order matches input
+		for _, d := range resp.Data {
+			if d.Index < 0 || start+d.Index >= end {
+				return nil, fmt.Errorf("Voyage returned an embedding for unknown input %d", d.Index)
+			}
+			embeddings[start+d.Index] = d.Embedding
+		}
+		for i := start; i < end; i++ {
+			if embeddings[i] == nil {
+				return nil, fmt.Errorf("Voyage returned no embedding for input %d", i-start)
+			}
+		}
+		start = end
+	}
+
+	return embeddings, nil
+}
+
+// batchEnd returns the end of the batch of texts starting at start: at most
+// voyageEmbedBatchSize texts within the model's token limit, estimated at
+// voyageBytesPerToken. A text over the limit alone is sent by itself.
+func (e *VoyageEmbedding) batchEnd(texts []string, start int) int {
+	end := start
+	tokens := 0
+	for end < len(texts) && end-start < voyageEmbedBatchSize {
+		n := (len(texts[end]) + voyageBytesPerToken - 1) / voyageBytesPerToken
+		if end > start && tokens+n > e.tokenLimit {
+			break
+		}
+		tokens += n
+		end++
+	}
+	return end
+}
+
+// Dimensions returns the embedding dimension size
+func (e *VoyageEmbedding) Dimensions() int {
+	return e.dimensions
+}
+
+// Model returns the model name being used
+func (e *VoyageEmbedding) Model() string {
+	return e.model
+}
+
+// HealthCheck verifies the embedding service is available
+func (e *VoyageEmbedding) HealthCheck(ctx context.Context) error {
+	_, err := e.EmbedQuery(ctx, "health check")
+	return err
+}
+
+// Close releases resources held by the embedding service
+func (e *VoyageEmbedding) Close() error {
+	e.client.CloseIdleConnections()
+	return nil
+}
+
+// doRequest makes a request to the Voyage embedding API, retrying on rate limits
+func (e *VoyageEmbedding) doRequest(ctx context.Context, reqBody voyageEmbedRequest) (*voyageEmbedResponse, error) {
+	body, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	status, respBody, err := postJSONWithRetry(ctx, e.client, e.baseURL+"/embeddings", map[string]string{
+		"Authorization": "Bearer " + e.apiKey,
+	}, body)
+	if err != nil {
+		return nil, err
+	}
+
+	var embResp voyageEmbedResponse
+	if err := json.Unmarshal(respBody, &embResp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if status != http.StatusOK {
+		if embResp.Detail != "" {
+			return nil, fmt.Errorf("Voyage API error (status %d): %s", status, embResp.Detail)
+		}
+		return nil, fmt.Errorf("Voyage API returned status %d", status)
+	}
+
+	return &embResp, nil
+}
@@ -0,0 +1,229 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// voyageTestServer returns a server that answers with one vector per input
+// (in reverse index order) and records the input type of every request
+func voyageTestServer(t *testing.T, inputTypes *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/embeddings" {
+			t.Errorf("expected /embeddings, got %s", r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer pa-test" {
+			t.Error("expected Authorization header")
+		}
+
+		var req voyageEmbedRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+		if len(req.Input) > voyageEmbedBatchSize {
+			t.Errorf("batch of %d exceeds limit %d", len(req.Input), voyageEmbedBatchSize)
+		}
+		*inputTypes = append(*inputTypes, req.InputType)
+
+		var resp voyageEmbedResponse
+		for i := len(req.Input) - 1; i >= 0; i-- {
+			resp.Data = append(resp.Data, struct {
+				Object    string    `json:"object"`
+				Index     int       `json:"index"`
+				Embedding []float32 `json:"embedding"`
+			}{Object: "embedding", Index: i, Embedding: []float32{float32(i)}})
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+}
+
+func TestNewVoyageEmbedding_RequiresAPIKey(t *testing.T) {
+	_, err := NewVoyageEmbedding("", "voyage-3", "")
+	if err == nil {
+		t.Error("expected error for empty API key")
+	}
+}
+
+func TestNewVoyageEmbedding_Defaults(t *testing.T) {
+	svc, err := NewVoyageEmbedding("pa-test", "", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	emb := svc.(*VoyageEmbedding)
+	if emb.model != "voyage-3" {
+		t.Errorf("expected default model voyage-3, got %s", emb.model)
+	}
+	if emb.baseURL != "https://api.voyageai.com/v1" {
+		t.Errorf("expected default base URL, got %s", emb.baseURL)
+	}
+}
+
+func TestVoyageEmbedding_Dimensions(t *testing.T) {
+	for model, dim := range voyageModelDimensions {
+		t.Run(model, func(t *testing.T) {
+			svc, err := NewVoyageEmbedding("pa-test", model, "")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if svc.Dimensions() != dim {
+				t.Errorf("expected dimensions %d, got %d", dim, svc.Dimensions())
+			}
+		})
+	}
+}
+
+func TestVoyageEmbedding_InputTypes(t *testing.T) {
+	var inputTypes []string
+	server := voyageTestServer(t, &inputTypes)
+	defer server.Close()
+
+	svc, err := NewVoyageEmbedding("pa-test", "voyage-3", server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := svc.Embed(context.Background(), []string{"doc"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := svc.EmbedQuery(context.Background(), "query"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(inputTypes) != 2 || inputTypes[0] != "document" || inputTypes[1] != "query" {
+		t.Errorf("expected [document query], got %v", inputTypes)
+	}
+}
+
+func TestVoyageEmbedding_Embed_BatchingPreservesOrder(t *testing.T) {
+	var inputTypes []string
+	server := voyageTestServer(t, &inputTypes)
+	defer server.Close()
+
+	svc, err := NewVoyageEmbedding("pa-test", "voyage-3", server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	texts := make([]string, voyageEmbedBatchSize+3)
+	result, err := svc.Embed(context.Background(), texts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(inputTypes) != 2 {
+		t.Errorf("expected 2 requests, got %d", len(inputTypes))
+	}
+	if len(result) != len(texts) {
+		t.Fatalf("expected %d embeddings, got %d", len(texts), len(result))
+	}
+	if result[1][0] != 1 || result[voyageEmbedBatchSize+2][0] != 2 {
+		t.Error("expected embeddings to be placed by index within each batch")
+	}
+}
+
+func TestVoyageEmbedding_Embed_BatchesWithinTokenLimit(t *testing.T) {
+	var inputTypes []string
+	server := voyageTestServer(t, &inputTypes)
+	defer server.Close()
+
+	svc, err := NewVoyageEmbedding("pa-test", "voyage-3-large", server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Each text is about a sixth of the model's 120K token limit
+	texts := make([]string, 13)
+	for i := range texts {
+		texts[i] = strings.Repeat("a", 60000)
+	}
+	result, err := svc.Embed(context.Background(), texts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(inputTypes) != 3 {
+		t.Errorf("expected 3 requests of at most 6 texts, got %d", len(inputTypes))
+	}
+	if len(result) != len(texts) {
+		t.Errorf("expected %d embeddings, got %d", len(texts), len(result))
+	}
+}
+
+func TestVoyageEmbedding_Embed_MissingEmbeddings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[{"index":0,"embedding":[0.1]},{"index":0,"embedding":[0.2]}]}`))
+	}))
+	defer server.Close()
+
+	svc, err := NewVoyageEmbedding("pa-test", "voyage-3", server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := svc.Embed(context.Background(), []string{"a", "b", "c"}); err == nil {
+		t.Error("expected an error when fewer embeddings than inputs are returned")
+	}
+	if _, err := svc.Embed(context.Background(), []string{"a", "b"}); err == nil {
+		t.Error("expected an error when an input has no embedding")
+	}
+}
+
+func TestVoyageEmbedding_Embed_RetriesHonourRetryAfter(t *testing.T) {
+	defer func(d time.Duration) { rateLimitBaseDelay = d }(rateLimitBaseDelay)
+	rateLimitBaseDelay = time.Hour // would time out if Retry-After were ignored
+
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"detail": "rate limited"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": [{"index": 0, "embedding": [0.1]}]}`))
+	}))
+	defer server.Close()
+
+	svc, err := NewVoyageEmbedding("pa-test", "voyage-3", server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := svc.EmbedQuery(context.Background(), "query"); err != nil {
+		t.Fatalf("expected retry to succeed, got %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 calls, got %d", calls)
+	}
+}
+
+func TestVoyageEmbedding_Embed_GivesUpAfterMaxRetries(t *testing.T) {
+	defer func(d time.Duration) { rateLimitBaseDelay = d }(rateLimitBaseDelay)
+	rateLimitBaseDelay = time.Millisecond
+
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write([]byte(`{"detail": "rate limited"}`))
+	}))
+	defer server.Close()
+
+	svc, err := NewVoyageEmbedding("pa-test", "voyage-3", server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := svc.Embed(context.Background(), []string{"test"}); err == nil {
+		t.Error("expected error after exhausting retries")
+	}
+	if calls != rateLimitMaxRetries+1 {
+		t.Errorf("expected %d calls, got %d", rateLimitMaxRetries+1, calls)
+	}
+}
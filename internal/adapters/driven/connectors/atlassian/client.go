@@ -0,0 +1,230 @@
+package atlassian
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Client performs authenticated requests to the REST API of an Atlassian
+// product on one site. It authenticates with the installation's OAuth
+// token (Atlassian Cloud, through the API gateway) or API token (Atlassian
+// Cloud or Data Center, directly against the site).
+type Client struct {
+	tokenProvider driven.TokenProvider
+	httpClient    *http.Client
+	product       string // Gateway product name: "confluence" or "jira"
+	siteURL       string // Configured site URL; required for API tokens
+	contextPath   string // Path of the product on a Cloud site, e.g. "/wiki"
+	apiURL        string
+	maxRetries    int
+
+	mu      sync.Mutex
+	baseURL string // Resolved on first use
+	webURL  string // URL of the site in a browser, resolved with baseURL
+}
+
+// NewClient creates a client for product on the site at siteURL, including
+// the product's context path (e.g. https://acme.atlassian.net/wiki for
+// Confluence Cloud). contextPath is the product's path on Cloud sites,
+// appended to the API gateway URL when authenticating with OAuth. With
+// OAuth, siteURL may be empty to use the first site the token can access.
+func NewClient(tokenProvider driven.TokenProvider, product, siteURL, contextPath string) *Client {
+	return &Client{
+		tokenProvider: tokenProvider,
+		httpClient:    &http.Client{Timeout: 30 * time.Second},
+		product:       product,
+		siteURL:       strings.TrimSuffix(siteURL, "/"),
+		contextPath:   contextPath,
+		apiURL:        APIURL,
+		maxRetries:    3,
+	}
+}
+
+// SetMaxRetries sets how often a request failing with a server error is retried.
+func (c *Client) SetMaxRetries(maxRetries int) {
+	c.maxRetries = maxRetries
+}
+
+// Resource is an Atlassian Cloud site accessible with an OAuth token.
+type Resource struct {
+	ID     string   `json:"id"` // Cloud ID
+	URL    string   `json:"url"`
+	Name   string   `json:"name"`
+	Scopes []string `json:"scopes"`
+}
+
+// BaseURL returns the URL the product's REST API paths are relative to.
+// With OAuth, it is the gateway URL of the accessible site matching the
+// configured site URL, or else of the first site granting the product:
+// https://api.atlassian.com/ex/<product>/<cloud ID><context path>.
+// With API tokens, it is the configured site URL.
+func (c *Client) BaseURL(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.baseURL != "" {
+		return c.baseURL, nil
+	}
+
+	if c.tokenProvider.AuthMethod() != domain.AuthMethodOAuth2 {
+		if c.siteURL == "" {
+			return "", fmt.Errorf("%w: %s site URL is required for API token authentication", domain.ErrInvalidInput, c.product)
+		}
+		c.baseURL = c.siteURL
+		c.webURL = c.siteURL
+		return c.baseURL, nil
+	}
+
+	var resources []Resource
+	if err := c.do(ctx, c.apiURL+"/oauth/token/accessible-resources", &resources); err != nil {
+		return "", fmt.Errorf("get accessible resources: %w", err)
+	}
+
+	var match *Resource
+	for i := range resources {
+		resource := &resources[i]
+		if !grantsProduct(resource, c.product) {
+			continue
+		}
+		if c.siteURL == "" || strings.HasPrefix(c.siteURL, strings.TrimSuffix(resource.URL, "/")) {
+			match = resource
+			break
+		}
+	}
+	if match == nil {
+		return "", fmt.Errorf("%w: no accessible %s site matches %q", domain.ErrNotFound, c.product, c.siteURL)
+	}
+
+	c.baseURL = fmt.Sprintf("%s/ex/%s/%s%s", c.apiURL, c.product, match.ID, c.contextPath)
+	c.webURL = strings.TrimSuffix(match.URL, "/") + c.contextPath
+	return c.baseURL, nil
+}
+
+// WebURL returns the URL of the product on the site in a browser, e.g.
+// https://acme.atlassian.net for Jira Cloud, which web links are relative to.
+func (c *Client) WebURL(ctx context.Context) (string, error) {
+	if _, err := c.BaseURL(ctx); err != nil {
+		return "", err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.webURL, nil
+}
+
+// grantsProduct reports whether a resource's scopes include the product.
+func grantsProduct(resource *Resource, product string) bool {
+	for _, scope := range resource.Scopes {
+		if strings.Contains(scope, product) {
+			return true
+		}
+	}
+	return false
+}
+
+// Get performs a GET request and decodes the JSON response into out. path
+// is relative to BaseURL, or an absolute URL.
+func (c *Client) Get(ctx context.Context, path string, out any) error {
+	endpoint := path
+	if !strings.HasPrefix(path, "http://") && !strings.HasPrefix(path, "https://") {
+		baseURL, err := c.BaseURL(ctx)
+		if err != nil {
+			return err
+		}
+		endpoint = baseURL + path
+	}
+	return c.do(ctx, endpoint, out)
+}
+
+// do performs an authenticated GET request with retry logic and decodes
+// the JSON response into out.
+func (c *Client) do(ctx context.Context, endpoint string, out any) error {
+	var resp *http.Response
+	for attempt := 0; attempt <= c.maxRetries; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+		if err := c.authorize(ctx, req); err != nil {
+			return err
+		}
+		req.Header.Set("Accept", "application/json")
+
+		resp, err = c.httpClient.Do(req)
+		if err != nil {
+			return fmt.Errorf("do request: %w", err)
+		}
+
+		// Success or non-retryable error
+		if resp.StatusCode < 500 {
+			break
+		}
+
+		// Server error - retry with exponential backoff
+		if attempt == c.maxRetries {
+			break
+		}
+		resp.Body.Close()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Duration(attempt+1) * time.Second):
+		}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		body, _ := io.ReadAll(resp.Body)
+		apiErr := fmt.Errorf("%s API error %d: %s", c.product, resp.StatusCode, string(body))
+		switch resp.StatusCode {
+		case http.StatusNotFound:
+			return fmt.Errorf("%w: %v", domain.ErrNotFound, apiErr)
+		case http.StatusTooManyRequests:
+			return &domain.RateLimitError{RetryAfter: retryAfter(resp), Err: apiErr}
+		}
+		return apiErr
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	return nil
+}
+
+// authorize sets the Authorization header of req. OAuth access tokens and
+// Data Center personal access tokens are sent as bearer tokens. An API
+// token of the form "email:token" is an Atlassian Cloud API token, sent
+// with basic authentication.
+func (c *Client) authorize(ctx context.Context, req *http.Request) error {
+	token, err := c.tokenProvider.GetAccessToken(ctx)
+	if err != nil {
+		return fmt.Errorf("get access token: %w", err)
+	}
+
+	if c.tokenProvider.AuthMethod() != domain.AuthMethodOAuth2 {
+		if email, apiToken, ok := strings.Cut(token, ":"); ok && strings.Contains(email, "@") {
+			req.SetBasicAuth(email, apiToken)
+			return nil
+		}
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// retryAfter returns how long Atlassian asked a rate-limited client to
+// wait (zero if unknown).
+func retryAfter(resp *http.Response) time.Duration {
+	if secs, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second
+	}
+	return 0
+}
@@ -0,0 +1,128 @@
+package atlassian
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// oauthTokenProvider is a TokenProvider for a fixed OAuth access token.
+type oauthTokenProvider struct {
+	token string
+}
+
+func (p *oauthTokenProvider) GetAccessToken(ctx context.Context) (string, error) {
+	return p.token, nil
+}
+
+func (p *oauthTokenProvider) GetCredentials(ctx context.Context) (*domain.Credentials, error) {
+	return &domain.Credentials{AuthMethod: domain.AuthMethodOAuth2, AccessToken: p.token}, nil
+}
+
+func (p *oauthTokenProvider) AuthMethod() domain.AuthMethod {
+	return domain.AuthMethodOAuth2
+}
+
+func (p *oauthTokenProvider) IsValid(ctx context.Context) bool {
+	return true
+}
+
+func TestClient_OAuthSite(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		if r.Header.Get("Authorization") != "Bearer oauth-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/oauth/token/accessible-resources":
+			_ = json.NewEncoder(w).Encode([]map[string]any{
+				{"id": "jira-only", "url": "https://acme.atlassian.net", "scopes": []string{"read:jira-work"}},
+				{"id": "cloud-1", "url": "https://other.atlassian.net", "scopes": []string{"read:confluence-content.all"}},
+				{"id": "cloud-2", "url": "https://acme.atlassian.net", "scopes": []string{"read:confluence-content.all"}},
+			})
+		case "/ex/confluence/cloud-2/wiki/rest/api/space":
+			_ = json.NewEncoder(w).Encode(map[string]any{"size": 0})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(&oauthTokenProvider{token: "oauth-token"}, "confluence", "https://acme.atlassian.net/wiki", "/wiki")
+	c.apiURL = server.URL
+
+	var out map[string]any
+	if err := c.Get(context.Background(), "/rest/api/space", &out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := c.Get(context.Background(), "/rest/api/space", &out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(paths) != 3 {
+		t.Errorf("expected the site to be resolved once, got requests %v", paths)
+	}
+	if webURL, _ := c.WebURL(context.Background()); webURL != "https://acme.atlassian.net/wiki" {
+		t.Errorf("unexpected web URL %q", webURL)
+	}
+
+	c = NewClient(&oauthTokenProvider{token: "oauth-token"}, "confluence", "https://missing.atlassian.net/wiki", "/wiki")
+	c.apiURL = server.URL
+	if _, err := c.BaseURL(context.Background()); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for an inaccessible site, got %v", err)
+	}
+}
+
+func TestClient_APIToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); ok && user == "ann@example.com" && pass == "secret" {
+			_ = json.NewEncoder(w).Encode(map[string]any{"auth": "basic"})
+			return
+		}
+		if r.Header.Get("Authorization") == "Bearer dc-pat" {
+			_ = json.NewEncoder(w).Encode(map[string]any{"auth": "bearer"})
+			return
+		}
+		if r.URL.Path == "/limited" {
+			w.Header().Set("Retry-After", "2")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	for apiKey, want := range map[string]string{"ann@example.com:secret": "basic", "dc-pat": "bearer"} {
+		tokenProvider := driven.NewStaticTokenProvider(&domain.Credentials{AuthMethod: domain.AuthMethodAPIKey, APIKey: apiKey})
+		c := NewClient(tokenProvider, "jira", server.URL, "")
+
+		var out map[string]string
+		if err := c.Get(context.Background(), "/rest/api/2/myself", &out); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if out["auth"] != want {
+			t.Errorf("expected %s authentication for %q, got %q", want, apiKey, out["auth"])
+		}
+	}
+
+	tokenProvider := driven.NewStaticTokenProvider(&domain.Credentials{AuthMethod: domain.AuthMethodAPIKey, APIKey: "x"})
+	var out map[string]string
+	err := NewClient(tokenProvider, "jira", server.URL, "").Get(context.Background(), "/limited", &out)
+	var rateLimitErr *domain.RateLimitError
+	if !errors.As(err, &rateLimitErr) || rateLimitErr.RetryAfter != 2*time.Second {
+		t.Errorf("expected a rate limit error retrying after 2s, got %v", err)
+	}
+
+	err = NewClient(tokenProvider, "jira", "", "").Get(context.Background(), "/rest/api/2/myself", &out)
+	if !errors.Is(err, domain.ErrInvalidInput) {
+		t.Errorf("expected ErrInvalidInput without a site URL, got %v", err)
+	}
+}
@@ -0,0 +1,205 @@
+// Package atlassian provides the authentication and REST client shared by
+// the Atlassian connectors (Confluence and Jira): OAuth 2.0 (3LO) for
+// Atlassian Cloud, and API tokens for Atlassian Cloud and Data Center.
+package atlassian
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-core/internal/adapters/driven/connectors"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Ensure OAuthHandler implements the interface.
+var _ connectors.OAuthHandler = (*OAuthHandler)(nil)
+
+// Atlassian Cloud OAuth 2.0 (3LO) endpoints.
+const (
+	AuthURL     = "https://auth.atlassian.com/authorize"
+	TokenURL    = "https://auth.atlassian.com/oauth/token"
+	APIURL      = "https://api.atlassian.com"
+	UserInfoURL = APIURL + "/me"
+)
+
+// OAuthHandler handles OAuth operations for an Atlassian Cloud product.
+// Products differ only in their scopes.
+type OAuthHandler struct {
+	httpClient *http.Client
+	scopes     []string
+	authURL    string
+	tokenURL   string
+	apiURL     string
+}
+
+// NewOAuthHandler creates an OAuth handler requesting the given scopes by
+// default. Scopes should include "offline_access" for a refresh token and
+// "read:me" for user info.
+func NewOAuthHandler(scopes []string) *OAuthHandler {
+	return &OAuthHandler{
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		scopes:     scopes,
+		authURL:    AuthURL,
+		tokenURL:   TokenURL,
+		apiURL:     APIURL,
+	}
+}
+
+// BuildAuthURL constructs the Atlassian OAuth authorization URL.
+// Atlassian does not support PKCE, so codeChallenge is ignored.
+func (h *OAuthHandler) BuildAuthURL(clientID, redirectURI, state, codeChallenge string, scopes []string) string {
+	params := url.Values{
+		"audience":      {"api.atlassian.com"},
+		"client_id":     {clientID},
+		"redirect_uri":  {redirectURI},
+		"state":         {state},
+		"scope":         {strings.Join(scopes, " ")},
+		"response_type": {"code"},
+		"prompt":        {"consent"},
+	}
+	return h.authURL + "?" + params.Encode()
+}
+
+// ExchangeCode exchanges an authorization code for tokens.
+func (h *OAuthHandler) ExchangeCode(ctx context.Context, clientID, clientSecret, code, redirectURI, codeVerifier string) (*driven.OAuthToken, error) {
+	token, err := h.requestToken(ctx, map[string]string{
+		"grant_type":    "authorization_code",
+		"client_id":     clientID,
+		"client_secret": clientSecret,
+		"code":          code,
+		"redirect_uri":  redirectURI,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("token exchange failed: %w", err)
+	}
+	return token, nil
+}
+
+// RefreshToken refreshes an expired access token.
+// Atlassian access tokens expire after an hour; refresh tokens rotate, so
+// each refresh also replaces the refresh token.
+func (h *OAuthHandler) RefreshToken(ctx context.Context, clientID, clientSecret, refreshToken string) (*driven.OAuthToken, error) {
+	token, err := h.requestToken(ctx, map[string]string{
+		"grant_type":    "refresh_token",
+		"client_id":     clientID,
+		"client_secret": clientSecret,
+		"refresh_token": refreshToken,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("token refresh failed: %w", err)
+	}
+	return token, nil
+}
+
+// requestToken posts params to the token endpoint as JSON.
+func (h *OAuthHandler) requestToken(ctx context.Context, params map[string]string) (*driven.OAuthToken, error) {
+	payload, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("encode request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", h.tokenURL, bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("do request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+
+	var tokenResp struct {
+		AccessToken  string `json:"access_token"`
+		TokenType    string `json:"token_type"`
+		Scope        string `json:"scope"`
+		RefreshToken string `json:"refresh_token"`
+		ExpiresIn    int    `json:"expires_in"`
+		Error        string `json:"error"`
+		ErrorDesc    string `json:"error_description"`
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		if json.Unmarshal(body, &tokenResp) == nil && tokenResp.Error != "" {
+			return nil, fmt.Errorf("oauth error: %s - %s", tokenResp.Error, tokenResp.ErrorDesc)
+		}
+		return nil, fmt.Errorf("status %d: %s", resp.StatusCode, string(body))
+	}
+
+	if err := json.Unmarshal(body, &tokenResp); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+
+	return &driven.OAuthToken{
+		AccessToken:  tokenResp.AccessToken,
+		RefreshToken: tokenResp.RefreshToken,
+		TokenType:    tokenResp.TokenType,
+		Scope:        tokenResp.Scope,
+		ExpiresIn:    tokenResp.ExpiresIn,
+	}, nil
+}
+
+// GetUserInfo fetches the authenticated user's Atlassian account.
+func (h *OAuthHandler) GetUserInfo(ctx context.Context, accessToken string) (*driven.OAuthUserInfo, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", h.apiURL+"/me", nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("do request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("get user info failed: %s", string(body))
+	}
+
+	var user struct {
+		AccountID string `json:"account_id"`
+		Email     string `json:"email"`
+		Name      string `json:"name"`
+		Picture   string `json:"picture"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
+		return nil, fmt.Errorf("decode user: %w", err)
+	}
+
+	return &driven.OAuthUserInfo{
+		ID:       user.AccountID,
+		Email:    user.Email,
+		Name:     user.Name,
+		ImageURL: user.Picture,
+	}, nil
+}
+
+// DefaultConfig returns the product's default OAuth configuration.
+func (h *OAuthHandler) DefaultConfig() connectors.OAuthDefaults {
+	return connectors.OAuthDefaults{
+		AuthURL:      AuthURL,
+		TokenURL:     TokenURL,
+		Scopes:       h.scopes,
+		UserInfoURL:  UserInfoURL,
+		SupportsPKCE: false,
+	}
+}
@@ -0,0 +1,74 @@
+package confluence
+
+import (
+	"context"
+
+	"github.com/custodia-labs/sercha-core/internal/adapters/driven/connectors"
+	"github.com/custodia-labs/sercha-core/internal/adapters/driven/connectors/atlassian"
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Ensure Builder implements the interfaces.
+var (
+	_ driven.ConnectorBuilder = (*Builder)(nil)
+	_ driven.CursorMerger     = (*Builder)(nil)
+)
+
+// Builder creates Confluence connectors.
+type Builder struct {
+	config *Config
+}
+
+// NewBuilder creates a new Confluence connector builder.
+func NewBuilder() *Builder {
+	return &Builder{
+		config: DefaultConfig(),
+	}
+}
+
+// NewBuilderWithConfig creates a builder with custom configuration,
+// e.g. for a Confluence site authenticated with API tokens.
+func NewBuilderWithConfig(config *Config) *Builder {
+	return &Builder{
+		config: config,
+	}
+}
+
+// Type returns the provider type.
+func (b *Builder) Type() domain.ProviderType {
+	return domain.ProviderTypeConfluence
+}
+
+// Build creates a Confluence connector scoped to a space.
+// containerID is a space key, e.g. "ENG". Without one, the connector syncs
+// the source's configured space keys, or else every space.
+func (b *Builder) Build(ctx context.Context, tokenProvider driven.TokenProvider, containerID string) (driven.Connector, error) {
+	return NewConnector(tokenProvider, containerID, b.config), nil
+}
+
+// SupportsOAuth returns true - Confluence Cloud supports OAuth 2.0 (3LO).
+func (b *Builder) SupportsOAuth() bool {
+	return true
+}
+
+// OAuthConfig returns Confluence Cloud OAuth configuration.
+func (b *Builder) OAuthConfig() *driven.OAuthConfig {
+	return &driven.OAuthConfig{
+		AuthURL:     atlassian.AuthURL,
+		TokenURL:    atlassian.TokenURL,
+		Scopes:      defaultScopes,
+		UserInfoURL: atlassian.UserInfoURL,
+	}
+}
+
+// SupportsContainerSelection returns true - Confluence supports space selection.
+func (b *Builder) SupportsContainerSelection() bool {
+	return true
+}
+
+// MergeCursors combines the cursors of two spaces of one sync. Confluence
+// cursors hold the latest modification time seen in each space.
+func (b *Builder) MergeCursors(cursor, other string) string {
+	return connectors.MergeTimeCursors(cursor, other)
+}
@@ -0,0 +1,165 @@
+package confluence
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/custodia-labs/sercha-core/internal/adapters/driven/connectors/atlassian"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// contentExpand are the fields expanded on content: everything a document
+// is built from.
+const contentExpand = "body.storage,version,ancestors,metadata.labels,space,history"
+
+// Client provides Confluence REST API (v1) operations.
+type Client struct {
+	api      *atlassian.Client
+	pageSize int
+}
+
+// NewClient creates a new Confluence API client for the Confluence at
+// baseURL (see Config.BaseURL).
+func NewClient(tokenProvider driven.TokenProvider, baseURL string) *Client {
+	return &Client{
+		api:      atlassian.NewClient(tokenProvider, "confluence", baseURL, "/wiki"),
+		pageSize: 25,
+	}
+}
+
+// Space represents a Confluence space.
+type Space struct {
+	ID          int64  `json:"id"`
+	Key         string `json:"key"`
+	Name        string `json:"name"`
+	Type        string `json:"type"` // "global" or "personal"
+	Status      string `json:"status"`
+	Description struct {
+		Plain struct {
+			Value string `json:"value"`
+		} `json:"plain"`
+	} `json:"description"`
+	Links Links `json:"_links"`
+}
+
+// Content represents a Confluence page or blog post.
+type Content struct {
+	ID        string     `json:"id"`
+	Type      string     `json:"type"`   // "page" or "blogpost"
+	Status    string     `json:"status"` // "current", "trashed", "draft"...
+	Title     string     `json:"title"`
+	Space     *Space     `json:"space"`
+	Ancestors []*Content `json:"ancestors"`
+	Version   struct {
+		Number int       `json:"number"`
+		When   time.Time `json:"when"`
+		By     *User     `json:"by"`
+	} `json:"version"`
+	History struct {
+		CreatedDate time.Time `json:"createdDate"`
+		CreatedBy   *User     `json:"createdBy"`
+	} `json:"history"`
+	Metadata struct {
+		Labels struct {
+			Results []*Label `json:"results"`
+		} `json:"labels"`
+	} `json:"metadata"`
+	Body struct {
+		Storage struct {
+			Value string `json:"value"` // Storage format XHTML
+		} `json:"storage"`
+	} `json:"body"`
+	Links Links `json:"_links"`
+}
+
+// Label represents a label on content.
+type Label struct {
+	Prefix string `json:"prefix"`
+	Name   string `json:"name"`
+}
+
+// User represents a Confluence user.
+type User struct {
+	AccountID   string `json:"accountId"`
+	Username    string `json:"username"` // Data Center
+	DisplayName string `json:"displayName"`
+}
+
+// Links are the links of a REST resource or page of results.
+type Links struct {
+	Base  string `json:"base"`  // Confluence URL, on pages of results and single resources
+	WebUI string `json:"webui"` // Relative to Base
+	Next  string `json:"next"`  // Next page of results, relative to Base
+}
+
+// ListSpacesResponse is the response from listing spaces.
+type ListSpacesResponse struct {
+	Spaces     []*Space
+	BaseURL    string // Confluence URL the spaces' web links are relative to
+	NextCursor string
+}
+
+// ListSpaces lists a page of the current spaces. The cursor is the offset
+// of the page (empty for the first page); the returned cursor is empty on
+// the last page.
+func (c *Client) ListSpaces(ctx context.Context, cursor string) (*ListSpacesResponse, error) {
+	start, _ := strconv.Atoi(cursor)
+	path := fmt.Sprintf("/rest/api/space?status=current&expand=description.plain&limit=%d&start=%d", c.pageSize, start)
+
+	var resp struct {
+		Results []*Space `json:"results"`
+		Links   Links    `json:"_links"`
+	}
+	if err := c.api.Get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+
+	nextCursor := ""
+	if resp.Links.Next != "" && len(resp.Results) > 0 {
+		nextCursor = strconv.Itoa(start + len(resp.Results))
+	}
+	return &ListSpacesResponse{Spaces: resp.Results, BaseURL: resp.Links.Base, NextCursor: nextCursor}, nil
+}
+
+// GetSpace gets a space by key.
+func (c *Client) GetSpace(ctx context.Context, key string) (*Space, error) {
+	var space Space
+	if err := c.api.Get(ctx, "/rest/api/space/"+url.PathEscape(key), &space); err != nil {
+		return nil, err
+	}
+	return &space, nil
+}
+
+// SearchContent searches content with CQL, expanded with everything a
+// document is built from. next is empty for the first page and otherwise
+// the cursor returned with the previous page; the returned cursor is empty
+// on the last page. The Confluence URL is returned to resolve web links.
+func (c *Client) SearchContent(ctx context.Context, cql, next string) (contents []*Content, baseURL, nextCursor string, err error) {
+	path := next
+	if path == "" {
+		path = fmt.Sprintf("/rest/api/content/search?cql=%s&expand=%s&limit=%d",
+			url.QueryEscape(cql), url.QueryEscape(contentExpand), c.pageSize)
+	}
+
+	var resp struct {
+		Results []*Content `json:"results"`
+		Links   Links      `json:"_links"`
+	}
+	if err := c.api.Get(ctx, path, &resp); err != nil {
+		return nil, "", "", err
+	}
+	return resp.Results, resp.Links.Base, resp.Links.Next, nil
+}
+
+// GetContent gets a page or blog post by ID.
+func (c *Client) GetContent(ctx context.Context, id string) (*Content, error) {
+	var content Content
+	path := fmt.Sprintf("/rest/api/content/%s?expand=%s", url.PathEscape(id), url.QueryEscape(contentExpand))
+	if err := c.api.Get(ctx, path, &content); err != nil {
+		return nil, err
+	}
+	return &content, nil
+}
@@ -0,0 +1,39 @@
+package confluence
+
+import "time"
+
+// Config contains configuration for the Confluence connector.
+type Config struct {
+	// BaseURL is the Confluence URL including its context path, e.g.
+	// https://acme.atlassian.net/wiki for Confluence Cloud or
+	// https://confluence.example.com for Data Center. It is required for
+	// API token authentication. With OAuth it selects the Cloud site; if
+	// empty, the first site the token can access is used.
+	BaseURL string
+
+	// PageSize is the number of items to fetch per page.
+	// Confluence returns at most 50 items with their bodies.
+	PageSize int
+
+	// MaxRetries is the maximum number of retry attempts for failed requests.
+	MaxRetries int
+
+	// ContentTypes are the content types to index: "page" and "blogpost".
+	ContentTypes []string
+
+	// ModifiedOverlap is how long before the cursor incremental syncs
+	// search. CQL compares lastmodified at minute precision in the user's
+	// time zone, so the search starts early enough to cover any time zone;
+	// content whose version is not newer than the cursor is skipped.
+	ModifiedOverlap time.Duration
+}
+
+// DefaultConfig returns the default Confluence connector configuration.
+func DefaultConfig() *Config {
+	return &Config{
+		PageSize:        25,
+		MaxRetries:      3,
+		ContentTypes:    []string{"page", "blogpost"},
+		ModifiedOverlap: 24 * time.Hour,
+	}
+}
@@ -0,0 +1,255 @@
+package confluence
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-core/internal/adapters/driven/connectors"
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Ensure Connector implements the interface.
+var _ driven.Connector = (*Connector)(nil)
+
+// MimeTypeStorage is the MIME type of Confluence content in storage
+// format, the XHTML Confluence stores pages and blog posts in.
+const MimeTypeStorage = "application/x-confluence-storage"
+
+// cqlTimeFormat is the format of dates in CQL queries.
+const cqlTimeFormat = "2006/01/02 15:04"
+
+// Connector fetches pages and blog posts from Confluence spaces.
+type Connector struct {
+	tokenProvider driven.TokenProvider
+	spaceKey      string // Empty for the source's configured spaces
+	client        *Client
+	config        *Config
+}
+
+// NewConnector creates a Confluence connector scoped to a space, or to the
+// source's configured spaces if spaceKey is empty.
+func NewConnector(tokenProvider driven.TokenProvider, spaceKey string, config *Config) *Connector {
+	if config == nil {
+		config = DefaultConfig()
+	}
+	client := NewClient(tokenProvider, config.BaseURL)
+	if config.PageSize > 0 && config.PageSize <= 50 {
+		client.pageSize = config.PageSize
+	}
+	if config.MaxRetries > 0 {
+		client.api.SetMaxRetries(config.MaxRetries)
+	}
+	return &Connector{
+		tokenProvider: tokenProvider,
+		spaceKey:      spaceKey,
+		client:        client,
+		config:        config,
+	}
+}
+
+// Type returns the provider type.
+func (c *Connector) Type() domain.ProviderType {
+	return domain.ProviderTypeConfluence
+}
+
+// ValidateConfig validates source configuration.
+func (c *Connector) ValidateConfig(config domain.SourceConfig) error {
+	for _, key := range config.SpaceKeys {
+		if strings.TrimSpace(key) == "" {
+			return fmt.Errorf("%w: empty space key", domain.ErrInvalidInput)
+		}
+		if strings.ContainsAny(key, `"\`) {
+			return fmt.Errorf("%w: invalid space key %q", domain.ErrInvalidInput, key)
+		}
+	}
+	return nil
+}
+
+// FetchChanges fetches the pages and blog posts changed in the connector's
+// spaces, searching with CQL ordered by last modification.
+// For initial sync (empty cursor), it fetches all current content.
+// For incremental sync, it fetches the content modified after the space's
+// position in the cursor. Deleted content does not show up in searches, so
+// deletions are reconciled by full syncs.
+func (c *Connector) FetchChanges(ctx context.Context, source *domain.Source, cursor string) ([]*domain.Change, string, error) {
+	since := connectors.ParseTimeCursor(cursor).Since(c.spaceKey)
+
+	cql := c.buildCQL(c.spaceKeys(source), since)
+
+	var changes []*domain.Change
+	var lastModified time.Time
+	next := ""
+	for {
+		contents, baseURL, nextCursor, err := c.client.SearchContent(ctx, cql, next)
+		if err != nil {
+			return nil, "", fmt.Errorf("search content: %w", err)
+		}
+
+		for _, content := range contents {
+			// The search starts before the cursor; skip what was synced
+			if since != nil && !content.Version.When.After(*since) {
+				continue
+			}
+
+			change := &domain.Change{
+				Type:       domain.ChangeTypeModified,
+				ExternalID: externalID(content),
+				Document:   c.contentToDocument(content, baseURL),
+				Content:    content.Body.Storage.Value,
+			}
+			if since == nil {
+				change.Type = domain.ChangeTypeAdded
+			}
+			changes = append(changes, change)
+
+			if content.Version.When.After(lastModified) {
+				lastModified = content.Version.When
+			}
+		}
+
+		if nextCursor == "" {
+			break
+		}
+		next = nextCursor
+	}
+
+	// Move the space's position to the latest modified time, keeping it
+	// when nothing changed
+	return changes, connectors.ContainerTimeCursor(c.spaceKey, since, lastModified), nil
+}
+
+// spaceKeys returns the keys of the spaces the connector is scoped to: its
+// space, else the source's configured spaces. It is empty for every space.
+func (c *Connector) spaceKeys(source *domain.Source) []string {
+	if c.spaceKey != "" {
+		return []string{c.spaceKey}
+	}
+	var keys []string
+	for _, key := range source.Config.SpaceKeys {
+		if key = strings.TrimSpace(key); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// buildCQL builds the CQL query selecting the content to sync. CQL
+// compares lastmodified at minute precision in the user's time zone, so
+// incremental queries start ModifiedOverlap before since.
+func (c *Connector) buildCQL(spaceKeys []string, since *time.Time) string {
+	contentTypes := c.config.ContentTypes
+	if len(contentTypes) == 0 {
+		contentTypes = DefaultConfig().ContentTypes
+	}
+
+	clauses := []string{"type in (" + strings.Join(contentTypes, ",") + ")"}
+	if len(spaceKeys) > 0 {
+		quoted := make([]string, len(spaceKeys))
+		for i, key := range spaceKeys {
+			quoted[i] = strconv.Quote(key)
+		}
+		clauses = append(clauses, "space in ("+strings.Join(quoted, ",")+")")
+	}
+	if since != nil {
+		from := since.Add(-c.config.ModifiedOverlap).UTC()
+		clauses = append(clauses, fmt.Sprintf("lastmodified >= %q", from.Format(cqlTimeFormat)))
+	}
+
+	return strings.Join(clauses, " AND ") + " ORDER BY lastmodified ASC"
+}
+
+// FetchDocument fetches a single page or blog post by external ID.
+func (c *Connector) FetchDocument(ctx context.Context, source *domain.Source, externalID string) (*domain.Change, error) {
+	_, id, ok := strings.Cut(externalID, "-")
+	if !ok || id == "" {
+		return nil, fmt.Errorf("invalid external ID format: %s", externalID)
+	}
+
+	content, err := c.client.GetContent(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get content: %w", err)
+	}
+	if content.Status != "current" {
+		return nil, fmt.Errorf("%w: content %s is %s", domain.ErrNotFound, id, content.Status)
+	}
+
+	return &domain.Change{
+		Type:       domain.ChangeTypeModified,
+		ExternalID: externalID,
+		Document:   c.contentToDocument(content, content.Links.Base),
+		Content:    content.Body.Storage.Value,
+	}, nil
+}
+
+// TestConnection tests the connection to the space, or to Confluence.
+func (c *Connector) TestConnection(ctx context.Context, source *domain.Source) error {
+	if c.spaceKey != "" {
+		_, err := c.client.GetSpace(ctx, c.spaceKey)
+		return err
+	}
+	_, err := c.client.ListSpaces(ctx, "")
+	return err
+}
+
+// externalID formats the external ID of content.
+// Format: "<type>-<content ID>", e.g. "page-123456"
+func externalID(content *Content) string {
+	return content.Type + "-" + content.ID
+}
+
+// contentToDocument converts a Confluence page or blog post to a domain
+// document. baseURL is the Confluence URL web links are relative to.
+func (c *Connector) contentToDocument(content *Content, baseURL string) *domain.Document {
+	metadata := map[string]string{
+		"type":    content.Type,
+		"status":  content.Status,
+		"version": strconv.Itoa(content.Version.Number),
+	}
+
+	if content.Space != nil {
+		metadata["space"] = content.Space.Key
+		metadata["space_name"] = content.Space.Name
+	}
+
+	// Ancestors are ordered from the root page down to the parent
+	if len(content.Ancestors) > 0 {
+		titles := make([]string, len(content.Ancestors))
+		ids := make([]string, len(content.Ancestors))
+		for i, ancestor := range content.Ancestors {
+			titles[i] = ancestor.Title
+			ids[i] = ancestor.ID
+		}
+		metadata["ancestry"] = strings.Join(titles, " / ")
+		metadata["ancestor_ids"] = strings.Join(ids, ",")
+	}
+
+	if labels := content.Metadata.Labels.Results; len(labels) > 0 {
+		names := make([]string, len(labels))
+		for i, label := range labels {
+			names[i] = label.Name
+		}
+		metadata["labels"] = strings.Join(names, ",")
+	}
+
+	if author := content.History.CreatedBy; author != nil {
+		metadata["author"] = author.DisplayName
+	}
+
+	path := ""
+	if content.Links.WebUI != "" {
+		path = strings.TrimSuffix(baseURL, "/") + content.Links.WebUI
+	}
+
+	return &domain.Document{
+		Title:     content.Title,
+		Path:      path,
+		MimeType:  MimeTypeStorage,
+		Metadata:  metadata,
+		CreatedAt: content.History.CreatedDate,
+		UpdatedAt: content.Version.When,
+	}
+}
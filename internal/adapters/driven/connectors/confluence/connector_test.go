@@ -0,0 +1,211 @@
+package confluence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// newTestServer serves a Confluence site with the space ENG holding a page
+// nested under two ancestors, across two pages of search results. Searches
+// are recorded by their CQL.
+func newTestServer(t *testing.T) (*httptest.Server, *[]string) {
+	t.Helper()
+	var queries []string
+
+	page := func(id, title, when string) map[string]any {
+		return map[string]any{
+			"id": id, "type": "page", "status": "current", "title": title,
+			"space":     map[string]any{"key": "ENG", "name": "Engineering"},
+			"ancestors": []any{map[string]any{"id": "1", "title": "Home"}, map[string]any{"id": "2", "title": "Runbooks"}},
+			"version":   map[string]any{"number": 3, "when": when},
+			"history": map[string]any{
+				"createdDate": "2026-01-05T09:00:00.000Z",
+				"createdBy":   map[string]any{"accountId": "a1", "displayName": "Ann"},
+			},
+			"metadata": map[string]any{"labels": map[string]any{"results": []any{
+				map[string]any{"prefix": "global", "name": "ops"}, map[string]any{"prefix": "global", "name": "oncall"},
+			}}},
+			"body":   map[string]any{"storage": map[string]any{"value": "<p>Restart the <strong>api</strong>.</p>"}},
+			"_links": map[string]any{"webui": "/spaces/ENG/pages/" + id},
+		}
+	}
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "ann@example.com" || pass != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+
+		base := server.URL + "/wiki"
+		switch {
+		case r.URL.Path == "/wiki/rest/api/space/ENG":
+			_ = json.NewEncoder(w).Encode(map[string]any{"key": "ENG", "name": "Engineering"})
+		case r.URL.Path == "/wiki/rest/api/space":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"results": []any{map[string]any{
+					"key": "ENG", "name": "Engineering", "type": "global",
+					"description": map[string]any{"plain": map[string]any{"value": "Team docs"}},
+					"_links":      map[string]any{"webui": "/spaces/ENG"},
+				}},
+				"_links": map[string]any{"base": base},
+			})
+		case r.URL.Path == "/wiki/rest/api/content/search" && r.URL.Query().Get("cursor") == "":
+			queries = append(queries, r.URL.Query().Get("cql"))
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"results": []any{page("10", "Restarts", "2026-03-01T10:00:00.000Z")},
+				"_links":  map[string]any{"base": base, "next": "/rest/api/content/search?cursor=abc"},
+			})
+		case r.URL.Path == "/wiki/rest/api/content/search":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"results": []any{page("11", "Failover", "2026-03-02T10:00:00.000Z")},
+				"_links":  map[string]any{"base": base},
+			})
+		case r.URL.Path == "/wiki/rest/api/content/10":
+			content := page("10", "Restarts", "2026-03-01T10:00:00.000Z")
+			content["_links"].(map[string]any)["base"] = base
+			_ = json.NewEncoder(w).Encode(content)
+		case r.URL.Path == "/wiki/rest/api/content/12":
+			content := page("12", "Old", "2026-03-01T10:00:00.000Z")
+			content["status"] = "trashed"
+			_ = json.NewEncoder(w).Encode(content)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, &queries
+}
+
+func newTestConnector(baseURL, spaceKey string) *Connector {
+	config := DefaultConfig()
+	config.BaseURL = baseURL
+	tokenProvider := driven.NewStaticTokenProvider(&domain.Credentials{AuthMethod: domain.AuthMethodAPIKey, APIKey: "ann@example.com:secret"})
+	return NewConnector(tokenProvider, spaceKey, config)
+}
+
+func TestConnector_FetchChanges(t *testing.T) {
+	server, queries := newTestServer(t)
+	c := newTestConnector(server.URL+"/wiki", "ENG")
+
+	changes, cursor, err := c.FetchChanges(context.Background(), &domain.Source{}, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(changes) != 2 {
+		t.Fatalf("expected 2 pages across both result pages, got %d changes", len(changes))
+	}
+
+	change := changes[0]
+	if change.Type != domain.ChangeTypeAdded || change.ExternalID != "page-10" || change.Document.MimeType != MimeTypeStorage {
+		t.Errorf("unexpected change: %+v", change)
+	}
+	if change.Content != "<p>Restart the <strong>api</strong>.</p>" {
+		t.Errorf("expected the storage format body, got %q", change.Content)
+	}
+	if change.Document.Path != server.URL+"/wiki/spaces/ENG/pages/10" {
+		t.Errorf("unexpected path %q", change.Document.Path)
+	}
+	metadata := change.Document.Metadata
+	if metadata["ancestry"] != "Home / Runbooks" || metadata["ancestor_ids"] != "1,2" {
+		t.Errorf("unexpected ancestry metadata: %v", metadata)
+	}
+	if metadata["labels"] != "ops,oncall" || metadata["space"] != "ENG" || metadata["author"] != "Ann" {
+		t.Errorf("unexpected metadata: %v", metadata)
+	}
+
+	if cursor != `{"ENG":"2026-03-02T10:00:00Z"}` {
+		t.Errorf("expected the latest modification time as the space's position, got %q", cursor)
+	}
+	if len(*queries) != 1 || (*queries)[0] != `type in (page,blogpost) AND space in ("ENG") ORDER BY lastmodified ASC` {
+		t.Errorf("unexpected CQL %v", *queries)
+	}
+}
+
+func TestConnector_FetchChanges_Incremental(t *testing.T) {
+	server, queries := newTestServer(t)
+	c := newTestConnector(server.URL+"/wiki", "")
+
+	source := &domain.Source{Config: domain.SourceConfig{SpaceKeys: []string{"ENG", "OPS"}}}
+	changes, cursor, err := c.FetchChanges(context.Background(), source, `{"":"2026-03-01T10:00:00Z","OTHER":"2026-03-05T10:00:00Z"}`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(changes) != 1 || changes[0].ExternalID != "page-11" || changes[0].Type != domain.ChangeTypeModified {
+		t.Errorf("expected only the page modified after the cursor, got %+v", changes)
+	}
+	if cursor != `{"":"2026-03-02T10:00:00Z"}` {
+		t.Errorf("unexpected cursor %q", cursor)
+	}
+
+	want := `type in (page,blogpost) AND space in ("ENG","OPS") AND lastmodified >= "2026/02/28 10:00" ORDER BY lastmodified ASC`
+	if len(*queries) != 1 || (*queries)[0] != want {
+		t.Errorf("expected CQL %s, got %v", want, *queries)
+	}
+}
+
+func TestConnector_FetchDocument(t *testing.T) {
+	server, _ := newTestServer(t)
+	c := newTestConnector(server.URL+"/wiki", "ENG")
+
+	change, err := c.FetchDocument(context.Background(), &domain.Source{}, "page-10")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if change.Document.Title != "Restarts" || change.Document.Path != server.URL+"/wiki/spaces/ENG/pages/10" {
+		t.Errorf("unexpected document: %+v", change.Document)
+	}
+
+	for _, id := range []string{"page-12", "page-13"} {
+		if _, err := c.FetchDocument(context.Background(), &domain.Source{}, id); !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected ErrNotFound for %s, got %v", id, err)
+		}
+	}
+}
+
+func TestContainerLister_ListContainers(t *testing.T) {
+	server, _ := newTestServer(t)
+	tokenProvider := driven.NewStaticTokenProvider(&domain.Credentials{AuthMethod: domain.AuthMethodAPIKey, APIKey: "ann@example.com:secret"})
+	l := NewContainerLister(tokenProvider, server.URL+"/wiki")
+
+	containers, next, err := l.ListContainers(context.Background(), "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(containers) != 1 || next != "" {
+		t.Fatalf("expected one space and no next page, got %d (next %q)", len(containers), next)
+	}
+	space := containers[0]
+	if space.ID != "ENG" || space.Description != "Team docs" || space.Metadata["web_url"] != server.URL+"/wiki/spaces/ENG" {
+		t.Errorf("unexpected container: %+v", space)
+	}
+}
+
+func TestConnector_ValidateConfig(t *testing.T) {
+	c := NewConnector(nil, "", nil)
+	if err := c.ValidateConfig(domain.SourceConfig{SpaceKeys: []string{"ENG"}}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := c.ValidateConfig(domain.SourceConfig{SpaceKeys: []string{`EN"G`}}); !errors.Is(err, domain.ErrInvalidInput) {
+		t.Errorf("expected ErrInvalidInput, got %v", err)
+	}
+}
+
+func TestBuilder_MergeCursors(t *testing.T) {
+	b := NewBuilder()
+	merged := b.MergeCursors(`{"ENG":"2026-03-01T10:00:00Z"}`, `{"OPS":"2026-03-02T10:00:00Z"}`)
+	if merged != `{"ENG":"2026-03-01T10:00:00Z","OPS":"2026-03-02T10:00:00Z"}` {
+		t.Errorf("expected the position of each space kept, got %q", merged)
+	}
+	if got := b.MergeCursors(`{"ENG":"2026-03-02T10:00:00Z"}`, ""); got != `{"ENG":"2026-03-02T10:00:00Z"}` {
+		t.Errorf("expected the cursor kept, got %q", got)
+	}
+}
@@ -0,0 +1,80 @@
+package confluence
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Ensure ContainerLister implements the interface.
+var _ driven.ContainerLister = (*ContainerLister)(nil)
+
+// ContainerLister lists the Confluence spaces accessible with an
+// installation's credentials.
+type ContainerLister struct {
+	client *Client
+}
+
+// NewContainerLister creates a ContainerLister with the given token provider.
+func NewContainerLister(tokenProvider driven.TokenProvider, baseURL string) *ContainerLister {
+	return &ContainerLister{
+		client: NewClient(tokenProvider, baseURL),
+	}
+}
+
+// ListContainers lists a page of the current spaces, by space key.
+func (l *ContainerLister) ListContainers(ctx context.Context, cursor string) ([]*driven.Container, string, error) {
+	resp, err := l.client.ListSpaces(ctx, cursor)
+	if err != nil {
+		return nil, "", fmt.Errorf("list spaces: %w", err)
+	}
+
+	containers := make([]*driven.Container, len(resp.Spaces))
+	for i, space := range resp.Spaces {
+		containers[i] = &driven.Container{
+			ID:          space.Key,
+			Name:        space.Name,
+			Description: space.Description.Plain.Value,
+			Type:        "space",
+			Metadata: map[string]string{
+				"space_type": space.Type,
+				"web_url":    strings.TrimSuffix(resp.BaseURL, "/") + space.Links.WebUI,
+			},
+		}
+	}
+
+	return containers, resp.NextCursor, nil
+}
+
+// ContainerListerFactory creates ContainerListers for Confluence installations.
+type ContainerListerFactory struct {
+	installationStore driven.InstallationStore
+	tokenFactory      driven.TokenProviderFactory
+	baseURL           string
+}
+
+// NewContainerListerFactory creates a factory for Confluence container
+// listers of the Confluence at baseURL (see Config.BaseURL).
+func NewContainerListerFactory(
+	installationStore driven.InstallationStore,
+	tokenFactory driven.TokenProviderFactory,
+	baseURL string,
+) *ContainerListerFactory {
+	return &ContainerListerFactory{
+		installationStore: installationStore,
+		tokenFactory:      tokenFactory,
+		baseURL:           baseURL,
+	}
+}
+
+// Create creates a ContainerLister for a Confluence installation.
+func (f *ContainerListerFactory) Create(ctx context.Context, installationID string) (driven.ContainerLister, error) {
+	tokenProvider, err := f.tokenFactory.Create(ctx, installationID)
+	if err != nil {
+		return nil, fmt.Errorf("create token provider: %w", err)
+	}
+
+	return NewContainerLister(tokenProvider, f.baseURL), nil
+}
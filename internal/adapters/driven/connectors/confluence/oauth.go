@@ -0,0 +1,20 @@
+package confluence
+
+import "github.com/custodia-labs/sercha-core/internal/adapters/driven/connectors/atlassian"
+
+// defaultScopes are the OAuth scopes requested from Atlassian: reading
+// spaces, content and its labels, searching with CQL, the user, and a
+// refresh token.
+var defaultScopes = []string{
+	"read:confluence-space.summary",
+	"read:confluence-content.all",
+	"read:confluence-content.summary",
+	"search:confluence",
+	"read:me",
+	"offline_access",
+}
+
+// NewOAuthHandler creates an OAuth handler for Confluence Cloud.
+func NewOAuthHandler() *atlassian.OAuthHandler {
+	return atlassian.NewOAuthHandler(defaultScopes)
+}
@@ -0,0 +1,113 @@
+package connectors
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+)
+
+// TimeCursor is a sync cursor holding, per container, the latest
+// modification time a connector has synced. The containers of a source
+// sync in parallel and reach different times, so each keeps its own
+// position: a single time for the source would skip the changes a
+// container made between its own position and a later one.
+//
+// It is stored as a JSON object of RFC 3339 times by container ID. A cursor
+// holding one RFC 3339 time, the format of earlier cursors, applies to
+// every container.
+type TimeCursor struct {
+	positions map[string]time.Time
+	fallback  *time.Time // Earlier single-time cursor
+}
+
+// ParseTimeCursor parses a cursor formatted by TimeCursor.String. An empty
+// or unreadable cursor has no positions, so every container syncs in full.
+func ParseTimeCursor(cursor string) TimeCursor {
+	c := TimeCursor{positions: make(map[string]time.Time)}
+	if cursor == "" {
+		return c
+	}
+	if t, err := time.Parse(time.RFC3339, cursor); err == nil {
+		c.fallback = &t
+		return c
+	}
+
+	var raw map[string]string
+	if err := json.Unmarshal([]byte(cursor), &raw); err != nil {
+		return c
+	}
+	for containerID, value := range raw {
+		if t, err := time.Parse(time.RFC3339, value); err == nil {
+			c.positions[containerID] = t
+		}
+	}
+	return c
+}
+
+// Since returns the position of a container, or nil if it has none.
+func (c TimeCursor) Since(containerID string) *time.Time {
+	if t, ok := c.positions[containerID]; ok {
+		return &t
+	}
+	return c.fallback
+}
+
+// ContainerIDs returns the IDs of the containers with a position, sorted.
+func (c TimeCursor) ContainerIDs() []string {
+	ids := make([]string, 0, len(c.positions))
+	for containerID := range c.positions {
+		ids = append(ids, containerID)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// String formats the cursor; a cursor without positions is empty.
+func (c TimeCursor) String() string {
+	if len(c.positions) == 0 {
+		if c.fallback != nil {
+			return c.fallback.UTC().Format(time.RFC3339)
+		}
+		return ""
+	}
+	raw := make(map[string]string, len(c.positions))
+	for containerID, t := range c.positions {
+		raw[containerID] = t.UTC().Format(time.RFC3339)
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// ContainerTimeCursor returns the cursor of a container synced up to
+// latest, the latest modification time it saw. If it saw none, since (its
+// previous position) is kept. Empty if both are unset.
+func ContainerTimeCursor(containerID string, since *time.Time, latest time.Time) string {
+	if latest.IsZero() {
+		if since == nil {
+			return ""
+		}
+		latest = *since
+	}
+	return TimeCursor{positions: map[string]time.Time{containerID: latest}}.String()
+}
+
+// MergeTimeCursors combines the cursors of two containers of one sync,
+// keeping the most recent position of each container. It implements
+// driven.CursorMerger for connectors using TimeCursor.
+func MergeTimeCursors(cursor, other string) string {
+	merged := ParseTimeCursor(cursor)
+	o := ParseTimeCursor(other)
+	for containerID, t := range o.positions {
+		if current, ok := merged.positions[containerID]; !ok || t.After(current) {
+			merged.positions[containerID] = t
+		}
+	}
+	// Keep the earliest single time, which no container has passed
+	if o.fallback != nil && (merged.fallback == nil || o.fallback.Before(*merged.fallback)) {
+		merged.fallback = o.fallback
+	}
+	return merged.String()
+}
@@ -0,0 +1,53 @@
+package connectors
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeCursor(t *testing.T) {
+	first := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	second := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+
+	cursor := MergeTimeCursors(ContainerTimeCursor("a", nil, second), ContainerTimeCursor("b", nil, first))
+	if cursor != `{"a":"2026-03-02T10:00:00Z","b":"2026-03-01T10:00:00Z"}` {
+		t.Fatalf("expected a position per container, got %q", cursor)
+	}
+
+	parsed := ParseTimeCursor(cursor)
+	if since := parsed.Since("b"); since == nil || !since.Equal(first) {
+		t.Errorf("expected the container's own position, got %v", since)
+	}
+	if since := parsed.Since("c"); since != nil {
+		t.Errorf("expected no position for a new container, got %v", since)
+	}
+
+	// The later position of a container wins
+	if got := MergeTimeCursors(cursor, ContainerTimeCursor("b", &first, second)); got != `{"a":"2026-03-02T10:00:00Z","b":"2026-03-02T10:00:00Z"}` {
+		t.Errorf("unexpected merge %q", got)
+	}
+
+	// A container that saw no changes keeps its position
+	if got := ContainerTimeCursor("a", &first, time.Time{}); got != `{"a":"2026-03-01T10:00:00Z"}` {
+		t.Errorf("expected the position kept, got %q", got)
+	}
+	if got := ContainerTimeCursor("a", nil, time.Time{}); got != "" {
+		t.Errorf("expected no cursor, got %q", got)
+	}
+}
+
+func TestTimeCursor_SingleTime(t *testing.T) {
+	// Earlier cursors are one time for every container
+	parsed := ParseTimeCursor("2026-03-01T10:00:00Z")
+	for _, containerID := range []string{"", "a"} {
+		if since := parsed.Since(containerID); since == nil || since.Format(time.RFC3339) != "2026-03-01T10:00:00Z" {
+			t.Errorf("expected the single time for %q, got %v", containerID, since)
+		}
+	}
+	if got := MergeTimeCursors("2026-03-02T10:00:00Z", "2026-03-01T10:00:00Z"); got != "2026-03-01T10:00:00Z" {
+		t.Errorf("expected the earliest single time, got %q", got)
+	}
+	if ParseTimeCursor("not a cursor").Since("a") != nil {
+		t.Error("expected no position for an unreadable cursor")
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
 	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
 )
 
@@ -302,6 +303,40 @@ func (c *Client) ListPullRequests(ctx context.Context, owner, repo string, curso
 	return prs, nextCursor, nil
 }
 
+// GetIssue gets a single issue by number.
+func (c *Client) GetIssue(ctx context.Context, owner, repo string, number int) (*Issue, error) {
+	path := fmt.Sprintf("/repos/%s/%s/issues/%d", owner, repo, number)
+	resp, err := c.doRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var issue Issue
+	if err := json.NewDecoder(resp.Body).Decode(&issue); err != nil {
+		return nil, fmt.Errorf("decode issue: %w", err)
+	}
+
+	return &issue, nil
+}
+
+// GetPullRequest gets a single pull request by number.
+func (c *Client) GetPullRequest(ctx context.Context, owner, repo string, number int) (*PullRequest, error) {
+	path := fmt.Sprintf("/repos/%s/%s/pulls/%d", owner, repo, number)
+	resp, err := c.doRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var pr PullRequest
+	if err := json.NewDecoder(resp.Body).Decode(&pr); err != nil {
+		return nil, fmt.Errorf("decode pull request: %w", err)
+	}
+
+	return &pr, nil
+}
+
 // GetTree gets the repository tree (file listing).
 func (c *Client) GetTree(ctx context.Context, owner, repo, sha string) ([]*TreeEntry, error) {
 	path := fmt.Sprintf("/repos/%s/%s/git/trees/%s?recursive=1", owner, repo, sha)
@@ -422,8 +457,41 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body io.Rea
 	if resp.StatusCode >= 400 {
 		body, _ := io.ReadAll(resp.Body)
 		resp.Body.Close()
-		return nil, fmt.Errorf("GitHub API error %d: %s", resp.StatusCode, string(body))
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("%w: GitHub API error %d: %s", domain.ErrNotFound, resp.StatusCode, string(body))
+		}
+		apiErr := fmt.Errorf("GitHub API error %d: %s", resp.StatusCode, string(body))
+		if retryAfter, limited := rateLimitDelay(resp); limited {
+			return nil, &domain.RateLimitError{RetryAfter: retryAfter, Err: apiErr}
+		}
+		return nil, apiErr
 	}
 
 	return resp, nil
 }
+
+// rateLimitDelay reports whether a failed response was rate limited, and
+// how long GitHub asked to wait (zero if unknown). Primary rate limits
+// exhaust X-RateLimit-Remaining; secondary rate limits set Retry-After.
+func rateLimitDelay(resp *http.Response) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+
+	if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+		if secs, err := strconv.Atoi(retryAfter); err == nil && secs >= 0 {
+			return time.Duration(secs) * time.Second, true
+		}
+		return 0, true
+	}
+
+	if resp.Header.Get("X-RateLimit-Remaining") == "0" {
+		resetTime, _ := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+		if wait := time.Until(time.Unix(resetTime, 0)); resetTime > 0 && wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+
+	return 0, resp.StatusCode == http.StatusTooManyRequests
+}
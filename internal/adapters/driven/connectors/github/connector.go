@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -206,34 +207,41 @@ func (c *Connector) fetchFileChanges(ctx context.Context) ([]*domain.Change, err
 			continue
 		}
 
-		// Fetch file content
-		content, err := c.client.GetFileContent(ctx, c.owner, c.repo, entry.Path)
+		change, err := c.fileChange(ctx, entry)
 		if err != nil {
 			// Skip files we can't fetch
 			continue
 		}
+		changes = append(changes, change)
+	}
 
-		// Decode base64 content
-		decodedContent := ""
-		if content.Encoding == "base64" {
-			decoded, err := base64.StdEncoding.DecodeString(content.Content)
-			if err == nil {
-				decodedContent = string(decoded)
-			}
-		} else {
-			decodedContent = content.Content
-		}
+	return changes, nil
+}
 
-		change := &domain.Change{
-			Type:       domain.ChangeTypeAdded,
-			ExternalID: fmt.Sprintf("file-%s", entry.SHA),
-			Document:   c.fileToDocument(entry, content),
-			Content:    decodedContent,
+// fileChange fetches the content of a tree entry as an added change.
+func (c *Connector) fileChange(ctx context.Context, entry *TreeEntry) (*domain.Change, error) {
+	content, err := c.client.GetFileContent(ctx, c.owner, c.repo, entry.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Decode base64 content
+	decodedContent := ""
+	if content.Encoding == "base64" {
+		decoded, err := base64.StdEncoding.DecodeString(content.Content)
+		if err == nil {
+			decodedContent = string(decoded)
 		}
-		changes = append(changes, change)
+	} else {
+		decodedContent = content.Content
 	}
 
-	return changes, nil
+	return &domain.Change{
+		Type:       domain.ChangeTypeAdded,
+		ExternalID: fmt.Sprintf("file-%s", entry.SHA),
+		Document:   c.fileToDocument(entry, content),
+		Content:    decodedContent,
+	}, nil
 }
 
 // shouldIncludeFile checks if a file should be included based on configuration.
@@ -269,10 +277,10 @@ func (c *Connector) shouldIncludeFile(path string) bool {
 }
 
 // FetchDocument fetches a single document by external ID.
-func (c *Connector) FetchDocument(ctx context.Context, source *domain.Source, externalID string) (*domain.Document, string, error) {
+func (c *Connector) FetchDocument(ctx context.Context, source *domain.Source, externalID string) (*domain.Change, error) {
 	parts := strings.SplitN(externalID, "-", 2)
 	if len(parts) != 2 {
-		return nil, "", fmt.Errorf("invalid external ID format: %s", externalID)
+		return nil, fmt.Errorf("invalid external ID format: %s", externalID)
 	}
 
 	docType := parts[0]
@@ -280,16 +288,54 @@ func (c *Connector) FetchDocument(ctx context.Context, source *domain.Source, ex
 
 	switch docType {
 	case "issue":
-		// Fetch single issue - would need GetIssue method
-		return nil, "", fmt.Errorf("single issue fetch not implemented")
+		number, err := strconv.Atoi(identifier)
+		if err != nil {
+			return nil, fmt.Errorf("invalid external ID format: %s", externalID)
+		}
+		issue, err := c.client.GetIssue(ctx, c.owner, c.repo, number)
+		if err != nil {
+			return nil, fmt.Errorf("get issue: %w", err)
+		}
+		return &domain.Change{
+			Type:       domain.ChangeTypeModified,
+			ExternalID: externalID,
+			Document:   c.issueToDocument(issue),
+			Content:    c.formatIssueContent(issue),
+		}, nil
 	case "pr":
-		// Fetch single PR - would need GetPullRequest method
-		return nil, "", fmt.Errorf("single PR fetch not implemented")
+		number, err := strconv.Atoi(identifier)
+		if err != nil {
+			return nil, fmt.Errorf("invalid external ID format: %s", externalID)
+		}
+		pr, err := c.client.GetPullRequest(ctx, c.owner, c.repo, number)
+		if err != nil {
+			return nil, fmt.Errorf("get pull request: %w", err)
+		}
+		return &domain.Change{
+			Type:       domain.ChangeTypeModified,
+			ExternalID: externalID,
+			Document:   c.prToDocument(pr),
+			Content:    c.formatPRContent(pr),
+		}, nil
 	case "file":
-		// Fetch single file - would need GetBlob method
-		return nil, "", fmt.Errorf("single file fetch not implemented: %s", identifier)
+		// Files are identified by blob SHA, so find the path in the
+		// current tree; a file whose content changed has a new SHA
+		repoInfo, err := c.client.GetRepository(ctx, c.owner, c.repo)
+		if err != nil {
+			return nil, fmt.Errorf("get repository: %w", err)
+		}
+		tree, err := c.client.GetTree(ctx, c.owner, c.repo, repoInfo.DefaultBranch)
+		if err != nil {
+			return nil, fmt.Errorf("get tree: %w", err)
+		}
+		for _, entry := range tree {
+			if entry.SHA == identifier {
+				return c.fileChange(ctx, entry)
+			}
+		}
+		return nil, fmt.Errorf("%w: file %s", domain.ErrNotFound, identifier)
 	default:
-		return nil, "", fmt.Errorf("unknown document type: %s", docType)
+		return nil, fmt.Errorf("unknown document type: %s", docType)
 	}
 }
 
@@ -406,6 +452,20 @@ func (c *Connector) guessMimeType(path string) string {
 		return "text/x-ruby"
 	case ".sh", ".bash":
 		return "text/x-shellscript"
+	case ".pdf":
+		return "application/pdf"
+	case ".docx":
+		return "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+	case ".xlsx":
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case ".pptx":
+		return "application/vnd.openxmlformats-officedocument.presentationml.presentation"
+	case ".odt":
+		return "application/vnd.oasis.opendocument.text"
+	case ".ods":
+		return "application/vnd.oasis.opendocument.spreadsheet"
+	case ".odp":
+		return "application/vnd.oasis.opendocument.presentation"
 	default:
 		return "text/plain"
 	}
@@ -0,0 +1,105 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/custodia-labs/sercha-core/internal/adapters/driven/connectors"
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Ensure Builder implements the interfaces.
+var (
+	_ driven.ConnectorBuilder = (*Builder)(nil)
+	_ driven.CursorMerger     = (*Builder)(nil)
+)
+
+// groupContainerPrefix marks container IDs that select a whole group.
+const groupContainerPrefix = "group:"
+
+// Builder creates GitLab connectors.
+type Builder struct {
+	config *Config
+}
+
+// NewBuilder creates a new GitLab connector builder for GitLab.com.
+func NewBuilder() *Builder {
+	return &Builder{
+		config: DefaultConfig(),
+	}
+}
+
+// NewBuilderWithConfig creates a builder with custom configuration,
+// e.g. for a self-managed GitLab instance.
+func NewBuilderWithConfig(config *Config) *Builder {
+	return &Builder{
+		config: config,
+	}
+}
+
+// Type returns the provider type.
+func (b *Builder) Type() domain.ProviderType {
+	return domain.ProviderTypeGitLab
+}
+
+// Build creates a GitLab connector scoped to a project or a group.
+// containerID format: "group/project" for a project, or "group:group/subgroup"
+// for every project of a group and its subgroups.
+func (b *Builder) Build(ctx context.Context, tokenProvider driven.TokenProvider, containerID string) (driven.Connector, error) {
+	if containerID == "" {
+		return nil, fmt.Errorf("containerID is required for GitLab connector (format: group/project or group:group)")
+	}
+
+	path, isGroup, err := ParseContainerID(containerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewConnector(tokenProvider, path, isGroup, b.config), nil
+}
+
+// SupportsOAuth returns true - GitLab supports OAuth2.
+func (b *Builder) SupportsOAuth() bool {
+	return true
+}
+
+// OAuthConfig returns OAuth configuration for the GitLab instance.
+func (b *Builder) OAuthConfig() *driven.OAuthConfig {
+	baseURL := strings.TrimSuffix(b.config.BaseURL, "/")
+	return &driven.OAuthConfig{
+		AuthURL:     baseURL + "/oauth/authorize",
+		TokenURL:    baseURL + "/oauth/token",
+		Scopes:      defaultScopes,
+		UserInfoURL: baseURL + "/api/v4/user",
+	}
+}
+
+// SupportsContainerSelection returns true - GitLab supports group and project selection.
+func (b *Builder) SupportsContainerSelection() bool {
+	return true
+}
+
+// MergeCursors combines the cursors of two projects or groups of one sync.
+// GitLab cursors hold the latest modification time seen in each container.
+func (b *Builder) MergeCursors(cursor, other string) string {
+	return connectors.MergeTimeCursors(cursor, other)
+}
+
+// ParseContainerID parses a container ID into a namespace path and whether
+// it selects a group.
+// Format: "group/project" or "group:group/subgroup"
+func ParseContainerID(containerID string) (path string, isGroup bool, err error) {
+	path, isGroup = strings.CutPrefix(containerID, groupContainerPrefix)
+	path = strings.Trim(path, "/")
+	if path == "" || (!isGroup && !strings.Contains(path, "/")) {
+		return "", false, fmt.Errorf("invalid container ID format: %q (expected: group/project or group:group)", containerID)
+	}
+	return path, isGroup, nil
+}
+
+// FormatGroupContainerID formats a group path into a container ID.
+func FormatGroupContainerID(fullPath string) string {
+	return groupContainerPrefix + fullPath
+}
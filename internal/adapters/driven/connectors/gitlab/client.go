@@ -0,0 +1,423 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Client provides GitLab REST API (v4) operations.
+type Client struct {
+	tokenProvider driven.TokenProvider
+	httpClient    *http.Client
+	apiURL        string
+	perPage       int
+	maxRetries    int
+}
+
+// NewClient creates a new GitLab API client for the instance at baseURL.
+func NewClient(tokenProvider driven.TokenProvider, baseURL string) *Client {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	return &Client{
+		tokenProvider: tokenProvider,
+		httpClient:    &http.Client{Timeout: 30 * time.Second},
+		apiURL:        strings.TrimSuffix(baseURL, "/") + "/api/v4",
+		perPage:       100,
+		maxRetries:    3,
+	}
+}
+
+// Project represents a GitLab project.
+type Project struct {
+	ID                   int64      `json:"id"`
+	Name                 string     `json:"name"`
+	PathWithNamespace    string     `json:"path_with_namespace"`
+	Description          string     `json:"description"`
+	Visibility           string     `json:"visibility"`
+	Archived             bool       `json:"archived"`
+	DefaultBranch        string     `json:"default_branch"`
+	WebURL               string     `json:"web_url"`
+	EmptyRepo            bool       `json:"empty_repo"`
+	IssuesEnabled        bool       `json:"issues_enabled"`
+	MergeRequestsEnabled bool       `json:"merge_requests_enabled"`
+	WikiEnabled          bool       `json:"wiki_enabled"`
+	Namespace            *Namespace `json:"namespace"`
+}
+
+// Namespace represents the group or user namespace of a project.
+type Namespace struct {
+	ID       int64  `json:"id"`
+	Kind     string `json:"kind"` // "group" or "user"
+	FullPath string `json:"full_path"`
+}
+
+// Group represents a GitLab group.
+type Group struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	FullName    string `json:"full_name"`
+	FullPath    string `json:"full_path"`
+	Description string `json:"description"`
+	Visibility  string `json:"visibility"`
+	WebURL      string `json:"web_url"`
+}
+
+// Issue represents a GitLab issue.
+type Issue struct {
+	ID             int64      `json:"id"`
+	IID            int        `json:"iid"`
+	ProjectID      int64      `json:"project_id"`
+	Title          string     `json:"title"`
+	Description    string     `json:"description"`
+	State          string     `json:"state"`
+	WebURL         string     `json:"web_url"`
+	Author         *User      `json:"author"`
+	Labels         []string   `json:"labels"`
+	UserNotesCount int        `json:"user_notes_count"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+	ClosedAt       *time.Time `json:"closed_at"`
+}
+
+// MergeRequest represents a GitLab merge request.
+type MergeRequest struct {
+	ID           int64      `json:"id"`
+	IID          int        `json:"iid"`
+	ProjectID    int64      `json:"project_id"`
+	Title        string     `json:"title"`
+	Description  string     `json:"description"`
+	State        string     `json:"state"` // opened, closed, merged or locked
+	Draft        bool       `json:"draft"`
+	WebURL       string     `json:"web_url"`
+	Author       *User      `json:"author"`
+	Labels       []string   `json:"labels"`
+	SourceBranch string     `json:"source_branch"`
+	TargetBranch string     `json:"target_branch"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+	MergedAt     *time.Time `json:"merged_at"`
+	ClosedAt     *time.Time `json:"closed_at"`
+}
+
+// WikiPage represents a GitLab project wiki page.
+type WikiPage struct {
+	Slug    string `json:"slug"`
+	Title   string `json:"title"`
+	Format  string `json:"format"` // markdown, rdoc, asciidoc or org
+	Content string `json:"content"`
+}
+
+// User represents a GitLab user.
+type User struct {
+	ID        int64  `json:"id"`
+	Username  string `json:"username"`
+	Name      string `json:"name"`
+	Email     string `json:"email"`
+	WebURL    string `json:"web_url"`
+	AvatarURL string `json:"avatar_url"`
+}
+
+// TreeEntry represents a file or directory in a repository tree.
+type TreeEntry struct {
+	ID   string `json:"id"` // Blob SHA
+	Name string `json:"name"`
+	Type string `json:"type"` // "blob" or "tree"
+	Path string `json:"path"`
+	Mode string `json:"mode"`
+}
+
+// File represents file content from GitLab.
+type File struct {
+	FileName     string `json:"file_name"`
+	FilePath     string `json:"file_path"`
+	Size         int64  `json:"size"`
+	Encoding     string `json:"encoding"`
+	Content      string `json:"content"`
+	BlobID       string `json:"blob_id"`
+	Ref          string `json:"ref"`
+	LastCommitID string `json:"last_commit_id"`
+}
+
+// ListProjectsResponse is the response from listing projects.
+type ListProjectsResponse struct {
+	Projects   []*Project
+	NextCursor string
+}
+
+// ListGroupsResponse is the response from listing groups.
+type ListGroupsResponse struct {
+	Groups     []*Group
+	NextCursor string
+}
+
+// ListAccessibleProjects lists the projects the authenticated user is a member of.
+func (c *Client) ListAccessibleProjects(ctx context.Context, cursor string) (*ListProjectsResponse, error) {
+	var projects []*Project
+	next, err := c.getPage(ctx, "/projects?membership=true&order_by=path&sort=asc", cursor, &projects)
+	if err != nil {
+		return nil, err
+	}
+	return &ListProjectsResponse{Projects: projects, NextCursor: next}, nil
+}
+
+// ListAccessibleGroups lists the groups the authenticated user is a member of.
+func (c *Client) ListAccessibleGroups(ctx context.Context, cursor string) (*ListGroupsResponse, error) {
+	var groups []*Group
+	next, err := c.getPage(ctx, "/groups?min_access_level=10&order_by=path&sort=asc", cursor, &groups)
+	if err != nil {
+		return nil, err
+	}
+	return &ListGroupsResponse{Groups: groups, NextCursor: next}, nil
+}
+
+// ListGroupProjects lists the projects of a group, including its subgroups.
+// Archived projects are skipped.
+func (c *Client) ListGroupProjects(ctx context.Context, groupPath, cursor string) ([]*Project, string, error) {
+	var projects []*Project
+	path := fmt.Sprintf("/groups/%s/projects?include_subgroups=true&archived=false&order_by=path&sort=asc",
+		url.PathEscape(groupPath))
+	next, err := c.getPage(ctx, path, cursor, &projects)
+	return projects, next, err
+}
+
+// GetProject gets a project by numeric ID or path with namespace.
+func (c *Client) GetProject(ctx context.Context, project string) (*Project, error) {
+	var p Project
+	if err := c.get(ctx, "/projects/"+url.PathEscape(project), &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// ListIssues lists the issues of a project, optionally only those updated after since.
+func (c *Client) ListIssues(ctx context.Context, projectID int64, since *time.Time, cursor string) ([]*Issue, string, error) {
+	path := fmt.Sprintf("/projects/%d/issues?scope=all&order_by=updated_at&sort=desc", projectID)
+	if since != nil {
+		path += "&updated_after=" + url.QueryEscape(since.Format(time.RFC3339))
+	}
+
+	var issues []*Issue
+	next, err := c.getPage(ctx, path, cursor, &issues)
+	return issues, next, err
+}
+
+// ListMergeRequests lists the merge requests of a project, optionally only
+// those updated after since.
+func (c *Client) ListMergeRequests(ctx context.Context, projectID int64, since *time.Time, cursor string) ([]*MergeRequest, string, error) {
+	path := fmt.Sprintf("/projects/%d/merge_requests?scope=all&state=all&order_by=updated_at&sort=desc", projectID)
+	if since != nil {
+		path += "&updated_after=" + url.QueryEscape(since.Format(time.RFC3339))
+	}
+
+	var mrs []*MergeRequest
+	next, err := c.getPage(ctx, path, cursor, &mrs)
+	return mrs, next, err
+}
+
+// GetIssue gets a single issue by its project-scoped IID.
+func (c *Client) GetIssue(ctx context.Context, projectID int64, iid int) (*Issue, error) {
+	var issue Issue
+	if err := c.get(ctx, fmt.Sprintf("/projects/%d/issues/%d", projectID, iid), &issue); err != nil {
+		return nil, err
+	}
+	return &issue, nil
+}
+
+// GetMergeRequest gets a single merge request by its project-scoped IID.
+func (c *Client) GetMergeRequest(ctx context.Context, projectID int64, iid int) (*MergeRequest, error) {
+	var mr MergeRequest
+	if err := c.get(ctx, fmt.Sprintf("/projects/%d/merge_requests/%d", projectID, iid), &mr); err != nil {
+		return nil, err
+	}
+	return &mr, nil
+}
+
+// ListWikiPages lists the wiki pages of a project with their content.
+func (c *Client) ListWikiPages(ctx context.Context, projectID int64) ([]*WikiPage, error) {
+	var pages []*WikiPage
+	if err := c.get(ctx, fmt.Sprintf("/projects/%d/wikis?with_content=1", projectID), &pages); err != nil {
+		return nil, err
+	}
+	return pages, nil
+}
+
+// GetWikiPage gets a single wiki page by slug.
+func (c *Client) GetWikiPage(ctx context.Context, projectID int64, slug string) (*WikiPage, error) {
+	var page WikiPage
+	if err := c.get(ctx, fmt.Sprintf("/projects/%d/wikis/%s", projectID, url.PathEscape(slug)), &page); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+// GetTree gets the files of a repository at ref, recursively.
+func (c *Client) GetTree(ctx context.Context, projectID int64, ref string) ([]*TreeEntry, error) {
+	path := fmt.Sprintf("/projects/%d/repository/tree?recursive=true&ref=%s", projectID, url.QueryEscape(ref))
+
+	var files []*TreeEntry
+	cursor := ""
+	for {
+		var entries []*TreeEntry
+		next, err := c.getPage(ctx, path, cursor, &entries)
+		if err != nil {
+			return nil, err
+		}
+
+		// Filter to only blobs (files)
+		for _, entry := range entries {
+			if entry.Type == "blob" {
+				files = append(files, entry)
+			}
+		}
+
+		if next == "" {
+			return files, nil
+		}
+		cursor = next
+	}
+}
+
+// GetFile gets the content of a file at ref.
+func (c *Client) GetFile(ctx context.Context, projectID int64, filePath, ref string) (*File, error) {
+	path := fmt.Sprintf("/projects/%d/repository/files/%s?ref=%s",
+		projectID, url.PathEscape(filePath), url.QueryEscape(ref))
+
+	var file File
+	if err := c.get(ctx, path, &file); err != nil {
+		return nil, err
+	}
+	return &file, nil
+}
+
+// GetUser gets the authenticated user's information.
+func (c *Client) GetUser(ctx context.Context) (*User, error) {
+	var user User
+	if err := c.get(ctx, "/user", &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// get performs a GET request and decodes the JSON response into out.
+func (c *Client) get(ctx context.Context, path string, out any) error {
+	resp, err := c.doRequest(ctx, "GET", path)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	return nil
+}
+
+// getPage fetches one page of a list endpoint into out. The cursor is a
+// page number (empty for the first page); the returned cursor is the next
+// page, or empty on the last page.
+func (c *Client) getPage(ctx context.Context, path, cursor string, out any) (string, error) {
+	page := 1
+	if cursor != "" {
+		if n, err := strconv.Atoi(cursor); err == nil && n > 0 {
+			page = n
+		}
+	}
+
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
+	resp, err := c.doRequest(ctx, "GET", fmt.Sprintf("%s%sper_page=%d&page=%d", path, sep, c.perPage, page))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return "", fmt.Errorf("decode response: %w", err)
+	}
+
+	// GitLab omits X-Next-Page on the last page
+	return resp.Header.Get("X-Next-Page"), nil
+}
+
+// doRequest performs an authenticated HTTP request with retry logic.
+func (c *Client) doRequest(ctx context.Context, method, path string) (*http.Response, error) {
+	token, err := c.tokenProvider.GetAccessToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get access token: %w", err)
+	}
+
+	var resp *http.Response
+	for attempt := 0; attempt <= c.maxRetries; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, method, c.apiURL+path, nil)
+		if err != nil {
+			return nil, fmt.Errorf("create request: %w", err)
+		}
+
+		// OAuth tokens and personal access tokens are both accepted as bearer tokens
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Accept", "application/json")
+
+		resp, err = c.httpClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("do request: %w", err)
+		}
+
+		// Success or non-retryable error
+		if resp.StatusCode < 500 {
+			break
+		}
+
+		// Server error - retry with exponential backoff
+		if attempt == c.maxRetries {
+			break
+		}
+		resp.Body.Close()
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(time.Duration(attempt+1) * time.Second):
+		}
+	}
+
+	if resp.StatusCode >= 400 {
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("%w: GitLab API error %d: %s", domain.ErrNotFound, resp.StatusCode, string(body))
+		}
+		apiErr := fmt.Errorf("GitLab API error %d: %s", resp.StatusCode, string(body))
+		if resp.StatusCode == http.StatusTooManyRequests {
+			return nil, &domain.RateLimitError{RetryAfter: rateLimitDelay(resp), Err: apiErr}
+		}
+		return nil, apiErr
+	}
+
+	return resp, nil
+}
+
+// rateLimitDelay returns how long GitLab asked a rate-limited client to
+// wait (zero if unknown), from Retry-After or else RateLimit-Reset.
+func rateLimitDelay(resp *http.Response) time.Duration {
+	if secs, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second
+	}
+	if reset, err := strconv.ParseInt(resp.Header.Get("RateLimit-Reset"), 10, 64); err == nil {
+		if wait := time.Until(time.Unix(reset, 0)); wait > 0 {
+			return wait
+		}
+	}
+	return 0
+}
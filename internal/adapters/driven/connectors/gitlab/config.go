@@ -0,0 +1,67 @@
+package gitlab
+
+// DefaultBaseURL is the URL of GitLab.com.
+const DefaultBaseURL = "https://gitlab.com"
+
+// Config contains configuration for the GitLab connector.
+type Config struct {
+	// BaseURL is the URL of the GitLab instance.
+	// Defaults to https://gitlab.com. For self-managed GitLab, use the
+	// instance URL, e.g. https://gitlab.example.com; the API is expected
+	// at <BaseURL>/api/v4.
+	BaseURL string
+
+	// PerPage is the number of items to fetch per page.
+	// Maximum is 100.
+	PerPage int
+
+	// MaxRetries is the maximum number of retry attempts for failed requests.
+	MaxRetries int
+
+	// IncludeFiles enables indexing of repository files.
+	IncludeFiles bool
+
+	// IncludeIssues enables indexing of issues.
+	IncludeIssues bool
+
+	// IncludeMRs enables indexing of merge requests.
+	IncludeMRs bool
+
+	// IncludeWiki enables indexing of wiki pages.
+	IncludeWiki bool
+
+	// FileExtensions is a list of file extensions to index.
+	// Empty means all text-based files.
+	FileExtensions []string
+
+	// ExcludePaths is a list of path patterns to exclude.
+	ExcludePaths []string
+
+	// MaxFileSize is the maximum file size in bytes to index.
+	// Default is 1MB.
+	MaxFileSize int64
+}
+
+// DefaultConfig returns the default GitLab connector configuration.
+func DefaultConfig() *Config {
+	return &Config{
+		BaseURL:        DefaultBaseURL,
+		PerPage:        100,
+		MaxRetries:     3,
+		IncludeFiles:   true,
+		IncludeIssues:  true,
+		IncludeMRs:     true,
+		IncludeWiki:    true,
+		FileExtensions: []string{}, // All text files
+		ExcludePaths: []string{
+			"vendor/",
+			"node_modules/",
+			".git/",
+			"*.min.js",
+			"*.min.css",
+			"package-lock.json",
+			"yarn.lock",
+		},
+		MaxFileSize: 1 << 20, // 1MB
+	}
+}
@@ -0,0 +1,645 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-core/internal/adapters/driven/connectors"
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Ensure Connector implements the interface.
+var _ driven.Connector = (*Connector)(nil)
+
+// Connector fetches documents from a GitLab project, or from every project
+// of a group.
+type Connector struct {
+	tokenProvider driven.TokenProvider
+	path          string // Project path with namespace, or group full path
+	isGroup       bool
+	client        *Client
+	config        *Config
+}
+
+// NewConnector creates a GitLab connector scoped to the project or group at path.
+func NewConnector(tokenProvider driven.TokenProvider, path string, isGroup bool, config *Config) *Connector {
+	if config == nil {
+		config = DefaultConfig()
+	}
+	client := NewClient(tokenProvider, config.BaseURL)
+	if config.PerPage > 0 && config.PerPage <= 100 {
+		client.perPage = config.PerPage
+	}
+	if config.MaxRetries > 0 {
+		client.maxRetries = config.MaxRetries
+	}
+	return &Connector{
+		tokenProvider: tokenProvider,
+		path:          path,
+		isGroup:       isGroup,
+		client:        client,
+		config:        config,
+	}
+}
+
+// Type returns the provider type.
+func (c *Connector) Type() domain.ProviderType {
+	return domain.ProviderTypeGitLab
+}
+
+// ValidateConfig validates source configuration.
+func (c *Connector) ValidateConfig(config domain.SourceConfig) error {
+	// No special validation needed for GitLab
+	return nil
+}
+
+// FetchChanges fetches document changes from the project or group.
+// For initial sync (empty cursor), it fetches all content.
+// For incremental sync, it fetches the issues and merge requests updated
+// after the container's position in the cursor; files and wiki pages, which GitLab cannot
+// list by modification time, are refreshed by full syncs.
+func (c *Connector) FetchChanges(ctx context.Context, source *domain.Source, cursor string) ([]*domain.Change, string, error) {
+	var changes []*domain.Change
+	var lastModified time.Time
+
+	since := connectors.ParseTimeCursor(cursor).Since(c.containerID())
+
+	projects, err := c.projects(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	for _, project := range projects {
+		projectChanges, err := c.fetchProjectChanges(ctx, project, since)
+		if err != nil {
+			return nil, "", fmt.Errorf("project %s: %w", project.PathWithNamespace, err)
+		}
+		changes = append(changes, projectChanges...)
+		for _, change := range projectChanges {
+			if change.Document != nil && change.Document.UpdatedAt.After(lastModified) {
+				lastModified = change.Document.UpdatedAt
+			}
+		}
+	}
+
+	// Move the container's position to the latest modified time, keeping
+	// it when nothing changed
+	if lastModified.IsZero() && since == nil && len(changes) > 0 {
+		lastModified = time.Now()
+	}
+	return changes, connectors.ContainerTimeCursor(c.containerID(), since, lastModified), nil
+}
+
+// containerID returns the container ID the connector was built for.
+func (c *Connector) containerID() string {
+	if c.isGroup {
+		return groupContainerPrefix + c.path
+	}
+	return c.path
+}
+
+// projects returns the projects the connector is scoped to.
+func (c *Connector) projects(ctx context.Context) ([]*Project, error) {
+	if !c.isGroup {
+		project, err := c.client.GetProject(ctx, c.path)
+		if err != nil {
+			return nil, fmt.Errorf("get project: %w", err)
+		}
+		return []*Project{project}, nil
+	}
+
+	var projects []*Project
+	cursor := ""
+	for {
+		page, nextCursor, err := c.client.ListGroupProjects(ctx, c.path, cursor)
+		if err != nil {
+			return nil, fmt.Errorf("list group projects: %w", err)
+		}
+		projects = append(projects, page...)
+
+		if nextCursor == "" {
+			return projects, nil
+		}
+		cursor = nextCursor
+	}
+}
+
+// fetchProjectChanges fetches the changes of one project.
+func (c *Connector) fetchProjectChanges(ctx context.Context, project *Project, since *time.Time) ([]*domain.Change, error) {
+	var changes []*domain.Change
+
+	// Fetch issues if enabled
+	if c.config.IncludeIssues && project.IssuesEnabled {
+		issueChanges, err := c.fetchIssueChanges(ctx, project, since)
+		if err != nil {
+			return nil, fmt.Errorf("fetch issues: %w", err)
+		}
+		changes = append(changes, issueChanges...)
+	}
+
+	// Fetch merge requests if enabled
+	if c.config.IncludeMRs && project.MergeRequestsEnabled {
+		mrChanges, err := c.fetchMRChanges(ctx, project, since)
+		if err != nil {
+			return nil, fmt.Errorf("fetch merge requests: %w", err)
+		}
+		changes = append(changes, mrChanges...)
+	}
+
+	// Fetch wiki pages and files only on initial sync
+	if c.config.IncludeWiki && project.WikiEnabled && since == nil {
+		wikiChanges, err := c.fetchWikiChanges(ctx, project)
+		if err != nil {
+			return nil, fmt.Errorf("fetch wiki: %w", err)
+		}
+		changes = append(changes, wikiChanges...)
+	}
+
+	if c.config.IncludeFiles && !project.EmptyRepo && project.DefaultBranch != "" && since == nil {
+		fileChanges, err := c.fetchFileChanges(ctx, project)
+		if err != nil {
+			return nil, fmt.Errorf("fetch files: %w", err)
+		}
+		changes = append(changes, fileChanges...)
+	}
+
+	return changes, nil
+}
+
+// fetchIssueChanges fetches issue changes.
+func (c *Connector) fetchIssueChanges(ctx context.Context, project *Project, since *time.Time) ([]*domain.Change, error) {
+	var allChanges []*domain.Change
+	cursor := ""
+
+	for {
+		issues, nextCursor, err := c.client.ListIssues(ctx, project.ID, since, cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, issue := range issues {
+			change := &domain.Change{
+				Type:       domain.ChangeTypeModified,
+				ExternalID: externalID("issue", project.ID, strconv.Itoa(issue.IID)),
+				Document:   c.issueToDocument(project, issue),
+				Content:    c.formatIssueContent(issue),
+			}
+			if since == nil {
+				change.Type = domain.ChangeTypeAdded
+			}
+			allChanges = append(allChanges, change)
+		}
+
+		if nextCursor == "" {
+			break
+		}
+		cursor = nextCursor
+	}
+
+	return allChanges, nil
+}
+
+// fetchMRChanges fetches merge request changes.
+func (c *Connector) fetchMRChanges(ctx context.Context, project *Project, since *time.Time) ([]*domain.Change, error) {
+	var allChanges []*domain.Change
+	cursor := ""
+
+	for {
+		mrs, nextCursor, err := c.client.ListMergeRequests(ctx, project.ID, since, cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, mr := range mrs {
+			change := &domain.Change{
+				Type:       domain.ChangeTypeModified,
+				ExternalID: externalID("mr", project.ID, strconv.Itoa(mr.IID)),
+				Document:   c.mrToDocument(project, mr),
+				Content:    c.formatMRContent(mr),
+			}
+			if since == nil {
+				change.Type = domain.ChangeTypeAdded
+			}
+			allChanges = append(allChanges, change)
+		}
+
+		if nextCursor == "" {
+			break
+		}
+		cursor = nextCursor
+	}
+
+	return allChanges, nil
+}
+
+// fetchWikiChanges fetches the wiki pages of a project.
+func (c *Connector) fetchWikiChanges(ctx context.Context, project *Project) ([]*domain.Change, error) {
+	pages, err := c.client.ListWikiPages(ctx, project.ID)
+	if err != nil {
+		// A project without wiki pages may report its wiki as missing
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	changes := make([]*domain.Change, 0, len(pages))
+	for _, page := range pages {
+		changes = append(changes, &domain.Change{
+			Type:       domain.ChangeTypeAdded,
+			ExternalID: externalID("wiki", project.ID, page.Slug),
+			Document:   c.wikiToDocument(project, page),
+			Content:    page.Content,
+		})
+	}
+	return changes, nil
+}
+
+// fetchFileChanges fetches file changes from the default branch of a project.
+func (c *Connector) fetchFileChanges(ctx context.Context, project *Project) ([]*domain.Change, error) {
+	tree, err := c.client.GetTree(ctx, project.ID, project.DefaultBranch)
+	if err != nil {
+		return nil, fmt.Errorf("get tree: %w", err)
+	}
+
+	var changes []*domain.Change
+	for _, entry := range tree {
+		// Check if file should be included
+		if !c.shouldIncludeFile(entry.Path) {
+			continue
+		}
+
+		change, err := c.fileChange(ctx, project, entry)
+		if err != nil {
+			// Skip files we can't fetch
+			continue
+		}
+		if change != nil {
+			changes = append(changes, change)
+		}
+	}
+
+	return changes, nil
+}
+
+// fileChange fetches the content of a tree entry as an added change.
+// Returns nil for files larger than the configured maximum.
+func (c *Connector) fileChange(ctx context.Context, project *Project, entry *TreeEntry) (*domain.Change, error) {
+	file, err := c.client.GetFile(ctx, project.ID, entry.Path, project.DefaultBranch)
+	if err != nil {
+		return nil, err
+	}
+
+	// Skip if file is too large
+	if file.Size > c.config.MaxFileSize {
+		return nil, nil
+	}
+
+	// Decode base64 content
+	decodedContent := ""
+	if file.Encoding == "base64" {
+		decoded, err := base64.StdEncoding.DecodeString(file.Content)
+		if err == nil {
+			decodedContent = string(decoded)
+		}
+	} else {
+		decodedContent = file.Content
+	}
+
+	return &domain.Change{
+		Type:       domain.ChangeTypeAdded,
+		ExternalID: externalID("file", project.ID, entry.ID),
+		Document:   c.fileToDocument(project, entry, file),
+		Content:    decodedContent,
+	}, nil
+}
+
+// shouldIncludeFile checks if a file should be included based on configuration.
+func (c *Connector) shouldIncludeFile(path string) bool {
+	// Check excluded paths
+	for _, exclude := range c.config.ExcludePaths {
+		matched, _ := filepath.Match(exclude, path)
+		if matched {
+			return false
+		}
+		// Check if path starts with excluded directory
+		if strings.HasSuffix(exclude, "/") && strings.HasPrefix(path, exclude) {
+			return false
+		}
+	}
+
+	// Check file extensions
+	if len(c.config.FileExtensions) > 0 {
+		ext := filepath.Ext(path)
+		found := false
+		for _, allowedExt := range c.config.FileExtensions {
+			if ext == allowedExt || ext == "."+allowedExt {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FetchDocument fetches a single document by external ID.
+func (c *Connector) FetchDocument(ctx context.Context, source *domain.Source, externalID string) (*domain.Change, error) {
+	docType, projectID, identifier, err := parseExternalID(externalID)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := c.client.GetProject(ctx, strconv.FormatInt(projectID, 10))
+	if err != nil {
+		return nil, fmt.Errorf("get project: %w", err)
+	}
+
+	switch docType {
+	case "issue":
+		iid, err := strconv.Atoi(identifier)
+		if err != nil {
+			return nil, fmt.Errorf("invalid external ID format: %s", externalID)
+		}
+		issue, err := c.client.GetIssue(ctx, projectID, iid)
+		if err != nil {
+			return nil, fmt.Errorf("get issue: %w", err)
+		}
+		return &domain.Change{
+			Type:       domain.ChangeTypeModified,
+			ExternalID: externalID,
+			Document:   c.issueToDocument(project, issue),
+			Content:    c.formatIssueContent(issue),
+		}, nil
+	case "mr":
+		iid, err := strconv.Atoi(identifier)
+		if err != nil {
+			return nil, fmt.Errorf("invalid external ID format: %s", externalID)
+		}
+		mr, err := c.client.GetMergeRequest(ctx, projectID, iid)
+		if err != nil {
+			return nil, fmt.Errorf("get merge request: %w", err)
+		}
+		return &domain.Change{
+			Type:       domain.ChangeTypeModified,
+			ExternalID: externalID,
+			Document:   c.mrToDocument(project, mr),
+			Content:    c.formatMRContent(mr),
+		}, nil
+	case "wiki":
+		page, err := c.client.GetWikiPage(ctx, projectID, identifier)
+		if err != nil {
+			return nil, fmt.Errorf("get wiki page: %w", err)
+		}
+		return &domain.Change{
+			Type:       domain.ChangeTypeModified,
+			ExternalID: externalID,
+			Document:   c.wikiToDocument(project, page),
+			Content:    page.Content,
+		}, nil
+	case "file":
+		// Files are identified by blob SHA, so find the path in the
+		// current tree; a file whose content changed has a new SHA
+		tree, err := c.client.GetTree(ctx, projectID, project.DefaultBranch)
+		if err != nil {
+			return nil, fmt.Errorf("get tree: %w", err)
+		}
+		for _, entry := range tree {
+			if entry.ID == identifier {
+				change, err := c.fileChange(ctx, project, entry)
+				if err == nil && change == nil {
+					return nil, fmt.Errorf("%w: file %s exceeds the size limit", domain.ErrNotFound, entry.Path)
+				}
+				return change, err
+			}
+		}
+		return nil, fmt.Errorf("%w: file %s", domain.ErrNotFound, identifier)
+	default:
+		return nil, fmt.Errorf("unknown document type: %s", docType)
+	}
+}
+
+// TestConnection tests the connection to the project or group.
+func (c *Connector) TestConnection(ctx context.Context, source *domain.Source) error {
+	if c.isGroup {
+		_, _, err := c.client.ListGroupProjects(ctx, c.path, "")
+		return err
+	}
+	_, err := c.client.GetProject(ctx, c.path)
+	return err
+}
+
+// externalID formats the external ID of a document of a project.
+// Format: "<type>-<project ID>-<identifier>"
+func externalID(docType string, projectID int64, identifier string) string {
+	return fmt.Sprintf("%s-%d-%s", docType, projectID, identifier)
+}
+
+// parseExternalID parses an external ID formatted by externalID.
+func parseExternalID(id string) (docType string, projectID int64, identifier string, err error) {
+	parts := strings.SplitN(id, "-", 3)
+	if len(parts) != 3 || parts[2] == "" {
+		return "", 0, "", fmt.Errorf("invalid external ID format: %s", id)
+	}
+	projectID, err = strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return "", 0, "", fmt.Errorf("invalid external ID format: %s", id)
+	}
+	return parts[0], projectID, parts[2], nil
+}
+
+// issueToDocument converts a GitLab issue to a domain document.
+func (c *Connector) issueToDocument(project *Project, issue *Issue) *domain.Document {
+	metadata := map[string]string{
+		"number":   fmt.Sprintf("%d", issue.IID),
+		"state":    issue.State,
+		"comments": fmt.Sprintf("%d", issue.UserNotesCount),
+		"project":  project.PathWithNamespace,
+	}
+
+	if issue.Author != nil {
+		metadata["author"] = issue.Author.Username
+	}
+
+	if len(issue.Labels) > 0 {
+		metadata["labels"] = strings.Join(issue.Labels, ",")
+	}
+
+	return &domain.Document{
+		Title:     issue.Title,
+		Path:      issue.WebURL,
+		MimeType:  "application/x-gitlab-issue",
+		Metadata:  metadata,
+		CreatedAt: issue.CreatedAt,
+		UpdatedAt: issue.UpdatedAt,
+	}
+}
+
+// mrToDocument converts a GitLab merge request to a domain document.
+func (c *Connector) mrToDocument(project *Project, mr *MergeRequest) *domain.Document {
+	metadata := map[string]string{
+		"number":        fmt.Sprintf("%d", mr.IID),
+		"state":         mr.State,
+		"project":       project.PathWithNamespace,
+		"source_branch": mr.SourceBranch,
+		"target_branch": mr.TargetBranch,
+	}
+
+	if mr.Author != nil {
+		metadata["author"] = mr.Author.Username
+	}
+
+	if len(mr.Labels) > 0 {
+		metadata["labels"] = strings.Join(mr.Labels, ",")
+	}
+
+	if mr.MergedAt != nil {
+		metadata["merged"] = "true"
+	}
+
+	if mr.Draft {
+		metadata["draft"] = "true"
+	}
+
+	return &domain.Document{
+		Title:     mr.Title,
+		Path:      mr.WebURL,
+		MimeType:  "application/x-gitlab-mr",
+		Metadata:  metadata,
+		CreatedAt: mr.CreatedAt,
+		UpdatedAt: mr.UpdatedAt,
+	}
+}
+
+// wikiToDocument converts a GitLab wiki page to a domain document.
+func (c *Connector) wikiToDocument(project *Project, page *WikiPage) *domain.Document {
+	mimeType := "text/plain"
+	if page.Format == "markdown" {
+		mimeType = "text/markdown"
+	}
+
+	return &domain.Document{
+		Title:    page.Title,
+		Path:     project.WebURL + "/-/wikis/" + page.Slug,
+		MimeType: mimeType,
+		Metadata: map[string]string{
+			"slug":    page.Slug,
+			"format":  page.Format,
+			"project": project.PathWithNamespace,
+		},
+	}
+}
+
+// fileToDocument converts a GitLab file to a domain document.
+func (c *Connector) fileToDocument(project *Project, entry *TreeEntry, file *File) *domain.Document {
+	mimeType := c.guessMimeType(entry.Path)
+
+	return &domain.Document{
+		Title:    entry.Path,
+		Path:     fmt.Sprintf("%s/-/blob/%s/%s", project.WebURL, project.DefaultBranch, entry.Path),
+		MimeType: mimeType,
+		Metadata: map[string]string{
+			"file_path": entry.Path,
+			"sha":       entry.ID,
+			"size":      fmt.Sprintf("%d", file.Size),
+			"project":   project.PathWithNamespace,
+		},
+	}
+}
+
+// guessMimeType guesses the MIME type from file extension.
+func (c *Connector) guessMimeType(path string) string {
+	ext := strings.ToLower(filepath.Ext(path))
+	switch ext {
+	case ".md", ".markdown":
+		return "text/markdown"
+	case ".txt":
+		return "text/plain"
+	case ".go":
+		return "text/x-go"
+	case ".py":
+		return "text/x-python"
+	case ".js":
+		return "application/javascript"
+	case ".ts":
+		return "application/typescript"
+	case ".json":
+		return "application/json"
+	case ".yaml", ".yml":
+		return "text/yaml"
+	case ".html", ".htm":
+		return "text/html"
+	case ".css":
+		return "text/css"
+	case ".rs":
+		return "text/x-rust"
+	case ".java":
+		return "text/x-java"
+	case ".rb":
+		return "text/x-ruby"
+	case ".sh", ".bash":
+		return "text/x-shellscript"
+	case ".pdf":
+		return "application/pdf"
+	case ".docx":
+		return "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+	case ".xlsx":
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case ".pptx":
+		return "application/vnd.openxmlformats-officedocument.presentationml.presentation"
+	default:
+		return "text/plain"
+	}
+}
+
+// formatIssueContent formats issue content for indexing.
+func (c *Connector) formatIssueContent(issue *Issue) string {
+	var sb strings.Builder
+	sb.WriteString("# ")
+	sb.WriteString(issue.Title)
+	sb.WriteString("\n\n")
+
+	if len(issue.Labels) > 0 {
+		sb.WriteString("Labels: ")
+		sb.WriteString(strings.Join(issue.Labels, ", "))
+		sb.WriteString("\n\n")
+	}
+
+	if issue.Description != "" {
+		sb.WriteString(issue.Description)
+	}
+
+	return sb.String()
+}
+
+// formatMRContent formats merge request content for indexing.
+func (c *Connector) formatMRContent(mr *MergeRequest) string {
+	var sb strings.Builder
+	sb.WriteString("# ")
+	sb.WriteString(mr.Title)
+	sb.WriteString("\n\n")
+
+	sb.WriteString(fmt.Sprintf("Branch: %s → %s\n\n", mr.SourceBranch, mr.TargetBranch))
+
+	if len(mr.Labels) > 0 {
+		sb.WriteString("Labels: ")
+		sb.WriteString(strings.Join(mr.Labels, ", "))
+		sb.WriteString("\n\n")
+	}
+
+	if mr.Description != "" {
+		sb.WriteString(mr.Description)
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,297 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// newTestServer serves a GitLab API with one project, acme/app (ID 7).
+// Requests are recorded by escaped path and query.
+func newTestServer(t *testing.T) (*httptest.Server, *[]string) {
+	t.Helper()
+	var requests []string
+
+	writeJSON := func(w http.ResponseWriter, v any) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(v)
+	}
+
+	project := map[string]any{
+		"id": 7, "name": "app", "path_with_namespace": "acme/app", "default_branch": "main",
+		"web_url": "https://gitlab.example.com/acme/app", "issues_enabled": true,
+		"merge_requests_enabled": true, "wiki_enabled": true,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.EscapedPath()+"?"+r.URL.RawQuery)
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		// Lists of issues and merge requests honour updated_after
+		writeUpdated := func(items ...map[string]any) {
+			updatedAfter := r.URL.Query().Get("updated_after")
+			kept := []any{}
+			for _, item := range items {
+				if updatedAfter == "" || item["updated_at"].(string) > updatedAfter {
+					kept = append(kept, item)
+				}
+			}
+			writeJSON(w, kept)
+		}
+
+		switch r.URL.EscapedPath() {
+		case "/api/v4/projects/acme%2Fapp", "/api/v4/projects/7":
+			writeJSON(w, project)
+		case "/api/v4/groups/acme/projects":
+			writeJSON(w, []any{project})
+		case "/api/v4/projects/7/issues":
+			if r.URL.Query().Get("page") == "1" {
+				w.Header().Set("X-Next-Page", "2")
+				writeUpdated(map[string]any{
+					"iid": 1, "title": "Crash on start", "description": "Stack trace", "state": "opened",
+					"labels": []string{"bug"}, "author": map[string]any{"username": "ann"},
+					"updated_at": "2026-03-01T10:00:00Z",
+				})
+				return
+			}
+			writeUpdated(map[string]any{"iid": 2, "title": "Docs", "updated_at": "2026-03-02T10:00:00Z"})
+		case "/api/v4/projects/7/issues/1":
+			writeJSON(w, map[string]any{"iid": 1, "title": "Crash on start", "updated_at": "2026-03-01T10:00:00Z"})
+		case "/api/v4/projects/7/merge_requests":
+			writeUpdated(map[string]any{
+				"iid": 3, "title": "Fix crash", "state": "merged", "source_branch": "fix", "target_branch": "main",
+				"merged_at": "2026-03-03T10:00:00Z", "updated_at": "2026-03-03T10:00:00Z",
+			})
+		case "/api/v4/projects/7/wikis":
+			writeJSON(w, []any{map[string]any{"slug": "setup/install", "title": "Install", "format": "markdown", "content": "Run it"}})
+		case "/api/v4/projects/7/repository/tree":
+			writeJSON(w, []any{
+				map[string]any{"id": "sha-readme", "type": "blob", "path": "README.md"},
+				map[string]any{"id": "sha-docs", "type": "tree", "path": "docs"},
+				map[string]any{"id": "sha-dep", "type": "blob", "path": "node_modules/dep.js"},
+			})
+		case "/api/v4/projects/7/repository/files/README.md":
+			writeJSON(w, map[string]any{
+				"file_path": "README.md", "size": 7, "encoding": "base64",
+				"content": base64.StdEncoding.EncodeToString([]byte("# Hello")),
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func newTestConnector(baseURL, path string, isGroup bool) *Connector {
+	config := DefaultConfig()
+	config.BaseURL = baseURL
+	tokenProvider := driven.NewStaticTokenProvider(&domain.Credentials{AuthMethod: domain.AuthMethodPAT, APIKey: "test-token"})
+	return NewConnector(tokenProvider, path, isGroup, config)
+}
+
+func TestConnector_FetchChanges(t *testing.T) {
+	server, _ := newTestServer(t)
+	c := newTestConnector(server.URL, "acme/app", false)
+
+	changes, cursor, err := c.FetchChanges(context.Background(), &domain.Source{}, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	byID := make(map[string]*domain.Change)
+	for _, change := range changes {
+		byID[change.ExternalID] = change
+	}
+	if len(changes) != 5 {
+		t.Errorf("expected 2 issues, 1 merge request, 1 wiki page and 1 file, got %d changes", len(changes))
+	}
+
+	issue := byID["issue-7-1"]
+	if issue == nil || issue.Document.MimeType != "application/x-gitlab-issue" || issue.Document.Metadata["labels"] != "bug" {
+		t.Errorf("unexpected issue change: %+v", issue)
+	}
+	if byID["issue-7-2"] == nil {
+		t.Error("expected the second page of issues to be fetched")
+	}
+	if mr := byID["mr-7-3"]; mr == nil || mr.Document.Metadata["merged"] != "true" {
+		t.Errorf("unexpected merge request change: %+v", mr)
+	}
+	if wiki := byID["wiki-7-setup/install"]; wiki == nil || wiki.Document.Path != "https://gitlab.example.com/acme/app/-/wikis/setup/install" {
+		t.Errorf("unexpected wiki change: %+v", wiki)
+	}
+	if file := byID["file-7-sha-readme"]; file == nil || file.Content != "# Hello" || file.Document.Metadata["file_path"] != "README.md" {
+		t.Errorf("unexpected file change: %+v", file)
+	}
+	if cursor != `{"acme/app":"2026-03-03T10:00:00Z"}` {
+		t.Errorf("expected the project's position at the latest update, got %q", cursor)
+	}
+}
+
+func TestConnector_FetchChanges_Incremental(t *testing.T) {
+	server, requests := newTestServer(t)
+	c := newTestConnector(server.URL, "acme/app", false)
+
+	changes, _, err := c.FetchChanges(context.Background(), &domain.Source{}, "2026-03-01T00:00:00Z")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, change := range changes {
+		if change.Document.MimeType == "text/markdown" {
+			t.Errorf("expected wiki pages and files to be skipped, got %s", change.ExternalID)
+		}
+	}
+
+	var filtered int
+	for _, req := range *requests {
+		if strings.Contains(req, "updated_after=2026-03-01T00%3A00%3A00Z") {
+			filtered++
+		}
+	}
+	if filtered != 3 { // Two pages of issues, one of merge requests
+		t.Errorf("expected issue and merge request requests to filter by updated_after, got %v", *requests)
+	}
+
+	// Without changes the project's position is kept
+	cursor := `{"acme/app":"2026-04-01T00:00:00Z"}`
+	changes, next, err := c.FetchChanges(context.Background(), &domain.Source{}, cursor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(changes) != 0 || next != cursor {
+		t.Errorf("expected no changes and the cursor kept, got %d changes and %q", len(changes), next)
+	}
+}
+
+func TestBuilder_MergeCursors(t *testing.T) {
+	b := NewBuilder()
+	merged := b.MergeCursors(`{"acme/app":"2026-03-02T10:00:00Z"}`, `{"group:acme/tools":"2026-03-01T10:00:00Z"}`)
+	if merged != `{"acme/app":"2026-03-02T10:00:00Z","group:acme/tools":"2026-03-01T10:00:00Z"}` {
+		t.Errorf("expected the position of each container kept, got %q", merged)
+	}
+}
+
+func TestConnector_Group(t *testing.T) {
+	server, _ := newTestServer(t)
+	c := newTestConnector(server.URL, "acme", true)
+
+	changes, _, err := c.FetchChanges(context.Background(), &domain.Source{}, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(changes) != 5 {
+		t.Errorf("expected the group's project to be synced, got %d changes", len(changes))
+	}
+	if err := c.TestConnection(context.Background(), &domain.Source{}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestConnector_FetchDocument(t *testing.T) {
+	server, _ := newTestServer(t)
+	c := newTestConnector(server.URL, "acme/app", false)
+
+	change, err := c.FetchDocument(context.Background(), &domain.Source{}, "issue-7-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if change.Document.Title != "Crash on start" {
+		t.Errorf("unexpected document: %+v", change.Document)
+	}
+
+	if _, err := c.FetchDocument(context.Background(), &domain.Source{}, "file-7-sha-gone"); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for a file no longer in the tree, got %v", err)
+	}
+	if _, err := c.FetchDocument(context.Background(), &domain.Source{}, "issue-1"); err == nil {
+		t.Error("expected an error for a malformed external ID")
+	}
+}
+
+func TestClient_RateLimited(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	c := newTestConnector(server.URL, "acme/app", false)
+	_, err := c.client.GetProject(context.Background(), "acme/app")
+
+	var rateLimitErr *domain.RateLimitError
+	if !errors.As(err, &rateLimitErr) || rateLimitErr.RetryAfter != 30*time.Second {
+		t.Errorf("expected a rate limit error with a 30s delay, got %v", err)
+	}
+}
+
+func TestContainerLister_ListContainers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v4/groups":
+			_ = json.NewEncoder(w).Encode([]any{map[string]any{"full_path": "acme/platform", "full_name": "Acme / Platform"}})
+		case "/api/v4/projects":
+			_ = json.NewEncoder(w).Encode([]any{map[string]any{"path_with_namespace": "acme/app", "name": "app"}})
+		}
+	}))
+	defer server.Close()
+
+	tokenProvider := driven.NewStaticTokenProvider(&domain.Credentials{AuthMethod: domain.AuthMethodPAT, APIKey: "test-token"})
+	lister := NewContainerLister(tokenProvider, server.URL)
+
+	groups, cursor, err := lister.ListContainers(context.Background(), "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(groups) != 1 || groups[0].ID != "group:acme/platform" || groups[0].Type != "group" {
+		t.Errorf("unexpected groups: %+v", groups)
+	}
+
+	projects, cursor, err := lister.ListContainers(context.Background(), cursor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(projects) != 1 || projects[0].ID != "acme/app" || projects[0].Type != "project" {
+		t.Errorf("unexpected projects: %+v", projects)
+	}
+	if cursor != "" {
+		t.Errorf("expected the listing to end after the last page of projects, got cursor %q", cursor)
+	}
+}
+
+func TestParseContainerID(t *testing.T) {
+	tests := []struct {
+		containerID string
+		path        string
+		isGroup     bool
+		wantErr     bool
+	}{
+		{"acme/app", "acme/app", false, false},
+		{"acme/platform/app", "acme/platform/app", false, false},
+		{"group:acme", "acme", true, false},
+		{"group:acme/platform", "acme/platform", true, false},
+		{"acme", "", false, true},
+		{"group:", "", false, true},
+	}
+
+	for _, tt := range tests {
+		path, isGroup, err := ParseContainerID(tt.containerID)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseContainerID(%q): unexpected error %v", tt.containerID, err)
+			continue
+		}
+		if path != tt.path || isGroup != tt.isGroup {
+			t.Errorf("ParseContainerID(%q) = %q, %v; want %q, %v", tt.containerID, path, isGroup, tt.path, tt.isGroup)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Ensure ContainerLister implements the interface.
+var _ driven.ContainerLister = (*ContainerLister)(nil)
+
+// Cursor prefixes of the two lists a ContainerLister pages through:
+// groups first, then projects.
+const (
+	groupsCursorPrefix   = "groups:"
+	projectsCursorPrefix = "projects:"
+)
+
+// ContainerLister lists GitLab groups and projects accessible with an
+// installation's credentials.
+type ContainerLister struct {
+	client *Client
+}
+
+// NewContainerLister creates a ContainerLister with the given token provider.
+func NewContainerLister(tokenProvider driven.TokenProvider, baseURL string) *ContainerLister {
+	return &ContainerLister{
+		client: NewClient(tokenProvider, baseURL),
+	}
+}
+
+// ListContainers lists the groups and then the projects the authenticated
+// user is a member of. Groups are returned as "group:<full path>", selecting
+// every project of the group and its subgroups, and projects as
+// "<group>/<project>".
+func (l *ContainerLister) ListContainers(ctx context.Context, cursor string) ([]*driven.Container, string, error) {
+	if page, ok := strings.CutPrefix(cursor, projectsCursorPrefix); ok {
+		return l.listProjects(ctx, page)
+	}
+
+	page, _ := strings.CutPrefix(cursor, groupsCursorPrefix)
+	resp, err := l.client.ListAccessibleGroups(ctx, page)
+	if err != nil {
+		return nil, "", fmt.Errorf("list groups: %w", err)
+	}
+
+	containers := make([]*driven.Container, len(resp.Groups))
+	for i, group := range resp.Groups {
+		containers[i] = &driven.Container{
+			ID:          FormatGroupContainerID(group.FullPath),
+			Name:        group.FullName,
+			Description: group.Description,
+			Type:        "group",
+			Metadata: map[string]string{
+				"full_path":  group.FullPath,
+				"visibility": group.Visibility,
+				"web_url":    group.WebURL,
+			},
+		}
+	}
+
+	// Continue with the projects after the last page of groups
+	nextCursor := projectsCursorPrefix
+	if resp.NextCursor != "" {
+		nextCursor = groupsCursorPrefix + resp.NextCursor
+	}
+	return containers, nextCursor, nil
+}
+
+// listProjects lists a page of the projects the authenticated user is a member of.
+func (l *ContainerLister) listProjects(ctx context.Context, page string) ([]*driven.Container, string, error) {
+	resp, err := l.client.ListAccessibleProjects(ctx, page)
+	if err != nil {
+		return nil, "", fmt.Errorf("list projects: %w", err)
+	}
+
+	containers := make([]*driven.Container, len(resp.Projects))
+	for i, project := range resp.Projects {
+		namespace := ""
+		if project.Namespace != nil {
+			namespace = project.Namespace.FullPath
+		}
+		containers[i] = &driven.Container{
+			ID:          project.PathWithNamespace, // "group/project" format
+			Name:        project.Name,
+			Description: project.Description,
+			Type:        "project",
+			Metadata: map[string]string{
+				"namespace":      namespace,
+				"visibility":     project.Visibility,
+				"archived":       fmt.Sprintf("%t", project.Archived),
+				"default_branch": project.DefaultBranch,
+				"web_url":        project.WebURL,
+			},
+		}
+	}
+
+	nextCursor := ""
+	if resp.NextCursor != "" {
+		nextCursor = projectsCursorPrefix + resp.NextCursor
+	}
+	return containers, nextCursor, nil
+}
+
+// ContainerListerFactory creates ContainerListers for GitLab installations.
+type ContainerListerFactory struct {
+	installationStore driven.InstallationStore
+	tokenFactory      driven.TokenProviderFactory
+	baseURL           string
+}
+
+// NewContainerListerFactory creates a factory for GitLab container listers
+// of the instance at baseURL (GitLab.com if empty).
+func NewContainerListerFactory(
+	installationStore driven.InstallationStore,
+	tokenFactory driven.TokenProviderFactory,
+	baseURL string,
+) *ContainerListerFactory {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	return &ContainerListerFactory{
+		installationStore: installationStore,
+		tokenFactory:      tokenFactory,
+		baseURL:           baseURL,
+	}
+}
+
+// Create creates a ContainerLister for a GitLab installation.
+func (f *ContainerListerFactory) Create(ctx context.Context, installationID string) (driven.ContainerLister, error) {
+	tokenProvider, err := f.tokenFactory.Create(ctx, installationID)
+	if err != nil {
+		return nil, fmt.Errorf("create token provider: %w", err)
+	}
+
+	return NewContainerLister(tokenProvider, f.baseURL), nil
+}
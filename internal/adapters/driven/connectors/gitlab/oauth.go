@@ -0,0 +1,195 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-core/internal/adapters/driven/connectors"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Ensure OAuthHandler implements the interface.
+var _ connectors.OAuthHandler = (*OAuthHandler)(nil)
+
+// defaultScopes are the OAuth scopes requested from GitLab: read access to
+// the API (issues, merge requests, wikis), repositories and the user.
+var defaultScopes = []string{"read_api", "read_repository", "read_user"}
+
+// OAuthHandler handles OAuth operations for a GitLab instance.
+type OAuthHandler struct {
+	httpClient *http.Client
+	baseURL    string
+}
+
+// NewOAuthHandler creates a new OAuth handler for the GitLab instance at
+// baseURL (GitLab.com if empty).
+func NewOAuthHandler(baseURL string) *OAuthHandler {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	return &OAuthHandler{
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+// BuildAuthURL constructs the GitLab OAuth authorization URL.
+func (h *OAuthHandler) BuildAuthURL(clientID, redirectURI, state, codeChallenge string, scopes []string) string {
+	params := url.Values{
+		"client_id":             {clientID},
+		"redirect_uri":          {redirectURI},
+		"state":                 {state},
+		"scope":                 {strings.Join(scopes, " ")},
+		"response_type":         {"code"},
+		"code_challenge":        {codeChallenge},
+		"code_challenge_method": {"S256"},
+	}
+	return h.baseURL + "/oauth/authorize?" + params.Encode()
+}
+
+// ExchangeCode exchanges an authorization code for tokens.
+func (h *OAuthHandler) ExchangeCode(ctx context.Context, clientID, clientSecret, code, redirectURI, codeVerifier string) (*driven.OAuthToken, error) {
+	params := url.Values{
+		"client_id":     {clientID},
+		"client_secret": {clientSecret},
+		"code":          {code},
+		"grant_type":    {"authorization_code"},
+		"redirect_uri":  {redirectURI},
+	}
+	if codeVerifier != "" {
+		params.Set("code_verifier", codeVerifier)
+	}
+
+	token, err := h.requestToken(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("token exchange failed: %w", err)
+	}
+	return token, nil
+}
+
+// RefreshToken refreshes an expired access token.
+// GitLab access tokens expire after two hours; each refresh also replaces
+// the refresh token.
+func (h *OAuthHandler) RefreshToken(ctx context.Context, clientID, clientSecret, refreshToken string) (*driven.OAuthToken, error) {
+	params := url.Values{
+		"client_id":     {clientID},
+		"client_secret": {clientSecret},
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+	}
+
+	token, err := h.requestToken(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("token refresh failed: %w", err)
+	}
+	return token, nil
+}
+
+// requestToken posts params to the token endpoint.
+func (h *OAuthHandler) requestToken(ctx context.Context, params url.Values) (*driven.OAuthToken, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST",
+		h.baseURL+"/oauth/token",
+		strings.NewReader(params.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("do request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+
+	var tokenResp struct {
+		AccessToken  string `json:"access_token"`
+		TokenType    string `json:"token_type"`
+		Scope        string `json:"scope"`
+		RefreshToken string `json:"refresh_token"`
+		ExpiresIn    int    `json:"expires_in"`
+		Error        string `json:"error"`
+		ErrorDesc    string `json:"error_description"`
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		if json.Unmarshal(body, &tokenResp) == nil && tokenResp.Error != "" {
+			return nil, fmt.Errorf("oauth error: %s - %s", tokenResp.Error, tokenResp.ErrorDesc)
+		}
+		return nil, fmt.Errorf("status %d: %s", resp.StatusCode, string(body))
+	}
+
+	if err := json.Unmarshal(body, &tokenResp); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+
+	return &driven.OAuthToken{
+		AccessToken:  tokenResp.AccessToken,
+		RefreshToken: tokenResp.RefreshToken,
+		TokenType:    tokenResp.TokenType,
+		Scope:        tokenResp.Scope,
+		ExpiresIn:    tokenResp.ExpiresIn,
+	}, nil
+}
+
+// GetUserInfo fetches the authenticated user's information.
+func (h *OAuthHandler) GetUserInfo(ctx context.Context, accessToken string) (*driven.OAuthUserInfo, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", h.baseURL+"/api/v4/user", nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("do request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("get user info failed: %s", string(body))
+	}
+
+	var user User
+	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
+		return nil, fmt.Errorf("decode user: %w", err)
+	}
+
+	name := user.Name
+	if name == "" {
+		name = user.Username
+	}
+
+	return &driven.OAuthUserInfo{
+		ID:       fmt.Sprintf("%d", user.ID),
+		Email:    user.Email,
+		Name:     name,
+		ImageURL: user.AvatarURL,
+	}, nil
+}
+
+// DefaultConfig returns GitLab's default OAuth configuration.
+func (h *OAuthHandler) DefaultConfig() connectors.OAuthDefaults {
+	return connectors.OAuthDefaults{
+		AuthURL:      h.baseURL + "/oauth/authorize",
+		TokenURL:     h.baseURL + "/oauth/token",
+		Scopes:       defaultScopes,
+		UserInfoURL:  h.baseURL + "/api/v4/user",
+		SupportsPKCE: true,
+	}
+}
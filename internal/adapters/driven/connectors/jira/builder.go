@@ -0,0 +1,74 @@
+package jira
+
+import (
+	"context"
+
+	"github.com/custodia-labs/sercha-core/internal/adapters/driven/connectors"
+	"github.com/custodia-labs/sercha-core/internal/adapters/driven/connectors/atlassian"
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Ensure Builder implements the interfaces.
+var (
+	_ driven.ConnectorBuilder = (*Builder)(nil)
+	_ driven.CursorMerger     = (*Builder)(nil)
+)
+
+// Builder creates Jira connectors.
+type Builder struct {
+	config *Config
+}
+
+// NewBuilder creates a new Jira connector builder.
+func NewBuilder() *Builder {
+	return &Builder{
+		config: DefaultConfig(),
+	}
+}
+
+// NewBuilderWithConfig creates a builder with custom configuration,
+// e.g. for a Jira site authenticated with API tokens.
+func NewBuilderWithConfig(config *Config) *Builder {
+	return &Builder{
+		config: config,
+	}
+}
+
+// Type returns the provider type.
+func (b *Builder) Type() domain.ProviderType {
+	return domain.ProviderTypeJira
+}
+
+// Build creates a Jira connector scoped to a project.
+// containerID is a project key, e.g. "PROJ". Without one, the connector
+// syncs the source's configured projects and JQL, or else every project.
+func (b *Builder) Build(ctx context.Context, tokenProvider driven.TokenProvider, containerID string) (driven.Connector, error) {
+	return NewConnector(tokenProvider, containerID, b.config), nil
+}
+
+// SupportsOAuth returns true - Jira Cloud supports OAuth 2.0 (3LO).
+func (b *Builder) SupportsOAuth() bool {
+	return true
+}
+
+// OAuthConfig returns Jira Cloud OAuth configuration.
+func (b *Builder) OAuthConfig() *driven.OAuthConfig {
+	return &driven.OAuthConfig{
+		AuthURL:     atlassian.AuthURL,
+		TokenURL:    atlassian.TokenURL,
+		Scopes:      defaultScopes,
+		UserInfoURL: atlassian.UserInfoURL,
+	}
+}
+
+// SupportsContainerSelection returns true - Jira supports project selection.
+func (b *Builder) SupportsContainerSelection() bool {
+	return true
+}
+
+// MergeCursors combines the cursors of two projects of one sync. Jira
+// cursors hold the latest update time seen in each project.
+func (b *Builder) MergeCursors(cursor, other string) string {
+	return connectors.MergeTimeCursors(cursor, other)
+}
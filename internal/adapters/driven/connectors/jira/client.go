@@ -0,0 +1,282 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/custodia-labs/sercha-core/internal/adapters/driven/connectors/atlassian"
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Client provides Jira REST API (v2) operations.
+type Client struct {
+	api      *atlassian.Client
+	pageSize int
+
+	mu           sync.Mutex
+	legacySearch bool // Set once the site turns out not to support /search/jql
+}
+
+// NewClient creates a new Jira API client for the Jira at baseURL (see
+// Config.BaseURL).
+func NewClient(tokenProvider driven.TokenProvider, baseURL string) *Client {
+	return &Client{
+		api:      atlassian.NewClient(tokenProvider, "jira", baseURL, ""),
+		pageSize: 50,
+	}
+}
+
+// Time is a timestamp as formatted by Jira, e.g. "2026-03-01T10:00:00.000+0000".
+type Time struct {
+	time.Time
+}
+
+// UnmarshalJSON parses a Jira timestamp.
+func (t *Time) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil || s == "" {
+		return err
+	}
+	for _, layout := range []string{"2006-01-02T15:04:05.000-0700", time.RFC3339Nano} {
+		if parsed, err := time.Parse(layout, s); err == nil {
+			t.Time = parsed
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid time %q", s)
+}
+
+// Project represents a Jira project.
+type Project struct {
+	ID             string `json:"id"`
+	Key            string `json:"key"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	ProjectTypeKey string `json:"projectTypeKey"` // "software", "business"...
+}
+
+// User represents a Jira user.
+type User struct {
+	AccountID    string `json:"accountId"`
+	Name         string `json:"name"` // Data Center
+	DisplayName  string `json:"displayName"`
+	EmailAddress string `json:"emailAddress"`
+}
+
+// Named is a Jira value identified by name, such as an issue type.
+type Named struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// Status represents the status of an issue.
+type Status struct {
+	Name           string `json:"name"`
+	StatusCategory struct {
+		Key  string `json:"key"` // "new", "indeterminate" or "done"
+		Name string `json:"name"`
+	} `json:"statusCategory"`
+}
+
+// Comment represents a comment on an issue.
+type Comment struct {
+	ID      string `json:"id"`
+	Author  *User  `json:"author"`
+	Body    string `json:"body"` // Wiki markup
+	Created Time   `json:"created"`
+}
+
+// CommentPage is a page of the comments of an issue.
+type CommentPage struct {
+	Comments   []*Comment `json:"comments"`
+	StartAt    int        `json:"startAt"`
+	MaxResults int        `json:"maxResults"`
+	Total      int        `json:"total"`
+}
+
+// IssueFields are the system fields of an issue.
+type IssueFields struct {
+	Summary     string       `json:"summary"`
+	Description string       `json:"description"` // Wiki markup
+	Status      *Status      `json:"status"`
+	IssueType   *Named       `json:"issuetype"`
+	Priority    *Named       `json:"priority"`
+	Resolution  *Named       `json:"resolution"`
+	Project     *Project     `json:"project"`
+	Assignee    *User        `json:"assignee"`
+	Reporter    *User        `json:"reporter"`
+	Labels      []string     `json:"labels"`
+	Comment     *CommentPage `json:"comment"`
+	Created     Time         `json:"created"`
+	Updated     Time         `json:"updated"`
+}
+
+// Issue represents a Jira issue.
+type Issue struct {
+	ID     string
+	Key    string
+	Fields IssueFields
+	Custom map[string]json.RawMessage // Custom field values by field ID, e.g. "customfield_10010"
+}
+
+// UnmarshalJSON decodes an issue, keeping its non-empty custom fields.
+func (i *Issue) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		ID     string          `json:"id"`
+		Key    string          `json:"key"`
+		Fields json.RawMessage `json:"fields"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	i.ID, i.Key = raw.ID, raw.Key
+	if len(raw.Fields) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(raw.Fields, &i.Fields); err != nil {
+		return err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw.Fields, &fields); err != nil {
+		return err
+	}
+	for id, value := range fields {
+		if strings.HasPrefix(id, "customfield_") && string(value) != "null" {
+			if i.Custom == nil {
+				i.Custom = make(map[string]json.RawMessage)
+			}
+			i.Custom[id] = value
+		}
+	}
+	return nil
+}
+
+// SearchResponse is a page of issues matching a JQL query.
+type SearchResponse struct {
+	Issues     []*Issue
+	Names      map[string]string // Field names by field ID
+	NextCursor string
+}
+
+// SearchIssues searches issues with JQL, returning the given fields
+// (comma-separated, e.g. "*all") and the names of the fields. The cursor
+// is empty for the first page; the returned cursor is empty on the last
+// page. Jira Cloud pages with tokens through /search/jql; Data Center,
+// which lacks it, pages by offset through /search.
+func (c *Client) SearchIssues(ctx context.Context, jql, fields, cursor string) (*SearchResponse, error) {
+	c.mu.Lock()
+	legacy := c.legacySearch
+	c.mu.Unlock()
+
+	params := url.Values{
+		"jql":        {jql},
+		"fields":     {fields},
+		"expand":     {"names"},
+		"maxResults": {strconv.Itoa(c.pageSize)},
+	}
+
+	if !legacy {
+		if cursor != "" {
+			params.Set("nextPageToken", cursor)
+		}
+		var resp struct {
+			Issues        []*Issue          `json:"issues"`
+			Names         map[string]string `json:"names"`
+			NextPageToken string            `json:"nextPageToken"`
+			IsLast        bool              `json:"isLast"`
+		}
+		err := c.api.Get(ctx, "/rest/api/2/search/jql?"+params.Encode(), &resp)
+		if err == nil {
+			nextCursor := resp.NextPageToken
+			if resp.IsLast {
+				nextCursor = ""
+			}
+			return &SearchResponse{Issues: resp.Issues, Names: resp.Names, NextCursor: nextCursor}, nil
+		}
+		if !errors.Is(err, domain.ErrNotFound) || cursor != "" {
+			return nil, err
+		}
+
+		c.mu.Lock()
+		c.legacySearch = true
+		c.mu.Unlock()
+	}
+
+	startAt, _ := strconv.Atoi(cursor)
+	params.Del("nextPageToken")
+	params.Set("startAt", strconv.Itoa(startAt))
+	var resp struct {
+		Issues []*Issue          `json:"issues"`
+		Names  map[string]string `json:"names"`
+		Total  int               `json:"total"`
+	}
+	if err := c.api.Get(ctx, "/rest/api/2/search?"+params.Encode(), &resp); err != nil {
+		return nil, err
+	}
+
+	nextCursor := ""
+	if next := startAt + len(resp.Issues); len(resp.Issues) > 0 && next < resp.Total {
+		nextCursor = strconv.Itoa(next)
+	}
+	return &SearchResponse{Issues: resp.Issues, Names: resp.Names, NextCursor: nextCursor}, nil
+}
+
+// ListComments lists every comment on an issue, oldest first.
+func (c *Client) ListComments(ctx context.Context, issueID string) ([]*Comment, error) {
+	var comments []*Comment
+	for {
+		path := fmt.Sprintf("/rest/api/2/issue/%s/comment?orderBy=created&startAt=%d&maxResults=%d",
+			url.PathEscape(issueID), len(comments), c.pageSize)
+
+		var page CommentPage
+		if err := c.api.Get(ctx, path, &page); err != nil {
+			return nil, err
+		}
+		comments = append(comments, page.Comments...)
+
+		if len(page.Comments) == 0 || len(comments) >= page.Total {
+			return comments, nil
+		}
+	}
+}
+
+// ListProjects lists the projects visible to the user.
+func (c *Client) ListProjects(ctx context.Context) ([]*Project, error) {
+	var projects []*Project
+	if err := c.api.Get(ctx, "/rest/api/2/project?expand=description", &projects); err != nil {
+		return nil, err
+	}
+	return projects, nil
+}
+
+// GetProject gets a project by key.
+func (c *Client) GetProject(ctx context.Context, key string) (*Project, error) {
+	var project Project
+	if err := c.api.Get(ctx, "/rest/api/2/project/"+url.PathEscape(key), &project); err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+// GetMyself gets the authenticated user.
+func (c *Client) GetMyself(ctx context.Context) (*User, error) {
+	var user User
+	if err := c.api.Get(ctx, "/rest/api/2/myself", &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// WebURL returns the URL of the Jira site in a browser.
+func (c *Client) WebURL(ctx context.Context) (string, error) {
+	return c.api.WebURL(ctx)
+}
@@ -0,0 +1,34 @@
+package jira
+
+import "time"
+
+// Config contains configuration for the Jira connector.
+type Config struct {
+	// BaseURL is the Jira URL, e.g. https://acme.atlassian.net for Jira
+	// Cloud or https://jira.example.com for Data Center. It is required for
+	// API token authentication. With OAuth it selects the Cloud site; if
+	// empty, the first site the token can access is used.
+	BaseURL string
+
+	// PageSize is the number of issues to fetch per page.
+	// Jira returns at most 100 issues with all their fields.
+	PageSize int
+
+	// MaxRetries is the maximum number of retry attempts for failed requests.
+	MaxRetries int
+
+	// UpdatedOverlap is how long before the cursor incremental syncs
+	// search. JQL compares updated at minute precision in the user's time
+	// zone, so the search starts early enough to cover any time zone;
+	// issues not updated after the cursor are skipped.
+	UpdatedOverlap time.Duration
+}
+
+// DefaultConfig returns the default Jira connector configuration.
+func DefaultConfig() *Config {
+	return &Config{
+		PageSize:       50,
+		MaxRetries:     3,
+		UpdatedOverlap: 24 * time.Hour,
+	}
+}
@@ -0,0 +1,526 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-core/internal/adapters/driven/connectors"
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Ensure Connector implements the interface.
+var _ driven.Connector = (*Connector)(nil)
+
+// MimeTypeIssue is the MIME type of a Jira issue, whose content keeps
+// Jira's wiki markup.
+const MimeTypeIssue = "application/x-jira-issue"
+
+// jqlTimeFormat is the format of dates in JQL queries.
+const jqlTimeFormat = "2006/01/02 15:04"
+
+var (
+	// orderByPattern matches the ORDER BY clause ending a JQL query.
+	orderByPattern = regexp.MustCompile(`(?is)\s*\border\s+by\b.*$`)
+
+	// nonAlphanumericPattern matches runs of characters not allowed in
+	// metadata keys.
+	nonAlphanumericPattern = regexp.MustCompile(`[^a-z0-9]+`)
+
+	// projectKeyPattern matches a valid Jira project key.
+	projectKeyPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+)
+
+// Connector fetches issues from Jira projects, or the issues matching a
+// JQL query.
+type Connector struct {
+	tokenProvider driven.TokenProvider
+	projectKey    string // Empty for the source's configured projects or JQL
+	client        *Client
+	config        *Config
+}
+
+// NewConnector creates a Jira connector scoped to a project, or to the
+// source's configured projects and JQL if projectKey is empty.
+func NewConnector(tokenProvider driven.TokenProvider, projectKey string, config *Config) *Connector {
+	if config == nil {
+		config = DefaultConfig()
+	}
+	client := NewClient(tokenProvider, config.BaseURL)
+	if config.PageSize > 0 && config.PageSize <= 100 {
+		client.pageSize = config.PageSize
+	}
+	if config.MaxRetries > 0 {
+		client.api.SetMaxRetries(config.MaxRetries)
+	}
+	return &Connector{
+		tokenProvider: tokenProvider,
+		projectKey:    projectKey,
+		client:        client,
+		config:        config,
+	}
+}
+
+// Type returns the provider type.
+func (c *Connector) Type() domain.ProviderType {
+	return domain.ProviderTypeJira
+}
+
+// ValidateConfig validates source configuration.
+func (c *Connector) ValidateConfig(config domain.SourceConfig) error {
+	for _, key := range config.ProjectKeys {
+		if !projectKeyPattern.MatchString(strings.TrimSpace(key)) {
+			return fmt.Errorf("%w: invalid project key %q", domain.ErrInvalidInput, key)
+		}
+	}
+	return nil
+}
+
+// FetchChanges fetches the issues changed in the connector's scope: its
+// project, else the source's configured projects and JQL, else every
+// project. Each issue, with its comments, is one document.
+// For initial sync (empty cursor), it fetches every issue in scope.
+// For incremental sync, it fetches the issues updated after the project's
+// position in the cursor, and deletes the issues of the scope's projects
+// updated since that no longer match the scope, e.g. issues resolved out of
+// an "resolution = Unresolved" query. A scope of only JQL has no projects;
+// the cursor records those its issues were in. Deleted issues are
+// reconciled by full syncs.
+func (c *Connector) FetchChanges(ctx context.Context, source *domain.Source, cursor string) ([]*domain.Change, string, error) {
+	position := connectors.ParseTimeCursor(cursor)
+	since := position.Since(c.projectKey)
+
+	projectClause, err := c.projectClause(ctx, source)
+	if err != nil {
+		return nil, "", err
+	}
+	candidateClause := projectClause
+	if projectClause == "" {
+		candidateClause = scopeProjectsClause(position)
+	}
+
+	// Issues updated since the cursor that may have left the scope are
+	// listed before the scope is searched, so that an issue updated into
+	// the scope in between is not deleted
+	var candidates []*Issue
+	if since != nil && candidateClause != "" {
+		candidates, err = c.searchAll(ctx, c.buildJQL(since, candidateClause), "updated", since, nil)
+		if err != nil {
+			return nil, "", fmt.Errorf("search updated issues: %w", err)
+		}
+	}
+
+	var changes []*domain.Change
+	var lastUpdated time.Time
+	inScope := make(map[string]bool)
+	scopeProjects := make(map[string]time.Time) // Latest update by project key
+	_, err = c.searchAll(ctx, c.buildJQL(since, projectClause, userJQL(source)), "*all", since, func(issue *Issue, names map[string]string) error {
+		change, err := c.issueChange(ctx, issue, names)
+		if err != nil {
+			return fmt.Errorf("issue %s: %w", issue.Key, err)
+		}
+		if since == nil {
+			change.Type = domain.ChangeTypeAdded
+		}
+		changes = append(changes, change)
+		inScope[issue.ID] = true
+
+		if issue.Fields.Updated.After(lastUpdated) {
+			lastUpdated = issue.Fields.Updated.Time
+		}
+		if project := issue.Fields.Project; project != nil && issue.Fields.Updated.After(scopeProjects[project.Key]) {
+			scopeProjects[project.Key] = issue.Fields.Updated.Time
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("search issues: %w", err)
+	}
+
+	for _, issue := range candidates {
+		if !inScope[issue.ID] {
+			changes = append(changes, &domain.Change{
+				Type:       domain.ChangeTypeDeleted,
+				ExternalID: externalID(issue.ID),
+			})
+		}
+	}
+
+	// Move the project's position to the latest updated time, keeping it
+	// when nothing in scope changed
+	next := connectors.ContainerTimeCursor(c.projectKey, since, lastUpdated)
+	if projectClause == "" {
+		next = connectors.MergeTimeCursors(next, scopeProjectsCursor(position, scopeProjects))
+	}
+	return changes, next, nil
+}
+
+// scopeProjectPrefix prefixes the cursor entries of the projects holding
+// issues of a JQL-only scope, each at the latest update of its issues.
+const scopeProjectPrefix = "scope:"
+
+// scopeProjectsClause returns the JQL clause selecting the projects that
+// held issues of a JQL-only scope, as recorded in the cursor. Empty if none
+// were recorded.
+func scopeProjectsClause(position connectors.TimeCursor) string {
+	var quoted []string
+	for _, id := range position.ContainerIDs() {
+		if key, ok := strings.CutPrefix(id, scopeProjectPrefix); ok {
+			quoted = append(quoted, strconv.Quote(key))
+		}
+	}
+	if len(quoted) == 0 {
+		return ""
+	}
+	return "project in (" + strings.Join(quoted, ",") + ")"
+}
+
+// scopeProjectsCursor returns the cursor entries of the projects holding
+// issues of a JQL-only scope: those of the previous cursor, which may still
+// hold unchanged issues, and the projects of the issues just synced.
+func scopeProjectsCursor(position connectors.TimeCursor, projects map[string]time.Time) string {
+	cursor := ""
+	for _, id := range position.ContainerIDs() {
+		if strings.HasPrefix(id, scopeProjectPrefix) {
+			cursor = connectors.MergeTimeCursors(cursor, connectors.ContainerTimeCursor(id, position.Since(id), time.Time{}))
+		}
+	}
+	for key, updated := range projects {
+		cursor = connectors.MergeTimeCursors(cursor, connectors.ContainerTimeCursor(scopeProjectPrefix+key, nil, updated))
+	}
+	return cursor
+}
+
+// searchAll pages through the issues matching jql with the given fields,
+// skipping issues not updated after since. Issues are passed to visit, if
+// set, as they are fetched, and returned otherwise.
+func (c *Connector) searchAll(
+	ctx context.Context,
+	jql, fields string,
+	since *time.Time,
+	visit func(issue *Issue, names map[string]string) error,
+) ([]*Issue, error) {
+	var issues []*Issue
+	names := make(map[string]string)
+	cursor := ""
+	for {
+		resp, err := c.client.SearchIssues(ctx, jql, fields, cursor)
+		if err != nil {
+			return nil, err
+		}
+		for id, name := range resp.Names {
+			names[id] = name
+		}
+
+		for _, issue := range resp.Issues {
+			// The search starts before the cursor; skip what was synced
+			if since != nil && !issue.Fields.Updated.After(*since) {
+				continue
+			}
+			if visit == nil {
+				issues = append(issues, issue)
+			} else if err := visit(issue, names); err != nil {
+				return nil, err
+			}
+		}
+
+		if resp.NextCursor == "" {
+			return issues, nil
+		}
+		cursor = resp.NextCursor
+	}
+}
+
+// projectClause returns the JQL clause selecting the projects the
+// connector is scoped to: its project, else the source's configured
+// projects. Without either, it selects every project if the source has no
+// JQL, and is empty otherwise.
+func (c *Connector) projectClause(ctx context.Context, source *domain.Source) (string, error) {
+	var keys []string
+	switch {
+	case c.projectKey != "":
+		keys = []string{c.projectKey}
+	case len(source.Config.ProjectKeys) > 0:
+		for _, key := range source.Config.ProjectKeys {
+			if key = strings.TrimSpace(key); key != "" {
+				keys = append(keys, key)
+			}
+		}
+	case userJQL(source) == "":
+		// Jira Cloud rejects unbounded queries, so list the projects
+		projects, err := c.client.ListProjects(ctx)
+		if err != nil {
+			return "", fmt.Errorf("list projects: %w", err)
+		}
+		for _, project := range projects {
+			keys = append(keys, project.Key)
+		}
+		if len(keys) == 0 {
+			return "", fmt.Errorf("%w: no accessible projects", domain.ErrNotFound)
+		}
+	}
+
+	if len(keys) == 0 {
+		return "", nil
+	}
+	quoted := make([]string, len(keys))
+	for i, key := range keys {
+		quoted[i] = strconv.Quote(key)
+	}
+	return "project in (" + strings.Join(quoted, ",") + ")", nil
+}
+
+// userJQL returns the source's JQL query without its ORDER BY clause.
+func userJQL(source *domain.Source) string {
+	return strings.TrimSpace(orderByPattern.ReplaceAllString(source.Config.JQL, ""))
+}
+
+// joinClauses joins the non-empty JQL clauses with AND, parenthesizing
+// each when there are several.
+func joinClauses(clauses ...string) string {
+	var parts []string
+	for _, clause := range clauses {
+		if clause != "" {
+			parts = append(parts, clause)
+		}
+	}
+	if len(parts) < 2 {
+		return strings.Join(parts, "")
+	}
+	return "(" + strings.Join(parts, ") AND (") + ")"
+}
+
+// buildJQL builds the JQL query selecting the issues matching every
+// clause to sync, ordered by update. JQL compares updated at minute
+// precision in the user's time zone, so incremental queries start
+// UpdatedOverlap before since.
+func (c *Connector) buildJQL(since *time.Time, clauses ...string) string {
+	if since != nil {
+		from := since.Add(-c.config.UpdatedOverlap).UTC()
+		clauses = append(clauses, fmt.Sprintf("updated >= %q", from.Format(jqlTimeFormat)))
+	}
+	return strings.TrimSpace(joinClauses(clauses...) + " ORDER BY updated ASC")
+}
+
+// FetchDocument fetches a single issue by external ID. Issues that no
+// longer match the connector's scope are not found.
+func (c *Connector) FetchDocument(ctx context.Context, source *domain.Source, externalID string) (*domain.Change, error) {
+	id, ok := strings.CutPrefix(externalID, "issue-")
+	if !ok || id == "" {
+		return nil, fmt.Errorf("invalid external ID format: %s", externalID)
+	}
+	if _, err := strconv.ParseInt(id, 10, 64); err != nil {
+		return nil, fmt.Errorf("invalid external ID format: %s", externalID)
+	}
+
+	projectClause, err := c.projectClause(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.SearchIssues(ctx, joinClauses("id = "+id, projectClause, userJQL(source)), "*all", "")
+	if err != nil {
+		return nil, fmt.Errorf("search issue: %w", err)
+	}
+	if len(resp.Issues) == 0 {
+		return nil, fmt.Errorf("%w: issue %s", domain.ErrNotFound, id)
+	}
+
+	change, err := c.issueChange(ctx, resp.Issues[0], resp.Names)
+	if err != nil {
+		return nil, err
+	}
+	change.ExternalID = externalID
+	return change, nil
+}
+
+// TestConnection tests the connection to the project, or to Jira.
+func (c *Connector) TestConnection(ctx context.Context, source *domain.Source) error {
+	if c.projectKey != "" {
+		_, err := c.client.GetProject(ctx, c.projectKey)
+		return err
+	}
+	_, err := c.client.GetMyself(ctx)
+	return err
+}
+
+// externalID formats the external ID of an issue.
+// Format: "issue-<issue ID>"; unlike keys, IDs survive moves between projects.
+func externalID(issueID string) string {
+	return "issue-" + issueID
+}
+
+// issueChange builds the change of an issue, fetching the comments that
+// did not fit in the issue's comment field.
+func (c *Connector) issueChange(ctx context.Context, issue *Issue, names map[string]string) (*domain.Change, error) {
+	var comments []*Comment
+	if page := issue.Fields.Comment; page != nil {
+		comments = page.Comments
+		if page.Total > len(page.Comments) {
+			all, err := c.client.ListComments(ctx, issue.ID)
+			if err != nil {
+				return nil, fmt.Errorf("list comments: %w", err)
+			}
+			comments = all
+		}
+	}
+
+	webURL, err := c.client.WebURL(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Change{
+		Type:       domain.ChangeTypeModified,
+		ExternalID: externalID(issue.ID),
+		Document:   c.issueToDocument(issue, comments, names, webURL),
+		Content:    c.formatIssueContent(issue, comments),
+	}, nil
+}
+
+// issueToDocument converts a Jira issue to a domain document. Custom
+// field values are kept as "custom_<field name>" metadata.
+func (c *Connector) issueToDocument(issue *Issue, comments []*Comment, names map[string]string, webURL string) *domain.Document {
+	fields := issue.Fields
+	metadata := map[string]string{
+		"key":      issue.Key,
+		"comments": strconv.Itoa(len(comments)),
+	}
+
+	if fields.Project != nil {
+		metadata["project"] = fields.Project.Key
+	}
+	if fields.Status != nil {
+		metadata["status"] = fields.Status.Name
+		metadata["status_category"] = fields.Status.StatusCategory.Key
+	}
+	if fields.IssueType != nil {
+		metadata["issue_type"] = fields.IssueType.Name
+	}
+	if fields.Priority != nil {
+		metadata["priority"] = fields.Priority.Name
+	}
+	if fields.Resolution != nil {
+		metadata["resolution"] = fields.Resolution.Name
+	}
+	if fields.Assignee != nil {
+		metadata["assignee"] = fields.Assignee.DisplayName
+	}
+	if fields.Reporter != nil {
+		metadata["reporter"] = fields.Reporter.DisplayName
+	}
+	if len(fields.Labels) > 0 {
+		metadata["labels"] = strings.Join(fields.Labels, ",")
+	}
+
+	for id, raw := range issue.Custom {
+		value := customFieldValue(raw)
+		if value == "" {
+			continue
+		}
+		name := names[id]
+		if name == "" {
+			name = id
+		}
+		key := strings.Trim(nonAlphanumericPattern.ReplaceAllString(strings.ToLower(name), "_"), "_")
+		if key == "" {
+			key = id
+		}
+		metadata["custom_"+key] = value
+	}
+
+	return &domain.Document{
+		Title:     issue.Key + ": " + fields.Summary,
+		Path:      strings.TrimSuffix(webURL, "/") + "/browse/" + issue.Key,
+		MimeType:  MimeTypeIssue,
+		Metadata:  metadata,
+		CreatedAt: fields.Created.Time,
+		UpdatedAt: fields.Updated.Time,
+	}
+}
+
+// customFieldValue formats the value of a custom field as text: options,
+// users and other objects by their value or name, and arrays as
+// comma-separated values. It returns "" for values without text.
+func customFieldValue(raw json.RawMessage) string {
+	var value any
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return ""
+	}
+	return formatFieldValue(value)
+}
+
+// formatFieldValue formats a decoded custom field value.
+func formatFieldValue(value any) string {
+	switch v := value.(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case []any:
+		var values []string
+		for _, item := range v {
+			if s := formatFieldValue(item); s != "" {
+				values = append(values, s)
+			}
+		}
+		return strings.Join(values, ",")
+	case map[string]any:
+		for _, key := range []string{"value", "name", "displayName", "key"} {
+			if s, ok := v[key].(string); ok && s != "" {
+				// Cascading selects hold their second level as a child
+				if child := formatFieldValue(v["child"]); child != "" {
+					return s + " / " + child
+				}
+				return s
+			}
+		}
+	}
+	return ""
+}
+
+// formatIssueContent formats issue content for indexing.
+func (c *Connector) formatIssueContent(issue *Issue, comments []*Comment) string {
+	var sb strings.Builder
+	sb.WriteString("# ")
+	sb.WriteString(issue.Key)
+	sb.WriteString(": ")
+	sb.WriteString(issue.Fields.Summary)
+	sb.WriteString("\n\n")
+
+	if len(issue.Fields.Labels) > 0 {
+		sb.WriteString("Labels: ")
+		sb.WriteString(strings.Join(issue.Fields.Labels, ", "))
+		sb.WriteString("\n\n")
+	}
+
+	if issue.Fields.Description != "" {
+		sb.WriteString(issue.Fields.Description)
+		sb.WriteString("\n\n")
+	}
+
+	if len(comments) > 0 {
+		sb.WriteString("## Comments\n\n")
+		for _, comment := range comments {
+			author := "Unknown"
+			if comment.Author != nil {
+				author = comment.Author.DisplayName
+			}
+			sb.WriteString(author)
+			sb.WriteString(" (")
+			sb.WriteString(comment.Created.UTC().Format("2006-01-02 15:04"))
+			sb.WriteString("):\n")
+			sb.WriteString(comment.Body)
+			sb.WriteString("\n\n")
+		}
+	}
+
+	return strings.TrimSpace(sb.String())
+}
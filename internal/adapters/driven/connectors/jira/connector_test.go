@@ -0,0 +1,309 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// newTestServer serves a Jira site with the project PROJ. Searches for all
+// fields return PROJ-1, whose comments do not all fit in the issue, and
+// PROJ-2 across two pages; searches for the updated field, listing what
+// changed, also return PROJ-3, which no longer matches, and PROJ-0, which
+// was not updated recently. Without /search/jql (Data Center), searches
+// page by offset. Searches are recorded by their JQL.
+func newTestServer(t *testing.T, dataCenter bool) (*httptest.Server, *[]string) {
+	t.Helper()
+	var queries []string
+
+	issue := func(id, key, updated string) map[string]any {
+		return map[string]any{
+			"id": id, "key": key,
+			"fields": map[string]any{
+				"summary":     "Summary of " + key,
+				"description": "Steps to *reproduce*",
+				"status":      map[string]any{"name": "In Progress", "statusCategory": map[string]any{"key": "indeterminate"}},
+				"issuetype":   map[string]any{"name": "Bug"},
+				"priority":    map[string]any{"name": "High"},
+				"project":     map[string]any{"key": "PROJ"},
+				"assignee":    map[string]any{"accountId": "a1", "displayName": "Ann"},
+				"reporter":    map[string]any{"accountId": "b1", "displayName": "Bob"},
+				"labels":      []string{"backend", "urgent"},
+				"created":     "2026-02-01T09:00:00.000+0000",
+				"updated":     updated,
+				"comment": map[string]any{"total": 2, "maxResults": 1, "comments": []any{
+					map[string]any{"id": "1", "body": "First", "author": map[string]any{"displayName": "Bob"}, "created": "2026-02-02T09:00:00.000+0000"},
+				}},
+				"customfield_10010": []any{map[string]any{"id": 1, "name": "Sprint 4"}},
+				"customfield_10020": 5.0,
+				"customfield_10030": map[string]any{"value": "EMEA", "child": map[string]any{"value": "Berlin"}},
+				"customfield_10040": nil,
+			},
+		}
+	}
+	names := map[string]string{
+		"customfield_10010": "Sprint", "customfield_10020": "Story Points", "customfield_10030": "Region / City",
+	}
+	proj1 := issue("10001", "PROJ-1", "2026-03-01T10:00:00.000+0000")
+	proj2 := issue("10002", "PROJ-2", "2026-03-02T10:00:00.000+0000")
+	updated := []any{
+		map[string]any{"id": "10000", "key": "PROJ-0", "fields": map[string]any{"updated": "2026-02-20T10:00:00.000+0000"}},
+		map[string]any{"id": "10002", "key": "PROJ-2", "fields": map[string]any{"updated": "2026-03-02T10:00:00.000+0000"}},
+		map[string]any{"id": "10003", "key": "PROJ-3", "fields": map[string]any{"updated": "2026-03-02T11:00:00.000+0000"}},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "ann@example.com" || pass != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+
+		query := r.URL.Query()
+		jql := query.Get("jql")
+		var results []any
+		switch {
+		case strings.HasPrefix(jql, "(id = 10001)"):
+			results = []any{proj1}
+		case strings.HasPrefix(jql, "(id = "):
+		case query.Get("fields") == "updated":
+			results = updated
+		default:
+			results = []any{proj1, proj2}
+		}
+
+		switch r.URL.Path {
+		case "/rest/api/2/search/jql":
+			if dataCenter {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			if query.Get("nextPageToken") == "" {
+				queries = append(queries, jql)
+			}
+			resp := map[string]any{"names": names, "isLast": true, "issues": results}
+			if len(results) == 2 && results[0].(map[string]any)["id"] == "10001" {
+				if query.Get("nextPageToken") == "" {
+					resp["issues"], resp["isLast"], resp["nextPageToken"] = results[:1], false, "page-2"
+				} else {
+					resp["issues"] = results[1:]
+				}
+			}
+			_ = json.NewEncoder(w).Encode(resp)
+		case "/rest/api/2/search":
+			startAt, _ := strconv.Atoi(query.Get("startAt"))
+			if startAt == 0 {
+				queries = append(queries, jql)
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"names": names, "total": len(results), "startAt": startAt, "issues": results[startAt:min(startAt+1, len(results))],
+			})
+		case "/rest/api/2/issue/10001/comment", "/rest/api/2/issue/10002/comment":
+			_ = json.NewEncoder(w).Encode(map[string]any{"total": 2, "comments": []any{
+				map[string]any{"id": "1", "body": "First", "author": map[string]any{"displayName": "Bob"}, "created": "2026-02-02T09:00:00.000+0000"},
+				map[string]any{"id": "2", "body": "Second", "author": map[string]any{"displayName": "Ann"}, "created": "2026-02-03T09:00:00.000+0000"},
+			}})
+		case "/rest/api/2/project":
+			_ = json.NewEncoder(w).Encode([]any{map[string]any{"id": "1", "key": "PROJ", "name": "Project", "projectTypeKey": "software"}})
+		case "/rest/api/2/project/PROJ":
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "1", "key": "PROJ", "name": "Project"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, &queries
+}
+
+func newTestConnector(baseURL, projectKey string) *Connector {
+	config := DefaultConfig()
+	config.BaseURL = baseURL
+	tokenProvider := driven.NewStaticTokenProvider(&domain.Credentials{AuthMethod: domain.AuthMethodAPIKey, APIKey: "ann@example.com:secret"})
+	return NewConnector(tokenProvider, projectKey, config)
+}
+
+func TestConnector_FetchChanges(t *testing.T) {
+	for _, dataCenter := range []bool{false, true} {
+		server, queries := newTestServer(t, dataCenter)
+		c := newTestConnector(server.URL, "")
+
+		source := &domain.Source{Config: domain.SourceConfig{ProjectKeys: []string{"PROJ"}}}
+		changes, cursor, err := c.FetchChanges(context.Background(), source, "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(changes) != 2 {
+			t.Fatalf("expected 2 issues across both pages, got %d changes", len(changes))
+		}
+
+		change := changes[0]
+		if change.Type != domain.ChangeTypeAdded || change.ExternalID != "issue-10001" || change.Document.MimeType != MimeTypeIssue {
+			t.Errorf("unexpected change: %+v", change)
+		}
+		if change.Document.Title != "PROJ-1: Summary of PROJ-1" || change.Document.Path != server.URL+"/browse/PROJ-1" {
+			t.Errorf("unexpected document: %+v", change.Document)
+		}
+		if !strings.Contains(change.Content, "Steps to *reproduce*") || !strings.Contains(change.Content, "Ann (2026-02-03 09:00):\nSecond") {
+			t.Errorf("expected the description and every comment, got %q", change.Content)
+		}
+
+		metadata := change.Document.Metadata
+		want := map[string]string{
+			"key": "PROJ-1", "project": "PROJ", "status": "In Progress", "status_category": "indeterminate",
+			"issue_type": "Bug", "assignee": "Ann", "reporter": "Bob", "labels": "backend,urgent", "comments": "2",
+			"custom_sprint": "Sprint 4", "custom_story_points": "5", "custom_region_city": "EMEA / Berlin",
+		}
+		for key, value := range want {
+			if metadata[key] != value {
+				t.Errorf("expected metadata %s=%q, got %q", key, value, metadata[key])
+			}
+		}
+		if _, ok := metadata["custom_customfield_10040"]; ok {
+			t.Error("expected empty custom fields to be skipped")
+		}
+
+		if cursor != `{"":"2026-03-02T10:00:00Z"}` {
+			t.Errorf("expected the latest update time as cursor, got %q", cursor)
+		}
+		if len(*queries) != 1 || (*queries)[0] != `project in ("PROJ") ORDER BY updated ASC` {
+			t.Errorf("unexpected JQL %v", *queries)
+		}
+	}
+}
+
+func TestConnector_FetchChanges_Incremental(t *testing.T) {
+	server, queries := newTestServer(t, false)
+	c := newTestConnector(server.URL, "PROJ")
+
+	source := &domain.Source{Config: domain.SourceConfig{JQL: "resolution = Unresolved ORDER BY created DESC"}}
+	changes, cursor, err := c.FetchChanges(context.Background(), source, "2026-03-01T10:00:00Z")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	byID := make(map[string]*domain.Change)
+	for _, change := range changes {
+		byID[change.ExternalID] = change
+	}
+	if len(changes) != 2 {
+		t.Errorf("expected the updated issue and a deletion, got %d changes", len(changes))
+	}
+	if change := byID["issue-10002"]; change == nil || change.Type != domain.ChangeTypeModified {
+		t.Errorf("expected the issue updated after the cursor as modified, got %+v", change)
+	}
+	if change := byID["issue-10003"]; change == nil || change.Type != domain.ChangeTypeDeleted {
+		t.Errorf("expected the issue out of scope to be deleted, got %+v", change)
+	}
+	if cursor != `{"PROJ":"2026-03-02T10:00:00Z"}` {
+		t.Errorf("unexpected cursor %q", cursor)
+	}
+
+	want := []string{
+		`(project in ("PROJ")) AND (updated >= "2026/02/28 10:00") ORDER BY updated ASC`,
+		`(project in ("PROJ")) AND (resolution = Unresolved) AND (updated >= "2026/02/28 10:00") ORDER BY updated ASC`,
+	}
+	if strings.Join(*queries, "\n") != strings.Join(want, "\n") {
+		t.Errorf("expected JQL %v, got %v", want, *queries)
+	}
+}
+
+func TestConnector_FetchChanges_JQLOnlyScope(t *testing.T) {
+	server, queries := newTestServer(t, false)
+	c := newTestConnector(server.URL, "")
+	source := &domain.Source{Config: domain.SourceConfig{JQL: "resolution = Unresolved"}}
+
+	// Without recorded projects, nothing is searched beyond the scope
+	_, cursor, err := c.FetchChanges(context.Background(), source, `{"":"2026-03-01T10:00:00Z"}`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(*queries) != 1 {
+		t.Errorf("expected only the scope to be searched, got %v", *queries)
+	}
+	if cursor != `{"":"2026-03-02T10:00:00Z","scope:PROJ":"2026-03-02T10:00:00Z"}` {
+		t.Errorf("expected the cursor to record the scope's projects, got %q", cursor)
+	}
+
+	*queries = nil
+	changes, _, err := c.FetchChanges(context.Background(), source, cursor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(*queries) == 0 || (*queries)[0] != `(project in ("PROJ")) AND (updated >= "2026/03/01 10:00") ORDER BY updated ASC` {
+		t.Errorf("expected issues that left the scope to be listed in its projects, got %v", *queries)
+	}
+	if len(changes) != 1 || changes[0].Type != domain.ChangeTypeDeleted || changes[0].ExternalID != "issue-10003" {
+		t.Errorf("expected only the issue out of scope to be deleted, got %d changes", len(changes))
+	}
+}
+
+func TestConnector_FetchDocument(t *testing.T) {
+	server, queries := newTestServer(t, false)
+	c := newTestConnector(server.URL, "PROJ")
+
+	source := &domain.Source{Config: domain.SourceConfig{JQL: "resolution = Unresolved"}}
+	change, err := c.FetchDocument(context.Background(), source, "issue-10001")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if change.ExternalID != "issue-10001" || change.Document.Metadata["key"] != "PROJ-1" {
+		t.Errorf("unexpected change: %+v", change)
+	}
+	if (*queries)[0] != `(id = 10001) AND (project in ("PROJ")) AND (resolution = Unresolved)` {
+		t.Errorf("expected the issue to be searched in scope, got %v", *queries)
+	}
+
+	if _, err := c.FetchDocument(context.Background(), source, "issue-10003"); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for an issue out of scope, got %v", err)
+	}
+	if _, err := c.FetchDocument(context.Background(), source, "issue-x"); err == nil {
+		t.Error("expected an error for an invalid external ID")
+	}
+}
+
+func TestConnector_AllProjects(t *testing.T) {
+	server, queries := newTestServer(t, false)
+	c := newTestConnector(server.URL, "")
+
+	if _, _, err := c.FetchChanges(context.Background(), &domain.Source{}, ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if (*queries)[0] != `project in ("PROJ") ORDER BY updated ASC` {
+		t.Errorf("expected every project to be searched, got %v", *queries)
+	}
+}
+
+func TestContainerLister_ListContainers(t *testing.T) {
+	server, _ := newTestServer(t, false)
+	tokenProvider := driven.NewStaticTokenProvider(&domain.Credentials{AuthMethod: domain.AuthMethodAPIKey, APIKey: "ann@example.com:secret"})
+	l := NewContainerLister(tokenProvider, server.URL)
+
+	containers, next, err := l.ListContainers(context.Background(), "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(containers) != 1 || next != "" {
+		t.Fatalf("expected one project and no next page, got %d (next %q)", len(containers), next)
+	}
+	if project := containers[0]; project.ID != "PROJ" || project.Metadata["web_url"] != server.URL+"/browse/PROJ" {
+		t.Errorf("unexpected container: %+v", project)
+	}
+}
+
+func TestConnector_ValidateConfig(t *testing.T) {
+	c := NewConnector(nil, "", nil)
+	if err := c.ValidateConfig(domain.SourceConfig{ProjectKeys: []string{"PROJ", "OPS_2"}}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := c.ValidateConfig(domain.SourceConfig{ProjectKeys: []string{`PR"OJ`}}); !errors.Is(err, domain.ErrInvalidInput) {
+		t.Errorf("expected ErrInvalidInput, got %v", err)
+	}
+}
@@ -0,0 +1,86 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Ensure ContainerLister implements the interface.
+var _ driven.ContainerLister = (*ContainerLister)(nil)
+
+// ContainerLister lists the Jira projects accessible with an
+// installation's credentials.
+type ContainerLister struct {
+	client *Client
+}
+
+// NewContainerLister creates a ContainerLister with the given token provider.
+func NewContainerLister(tokenProvider driven.TokenProvider, baseURL string) *ContainerLister {
+	return &ContainerLister{
+		client: NewClient(tokenProvider, baseURL),
+	}
+}
+
+// ListContainers lists the projects, by project key. Jira lists every
+// project at once, so there is a single page.
+func (l *ContainerLister) ListContainers(ctx context.Context, cursor string) ([]*driven.Container, string, error) {
+	projects, err := l.client.ListProjects(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("list projects: %w", err)
+	}
+
+	webURL, err := l.client.WebURL(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	containers := make([]*driven.Container, len(projects))
+	for i, project := range projects {
+		containers[i] = &driven.Container{
+			ID:          project.Key,
+			Name:        project.Name,
+			Description: project.Description,
+			Type:        "project",
+			Metadata: map[string]string{
+				"project_type": project.ProjectTypeKey,
+				"web_url":      strings.TrimSuffix(webURL, "/") + "/browse/" + project.Key,
+			},
+		}
+	}
+
+	return containers, "", nil
+}
+
+// ContainerListerFactory creates ContainerListers for Jira installations.
+type ContainerListerFactory struct {
+	installationStore driven.InstallationStore
+	tokenFactory      driven.TokenProviderFactory
+	baseURL           string
+}
+
+// NewContainerListerFactory creates a factory for Jira container listers
+// of the Jira at baseURL (see Config.BaseURL).
+func NewContainerListerFactory(
+	installationStore driven.InstallationStore,
+	tokenFactory driven.TokenProviderFactory,
+	baseURL string,
+) *ContainerListerFactory {
+	return &ContainerListerFactory{
+		installationStore: installationStore,
+		tokenFactory:      tokenFactory,
+		baseURL:           baseURL,
+	}
+}
+
+// Create creates a ContainerLister for a Jira installation.
+func (f *ContainerListerFactory) Create(ctx context.Context, installationID string) (driven.ContainerLister, error) {
+	tokenProvider, err := f.tokenFactory.Create(ctx, installationID)
+	if err != nil {
+		return nil, fmt.Errorf("create token provider: %w", err)
+	}
+
+	return NewContainerLister(tokenProvider, f.baseURL), nil
+}
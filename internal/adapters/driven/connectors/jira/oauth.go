@@ -0,0 +1,17 @@
+package jira
+
+import "github.com/custodia-labs/sercha-core/internal/adapters/driven/connectors/atlassian"
+
+// defaultScopes are the OAuth scopes requested from Atlassian: reading
+// projects and issues, users, and a refresh token.
+var defaultScopes = []string{
+	"read:jira-work",
+	"read:jira-user",
+	"read:me",
+	"offline_access",
+}
+
+// NewOAuthHandler creates an OAuth handler for Jira Cloud.
+func NewOAuthHandler() *atlassian.OAuthHandler {
+	return atlassian.NewOAuthHandler(defaultScopes)
+}
@@ -43,7 +43,8 @@ func DefaultConfig() *Config {
 }
 
 // SupportedExtensions returns the list of file extensions that are
-// considered indexable text files.
+// considered indexable. Besides text files this includes PDF and office
+// documents, whose text is extracted during sync.
 func SupportedExtensions() []string {
 	return []string{
 		".md", ".markdown",
@@ -76,5 +77,8 @@ func SupportedExtensions() []string {
 		".makefile", "Makefile",
 		".gitignore",
 		".env.example",
+		".pdf",
+		".docx", ".xlsx", ".pptx",
+		".odt", ".ods", ".odp",
 	}
 }
@@ -16,13 +16,17 @@ import (
 )
 
 // Ensure Connector implements the interface.
-var _ driven.Connector = (*Connector)(nil)
+var _ driven.PagedConnector = (*Connector)(nil)
+
+// defaultPageSize is the number of changes returned per page by FetchChangesPage.
+const defaultPageSize = 500
 
 // Connector fetches documents from a local filesystem directory.
 type Connector struct {
 	rootPath    string  // Full path to directory
 	containerID string  // Relative path (for metadata)
 	config      *Config
+	pageSize    int
 }
 
 // NewConnector creates a LocalFS connector scoped to a directory.
@@ -34,6 +38,7 @@ func NewConnector(rootPath, containerID string, config *Config) *Connector {
 		rootPath:    rootPath,
 		containerID: containerID,
 		config:      config,
+		pageSize:    defaultPageSize,
 	}
 }
 
@@ -50,18 +55,69 @@ func (c *Connector) ValidateConfig(config domain.SourceConfig) error {
 // FetchChanges walks the directory and returns files as changes.
 // Cursor format: RFC3339 timestamp of last sync.
 func (c *Connector) FetchChanges(ctx context.Context, source *domain.Source, cursor string) ([]*domain.Change, string, error) {
-	var changes []*domain.Change
+	changes, latestMod, _, err := c.walkChanges(ctx, parseCursor(cursor), "", 0)
+	if err != nil && err != context.Canceled {
+		return nil, "", fmt.Errorf("walk directory: %w", err)
+	}
+
+	return changes, nextCursor(latestMod, cursor), nil
+}
+
+// FetchChangesPage walks the directory a page of files at a time.
+// Page token format: the latest modification time walked so far (RFC3339
+// with nanoseconds) and the relative path of the last file returned,
+// separated by "|". Files are walked in lexical order, so a page resumes
+// after that path.
+func (c *Connector) FetchChangesPage(ctx context.Context, source *domain.Source, cursor, pageToken string) (*domain.ChangePage, error) {
 	var latestMod time.Time
+	var after string
+	if pageToken != "" {
+		modTime, relPath, ok := strings.Cut(pageToken, "|")
+		if !ok || relPath == "" {
+			return nil, fmt.Errorf("invalid page token: %q", pageToken)
+		}
+		if modTime != "" {
+			parsed, err := time.Parse(time.RFC3339Nano, modTime)
+			if err != nil {
+				return nil, fmt.Errorf("invalid page token: %q", pageToken)
+			}
+			latestMod = parsed
+		}
+		after = relPath
+	}
 
-	// Parse cursor (last sync timestamp)
-	var since time.Time
-	if cursor != "" {
-		parsed, err := time.Parse(time.RFC3339, cursor)
-		if err == nil {
-			since = parsed
+	changes, pageMod, last, err := c.walkChanges(ctx, parseCursor(cursor), after, c.pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("walk directory: %w", err)
+	}
+	if pageMod.After(latestMod) {
+		latestMod = pageMod
+	}
+
+	page := &domain.ChangePage{Changes: changes}
+	if last != "" {
+		var modTime string
+		if !latestMod.IsZero() {
+			modTime = latestMod.Format(time.RFC3339Nano)
 		}
+		page.NextPageToken = modTime + "|" + last
+		return page, nil
 	}
 
+	page.Cursor = nextCursor(latestMod, cursor)
+	return page, nil
+}
+
+// walkChanges walks the directory in lexical order and returns the files
+// changed since the given time as changes, starting after the file at
+// relative path after (from the beginning if empty). It stops once limit
+// changes are collected (never if limit is 0), returning the relative path
+// of the last one. It also returns the latest modification time walked.
+func (c *Connector) walkChanges(ctx context.Context, since time.Time, after string, limit int) ([]*domain.Change, time.Time, string, error) {
+	var changes []*domain.Change
+	var latestMod time.Time
+	var last string
+
 	err := filepath.WalkDir(c.rootPath, func(path string, d os.DirEntry, walkErr error) error {
 		if walkErr != nil {
 			return nil // Skip inaccessible files
@@ -73,11 +129,23 @@ func (c *Connector) FetchChanges(ctx context.Context, source *domain.Source, cur
 		default:
 		}
 
+		relPath, _ := filepath.Rel(c.rootPath, path)
+		relPath = filepath.ToSlash(relPath)
+
 		// Skip directories
 		if d.IsDir() {
 			if c.shouldExcludeDir(path) {
 				return filepath.SkipDir
 			}
+			// Skip directories walked by earlier pages
+			if after != "" && relPath != "." && !strings.HasPrefix(after, relPath+"/") && walksBefore(relPath, after) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		// Skip files returned by earlier pages
+		if after != "" && !walksBefore(after, relPath) {
 			return nil
 		}
 
@@ -119,7 +187,7 @@ func (c *Connector) FetchChanges(ctx context.Context, source *domain.Source, cur
 		}
 
 		// Generate external ID from path hash
-		relPath, _ := filepath.Rel(c.rootPath, path)
+		relPath = filepath.FromSlash(relPath)
 		externalID := c.generateExternalID(relPath)
 
 		// Create document
@@ -132,29 +200,109 @@ func (c *Connector) FetchChanges(ctx context.Context, source *domain.Source, cur
 			Content:    content,
 		})
 
+		if limit > 0 && len(changes) >= limit {
+			last = filepath.ToSlash(relPath)
+			return filepath.SkipAll
+		}
 		return nil
 	})
 
-	if err != nil && err != context.Canceled {
-		return nil, "", fmt.Errorf("walk directory: %w", err)
+	return changes, latestMod, last, err
+}
+
+// parseCursor parses a cursor into the time of the last sync.
+// An empty or invalid cursor yields the zero time.
+func parseCursor(cursor string) time.Time {
+	if cursor == "" {
+		return time.Time{}
+	}
+	parsed, err := time.Parse(time.RFC3339, cursor)
+	if err != nil {
+		return time.Time{}
 	}
+	return parsed
+}
 
-	// Update cursor
-	newCursor := ""
+// nextCursor returns the cursor following a sync whose latest walked
+// modification time was latestMod.
+func nextCursor(latestMod time.Time, cursor string) string {
 	if !latestMod.IsZero() {
-		newCursor = latestMod.Format(time.RFC3339)
-	} else if cursor != "" {
-		newCursor = cursor // Keep existing cursor if no changes
+		return latestMod.Format(time.RFC3339)
 	}
+	return cursor // Keep existing cursor if no changes
+}
 
-	return changes, newCursor, nil
+// walksBefore reports whether filepath.WalkDir visits the slash-separated
+// relative path a before b. Directory entries are walked in lexical order,
+// and a directory before its contents.
+func walksBefore(a, b string) bool {
+	aParts := strings.Split(a, "/")
+	bParts := strings.Split(b, "/")
+	for i := 0; i < len(aParts) && i < len(bParts); i++ {
+		if aParts[i] != bParts[i] {
+			return aParts[i] < bParts[i]
+		}
+	}
+	return len(aParts) < len(bParts)
 }
 
 // FetchDocument fetches a single document by external ID.
-func (c *Connector) FetchDocument(ctx context.Context, source *domain.Source, externalID string) (*domain.Document, string, error) {
-	// External ID format: "file-<path-hash>"
-	// We'd need to store path mapping to resolve this
-	return nil, "", fmt.Errorf("single document fetch not implemented for localfs")
+// External IDs are path hashes, so the directory is walked to find the file.
+func (c *Connector) FetchDocument(ctx context.Context, source *domain.Source, externalID string) (*domain.Change, error) {
+	var change *domain.Change
+	var readErr error
+
+	err := filepath.WalkDir(c.rootPath, func(path string, d os.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return nil // Skip inaccessible files
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if c.shouldExcludeDir(path) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		relPath, _ := filepath.Rel(c.rootPath, path)
+		if c.generateExternalID(relPath) != externalID {
+			return nil
+		}
+
+		// A file that is no longer indexed counts as gone
+		info, err := d.Info()
+		if err != nil || !c.shouldIncludeFile(path) || info.Size() > c.config.MaxFileSize {
+			return filepath.SkipAll
+		}
+
+		content, err := c.readFileContent(path)
+		if err != nil {
+			readErr = fmt.Errorf("read file: %w", err)
+			return filepath.SkipAll
+		}
+
+		change = &domain.Change{
+			Type:       domain.ChangeTypeModified,
+			ExternalID: externalID,
+			Document:   c.fileToDocument(path, relPath, info),
+			Content:    content,
+		}
+		return filepath.SkipAll
+	})
+	if err != nil {
+		return nil, fmt.Errorf("walk directory: %w", err)
+	}
+	if readErr != nil {
+		return nil, readErr
+	}
+	if change == nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrNotFound, externalID)
+	}
+
+	return change, nil
 }
 
 // TestConnection tests if the directory is accessible.
@@ -257,6 +405,7 @@ func (c *Connector) shouldIncludeFile(path string) bool {
 }
 
 // readFileContent reads file content as string.
+// Binary formats are passed through unchanged for the sync content extractor.
 func (c *Connector) readFileContent(path string) (string, error) {
 	file, err := os.Open(path)
 	if err != nil {
@@ -349,6 +498,13 @@ func guessMimeType(path string) string {
 		".r":          "text/x-r",
 		".dockerfile": "text/x-dockerfile",
 		".makefile":   "text/x-makefile",
+		".pdf":        "application/pdf",
+		".docx":       "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+		".xlsx":       "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+		".pptx":       "application/vnd.openxmlformats-officedocument.presentationml.presentation",
+		".odt":        "application/vnd.oasis.opendocument.text",
+		".ods":        "application/vnd.oasis.opendocument.spreadsheet",
+		".odp":        "application/vnd.oasis.opendocument.presentation",
 	}
 
 	if mime, ok := mimeTypes[ext]; ok {
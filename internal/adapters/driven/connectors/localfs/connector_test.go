@@ -2,6 +2,7 @@ package localfs
 
 import (
 	"context"
+	"errors"
 	"os"
 	"path/filepath"
 	"testing"
@@ -90,6 +91,95 @@ func TestConnector_FetchChanges(t *testing.T) {
 	}
 }
 
+func TestConnector_FetchChangesPage(t *testing.T) {
+	tmpDir := t.TempDir()
+	for _, name := range []string{"a.md", "b/c.md", "b/d.md", "b/e/f.md", "b-g.md", "h.md"} {
+		path := filepath.Join(tmpDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(name), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	c := NewConnector(tmpDir, "", nil)
+	c.pageSize = 2
+	ctx := context.Background()
+
+	var paths []string
+	var pageToken, cursor string
+	for pages := 1; ; pages++ {
+		if pages > 5 {
+			t.Fatal("expected paging to end")
+		}
+		page, err := c.FetchChangesPage(ctx, nil, "", pageToken)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, change := range page.Changes {
+			paths = append(paths, change.Document.Path)
+		}
+		if page.NextPageToken == "" {
+			cursor = page.Cursor
+			break
+		}
+		if page.Cursor != "" {
+			t.Error("expected cursor only on the last page")
+		}
+		pageToken = page.NextPageToken
+	}
+
+	want := []string{"a.md", "b/c.md", "b/d.md", "b/e/f.md", "b-g.md", "h.md"}
+	if len(paths) != len(want) {
+		t.Fatalf("expected %v, got %v", want, paths)
+	}
+	for i := range want {
+		if filepath.ToSlash(paths[i]) != want[i] {
+			t.Errorf("expected %v, got %v", want, paths)
+			break
+		}
+	}
+
+	// The cursor matches an unpaged fetch
+	_, unpagedCursor, _ := c.FetchChanges(ctx, nil, "")
+	if cursor == "" || cursor != unpagedCursor {
+		t.Errorf("expected cursor %q, got %q", unpagedCursor, cursor)
+	}
+
+	if _, err := c.FetchChangesPage(ctx, nil, "", "bogus"); err == nil {
+		t.Error("expected error for invalid page token")
+	}
+}
+
+func TestConnector_FetchDocument(t *testing.T) {
+	tmpDir := t.TempDir()
+	subDir := filepath.Join(tmpDir, "docs")
+	if err := os.Mkdir(subDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(subDir, "guide.md"), []byte("# Guide"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	c := NewConnector(tmpDir, "", nil)
+	ctx := context.Background()
+
+	externalID := c.generateExternalID(filepath.Join("docs", "guide.md"))
+	change, err := c.FetchDocument(ctx, nil, externalID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if change.ExternalID != externalID || change.Content != "# Guide" || change.Document.Title != "guide.md" {
+		t.Errorf("unexpected change %+v", change)
+	}
+
+	_, err = c.FetchDocument(ctx, nil, c.generateExternalID("missing.md"))
+	if !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for missing file, got %v", err)
+	}
+}
+
 func TestConnector_ShouldExcludeDir(t *testing.T) {
 	c := NewConnector("/tmp", "", DefaultConfig())
 
@@ -129,6 +219,9 @@ func TestGuessMimeType(t *testing.T) {
 		{"test.unknown", "text/plain"},
 		{"Dockerfile", "text/x-dockerfile"},
 		{"Makefile", "text/x-makefile"},
+		{"report.PDF", "application/pdf"},
+		{"plan.docx", "application/vnd.openxmlformats-officedocument.wordprocessingml.document"},
+		{"notes.odt", "application/vnd.oasis.opendocument.text"},
 	}
 
 	for _, tt := range tests {
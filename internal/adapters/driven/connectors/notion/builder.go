@@ -0,0 +1,104 @@
+package notion
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"github.com/custodia-labs/sercha-core/internal/adapters/driven/connectors"
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Ensure Builder implements the interfaces.
+var (
+	_ driven.ConnectorBuilder = (*Builder)(nil)
+	_ driven.CursorMerger     = (*Builder)(nil)
+)
+
+// Builder creates Notion connectors.
+type Builder struct {
+	config *Config
+
+	mu       sync.Mutex
+	limiters map[string]*limiter // By hash of the integration token
+}
+
+// NewBuilder creates a new Notion connector builder.
+func NewBuilder() *Builder {
+	return &Builder{
+		config:   DefaultConfig(),
+		limiters: make(map[string]*limiter),
+	}
+}
+
+// NewBuilderWithConfig creates a builder with custom configuration.
+func NewBuilderWithConfig(config *Config) *Builder {
+	return &Builder{
+		config:   config,
+		limiters: make(map[string]*limiter),
+	}
+}
+
+// Type returns the provider type.
+func (b *Builder) Type() domain.ProviderType {
+	return domain.ProviderTypeNotion
+}
+
+// Build creates a Notion connector scoped to a database.
+// containerID is a database ID. Without one, the connector syncs the
+// source's configured databases and pages, or else every shared page.
+// Connectors built for the same integration token share its request pacing.
+func (b *Builder) Build(ctx context.Context, tokenProvider driven.TokenProvider, containerID string) (driven.Connector, error) {
+	token, err := tokenProvider.GetAccessToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get access token: %w", err)
+	}
+
+	connector := NewConnector(tokenProvider, containerID, b.config)
+	connector.client.limiter = b.limiter(token)
+	return connector, nil
+}
+
+// limiter returns the request limiter shared by the connectors of a token.
+func (b *Builder) limiter(token string) *limiter {
+	sum := sha256.Sum256([]byte(token))
+	key := hex.EncodeToString(sum[:])
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	l, ok := b.limiters[key]
+	if !ok {
+		l = newLimiter(b.config.RequestInterval)
+		b.limiters[key] = l
+	}
+	return l
+}
+
+// SupportsOAuth returns true - Notion supports OAuth for public integrations.
+func (b *Builder) SupportsOAuth() bool {
+	return true
+}
+
+// OAuthConfig returns Notion OAuth configuration.
+func (b *Builder) OAuthConfig() *driven.OAuthConfig {
+	return &driven.OAuthConfig{
+		AuthURL:     authURL,
+		TokenURL:    tokenURL,
+		Scopes:      []string{},
+		UserInfoURL: DefaultAPIURL + "/users/me",
+	}
+}
+
+// SupportsContainerSelection returns true - Notion supports database selection.
+func (b *Builder) SupportsContainerSelection() bool {
+	return true
+}
+
+// MergeCursors combines the cursors of two databases of one sync. Notion
+// cursors hold the latest edit time seen in each database.
+func (b *Builder) MergeCursors(cursor, other string) string {
+	return connectors.MergeTimeCursors(cursor, other)
+}
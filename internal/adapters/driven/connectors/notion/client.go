@@ -0,0 +1,420 @@
+package notion
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// maxRateLimitWait is the longest Retry-After the client waits out itself;
+// longer delays are returned as rate limit errors.
+const maxRateLimitWait = time.Minute
+
+// Client provides Notion API operations.
+type Client struct {
+	tokenProvider driven.TokenProvider
+	httpClient    *http.Client
+	baseURL       string
+	pageSize      int
+	maxRetries    int
+	limiter       *limiter
+}
+
+// NewClient creates a new Notion API client.
+func NewClient(tokenProvider driven.TokenProvider, baseURL string) *Client {
+	if baseURL == "" {
+		baseURL = DefaultAPIURL
+	}
+	return &Client{
+		tokenProvider: tokenProvider,
+		httpClient:    &http.Client{Timeout: 30 * time.Second},
+		baseURL:       strings.TrimSuffix(baseURL, "/"),
+		pageSize:      100,
+		maxRetries:    5,
+		limiter:       newLimiter(350 * time.Millisecond),
+	}
+}
+
+// limiter spaces the requests sent with one integration token. Notion rate
+// limits per integration, so the clients of every connector built for a
+// token share one limiter.
+type limiter struct {
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time // Earliest time the next request may be sent
+}
+
+// newLimiter creates a limiter spacing requests by interval.
+func newLimiter(interval time.Duration) *limiter {
+	return &limiter{interval: interval}
+}
+
+// wait blocks until the next request may be sent, reserving its slot.
+func (l *limiter) wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	at := l.next
+	if at.Before(now) {
+		at = now
+	}
+	l.next = at.Add(l.interval)
+	l.mu.Unlock()
+
+	if delay := time.Until(at); delay > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+	return nil
+}
+
+// pause holds back every request for d, e.g. after Notion rate limited one.
+func (l *limiter) pause(d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if until := time.Now().Add(d); until.After(l.next) {
+		l.next = until
+	}
+}
+
+// RichText is a span of Notion rich text.
+type RichText struct {
+	PlainText   string `json:"plain_text"`
+	Href        string `json:"href"`
+	Annotations struct {
+		Bold          bool `json:"bold"`
+		Italic        bool `json:"italic"`
+		Strikethrough bool `json:"strikethrough"`
+		Code          bool `json:"code"`
+	} `json:"annotations"`
+}
+
+// Parent is the parent of a page, database or block.
+type Parent struct {
+	Type       string `json:"type"` // "workspace", "page_id", "database_id" or "block_id"
+	PageID     string `json:"page_id"`
+	DatabaseID string `json:"database_id"`
+	BlockID    string `json:"block_id"`
+}
+
+// ID returns the ID of the parent, or "" for the workspace.
+func (p Parent) ID() string {
+	switch p.Type {
+	case "page_id":
+		return p.PageID
+	case "database_id":
+		return p.DatabaseID
+	case "block_id":
+		return p.BlockID
+	}
+	return ""
+}
+
+// Page represents a Notion page, including a database row.
+type Page struct {
+	ID             string                     `json:"id"`
+	URL            string                     `json:"url"`
+	CreatedTime    time.Time                  `json:"created_time"`
+	LastEditedTime time.Time                  `json:"last_edited_time"` // Rounded to the minute
+	Archived       bool                       `json:"archived"`
+	InTrash        bool                       `json:"in_trash"`
+	Parent         Parent                     `json:"parent"`
+	Properties     map[string]json.RawMessage `json:"properties"`
+}
+
+// Database represents a Notion database.
+type Database struct {
+	ID             string     `json:"id"`
+	URL            string     `json:"url"`
+	Title          []RichText `json:"title"`
+	Description    []RichText `json:"description"`
+	LastEditedTime time.Time  `json:"last_edited_time"`
+	Archived       bool       `json:"archived"`
+	InTrash        bool       `json:"in_trash"`
+	Parent         Parent     `json:"parent"`
+}
+
+// Block represents a Notion block. Content holds the fields of the block's
+// type, e.g. the "paragraph" object of a paragraph block.
+type Block struct {
+	ID          string
+	Type        string
+	HasChildren bool
+	Content     BlockContent
+}
+
+// BlockContent are the fields of a block's type that text is taken from.
+type BlockContent struct {
+	RichText   []RichText   `json:"rich_text"`
+	Caption    []RichText   `json:"caption"`
+	Cells      [][]RichText `json:"cells"`    // table_row
+	Checked    bool         `json:"checked"`  // to_do
+	Language   string       `json:"language"` // code
+	Expression string       `json:"expression"`
+	Title      string       `json:"title"` // child_page, child_database
+	URL        string       `json:"url"`   // bookmark, embed, link_preview
+	Icon       *struct {
+		Emoji string `json:"emoji"`
+	} `json:"icon"` // callout
+	External *struct {
+		URL string `json:"url"`
+	} `json:"external"` // image, video, file, pdf
+	Name string `json:"name"` // file, pdf
+}
+
+// UnmarshalJSON decodes a block and the fields of its type.
+func (b *Block) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(raw["id"], &b.ID)
+	_ = json.Unmarshal(raw["type"], &b.Type)
+	_ = json.Unmarshal(raw["has_children"], &b.HasChildren)
+	if content, ok := raw[b.Type]; ok {
+		if err := json.Unmarshal(content, &b.Content); err != nil {
+			return fmt.Errorf("decode %s block: %w", b.Type, err)
+		}
+	}
+	return nil
+}
+
+// User represents a Notion user or bot.
+type User struct {
+	ID     string `json:"id"`
+	Type   string `json:"type"` // "person" or "bot"
+	Name   string `json:"name"`
+	Person *struct {
+		Email string `json:"email"`
+	} `json:"person"`
+	Bot *struct {
+		WorkspaceName string `json:"workspace_name"`
+		Owner         struct {
+			Type string `json:"type"`
+			User *User  `json:"user"`
+		} `json:"owner"`
+	} `json:"bot"`
+}
+
+// listResponse is a page of a paginated list.
+type listResponse[T any] struct {
+	Results    []T    `json:"results"`
+	HasMore    bool   `json:"has_more"`
+	NextCursor string `json:"next_cursor"`
+}
+
+// next returns the cursor of the next page, or "" on the last page.
+func (r *listResponse[T]) next() string {
+	if !r.HasMore {
+		return ""
+	}
+	return r.NextCursor
+}
+
+// SearchPages lists a page of the pages shared with the integration,
+// including database rows, most recently edited first.
+func (c *Client) SearchPages(ctx context.Context, cursor string) ([]*Page, string, error) {
+	var resp listResponse[*Page]
+	if err := c.search(ctx, "page", cursor, &resp); err != nil {
+		return nil, "", err
+	}
+	return resp.Results, resp.next(), nil
+}
+
+// SearchDatabases lists a page of the databases shared with the
+// integration, most recently edited first.
+func (c *Client) SearchDatabases(ctx context.Context, cursor string) ([]*Database, string, error) {
+	var resp listResponse[*Database]
+	if err := c.search(ctx, "database", cursor, &resp); err != nil {
+		return nil, "", err
+	}
+	return resp.Results, resp.next(), nil
+}
+
+// search searches the objects of a type shared with the integration.
+func (c *Client) search(ctx context.Context, objectType, cursor string, out any) error {
+	body := map[string]any{
+		"filter":    map[string]string{"property": "object", "value": objectType},
+		"sort":      map[string]string{"timestamp": "last_edited_time", "direction": "descending"},
+		"page_size": c.pageSize,
+	}
+	if cursor != "" {
+		body["start_cursor"] = cursor
+	}
+	return c.do(ctx, "POST", "/search", body, out)
+}
+
+// QueryDatabase lists a page of the rows of a database, least recently
+// edited first. If since is set, only rows edited on or after it are listed.
+func (c *Client) QueryDatabase(ctx context.Context, databaseID string, since *time.Time, cursor string) ([]*Page, string, error) {
+	body := map[string]any{
+		"sorts":     []any{map[string]string{"timestamp": "last_edited_time", "direction": "ascending"}},
+		"page_size": c.pageSize,
+	}
+	if since != nil {
+		body["filter"] = map[string]any{
+			"timestamp":        "last_edited_time",
+			"last_edited_time": map[string]string{"on_or_after": since.UTC().Format(time.RFC3339)},
+		}
+	}
+	if cursor != "" {
+		body["start_cursor"] = cursor
+	}
+
+	var resp listResponse[*Page]
+	if err := c.do(ctx, "POST", "/databases/"+url.PathEscape(databaseID)+"/query", body, &resp); err != nil {
+		return nil, "", err
+	}
+	return resp.Results, resp.next(), nil
+}
+
+// GetDatabase gets a database by ID.
+func (c *Client) GetDatabase(ctx context.Context, databaseID string) (*Database, error) {
+	var database Database
+	if err := c.do(ctx, "GET", "/databases/"+url.PathEscape(databaseID), nil, &database); err != nil {
+		return nil, err
+	}
+	return &database, nil
+}
+
+// GetPage gets a page by ID.
+func (c *Client) GetPage(ctx context.Context, pageID string) (*Page, error) {
+	var page Page
+	if err := c.do(ctx, "GET", "/pages/"+url.PathEscape(pageID), nil, &page); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+// ListBlockChildren lists a page of the children of a block or page.
+func (c *Client) ListBlockChildren(ctx context.Context, blockID, cursor string) ([]*Block, string, error) {
+	path := fmt.Sprintf("/blocks/%s/children?page_size=%d", url.PathEscape(blockID), c.pageSize)
+	if cursor != "" {
+		path += "&start_cursor=" + url.QueryEscape(cursor)
+	}
+
+	var resp listResponse[*Block]
+	if err := c.do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, "", err
+	}
+	return resp.Results, resp.next(), nil
+}
+
+// GetMe gets the integration's bot user.
+func (c *Client) GetMe(ctx context.Context) (*User, error) {
+	var user User
+	if err := c.do(ctx, "GET", "/users/me", nil, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// do performs an authenticated request and decodes the JSON response into
+// out. Requests are spaced by the request interval. Rate-limited requests
+// are retried after the delay Notion asks for, or with exponential
+// backoff, and server errors with exponential backoff.
+func (c *Client) do(ctx context.Context, method, path string, body any, out any) error {
+	token, err := c.tokenProvider.GetAccessToken(ctx)
+	if err != nil {
+		return fmt.Errorf("get access token: %w", err)
+	}
+
+	var payload []byte
+	if body != nil {
+		if payload, err = json.Marshal(body); err != nil {
+			return fmt.Errorf("encode request: %w", err)
+		}
+	}
+
+	var resp *http.Response
+	for attempt := 0; attempt <= c.maxRetries; attempt++ {
+		if err := c.limiter.wait(ctx); err != nil {
+			return err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, bytes.NewReader(payload))
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Notion-Version", APIVersion)
+		req.Header.Set("Accept", "application/json")
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		resp, err = c.httpClient.Do(req)
+		if err != nil {
+			return fmt.Errorf("do request: %w", err)
+		}
+
+		// Success or non-retryable error
+		if resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
+			break
+		}
+		if attempt == c.maxRetries {
+			break
+		}
+
+		// Rate limited or server error - retry after the requested delay,
+		// else with exponential backoff
+		delay := time.Duration(1<<attempt) * time.Second
+		if resp.StatusCode == http.StatusTooManyRequests {
+			if resp.Header.Get("Retry-After") != "" {
+				if delay = retryAfter(resp); delay > maxRateLimitWait {
+					break
+				}
+			}
+			// The limit applies to the integration, so every client of the
+			// token waits
+			c.limiter.pause(delay)
+		}
+		resp.Body.Close()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		data, _ := io.ReadAll(resp.Body)
+		apiErr := fmt.Errorf("notion API error %d: %s", resp.StatusCode, string(data))
+		switch resp.StatusCode {
+		case http.StatusNotFound:
+			return fmt.Errorf("%w: %v", domain.ErrNotFound, apiErr)
+		case http.StatusTooManyRequests:
+			return &domain.RateLimitError{RetryAfter: retryAfter(resp), Err: apiErr}
+		}
+		return apiErr
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	return nil
+}
+
+// retryAfter returns how long Notion asked a rate-limited client to wait
+// (zero if unknown).
+func retryAfter(resp *http.Response) time.Duration {
+	if secs, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second
+	}
+	return 0
+}
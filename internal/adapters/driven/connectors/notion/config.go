@@ -0,0 +1,41 @@
+package notion
+
+import "time"
+
+// DefaultAPIURL is the Notion API URL.
+const DefaultAPIURL = "https://api.notion.com/v1"
+
+// APIVersion is the Notion API version requests are made with.
+const APIVersion = "2022-06-28"
+
+// Config contains configuration for the Notion connector.
+type Config struct {
+	// APIURL is the Notion API URL.
+	APIURL string
+
+	// PageSize is the number of items to fetch per page.
+	// Notion returns at most 100 items per page.
+	PageSize int
+
+	// MaxRetries is the maximum number of retry attempts for requests that
+	// failed or were rate limited.
+	MaxRetries int
+
+	// RequestInterval is the minimum time between requests. Notion allows
+	// an average of three requests per second per integration.
+	RequestInterval time.Duration
+
+	// MaxDepth is how deep nested blocks are walked.
+	MaxDepth int
+}
+
+// DefaultConfig returns the default Notion connector configuration.
+func DefaultConfig() *Config {
+	return &Config{
+		APIURL:          DefaultAPIURL,
+		PageSize:        100,
+		MaxRetries:      5,
+		RequestInterval: 350 * time.Millisecond,
+		MaxDepth:        10,
+	}
+}
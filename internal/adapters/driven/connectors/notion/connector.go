@@ -0,0 +1,354 @@
+package notion
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-core/internal/adapters/driven/connectors"
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Ensure Connector implements the interface.
+var _ driven.Connector = (*Connector)(nil)
+
+var (
+	// idPattern matches a Notion ID, with or without dashes.
+	idPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{12}$`)
+
+	// nonAlphanumericPattern matches runs of characters not allowed in
+	// metadata keys.
+	nonAlphanumericPattern = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// Connector fetches pages and database rows from Notion.
+type Connector struct {
+	tokenProvider driven.TokenProvider
+	databaseID    string // Empty for the source's configured databases and pages
+	client        *Client
+	config        *Config
+}
+
+// NewConnector creates a Notion connector scoped to a database, or to the
+// source's configured databases and pages if databaseID is empty.
+func NewConnector(tokenProvider driven.TokenProvider, databaseID string, config *Config) *Connector {
+	if config == nil {
+		config = DefaultConfig()
+	}
+	client := NewClient(tokenProvider, config.APIURL)
+	if config.PageSize > 0 && config.PageSize <= 100 {
+		client.pageSize = config.PageSize
+	}
+	if config.MaxRetries > 0 {
+		client.maxRetries = config.MaxRetries
+	}
+	client.limiter = newLimiter(config.RequestInterval)
+	return &Connector{
+		tokenProvider: tokenProvider,
+		databaseID:    databaseID,
+		client:        client,
+		config:        config,
+	}
+}
+
+// Type returns the provider type.
+func (c *Connector) Type() domain.ProviderType {
+	return domain.ProviderTypeNotion
+}
+
+// ValidateConfig validates source configuration.
+func (c *Connector) ValidateConfig(config domain.SourceConfig) error {
+	for _, id := range append(append([]string{}, config.DatabaseIDs...), config.PageIDs...) {
+		if !idPattern.MatchString(strings.TrimSpace(id)) {
+			return fmt.Errorf("%w: invalid Notion ID %q", domain.ErrInvalidInput, id)
+		}
+	}
+	return nil
+}
+
+// changeSet collects the changes of one sync.
+type changeSet struct {
+	since      *time.Time
+	changes    []*domain.Change
+	lastEdited time.Time
+	seen       map[string]bool // Pages and databases visited
+}
+
+// edited reports whether a page was edited since the previous sync.
+// last_edited_time is rounded to the minute, so pages edited in the
+// minute of the cursor are fetched again.
+func (s *changeSet) edited(page *Page) bool {
+	return s.since == nil || !page.LastEditedTime.Before(*s.since)
+}
+
+// add adds the change of a page.
+func (s *changeSet) add(change *domain.Change, page *Page) {
+	if s.since == nil {
+		change.Type = domain.ChangeTypeAdded
+	}
+	s.changes = append(s.changes, change)
+	if page.LastEditedTime.After(s.lastEdited) {
+		s.lastEdited = page.LastEditedTime
+	}
+}
+
+// FetchChanges fetches the pages changed in the connector's scope: its
+// database, else the source's configured databases and page trees, else
+// every page shared with the integration. Each page, including each
+// database row, is one document.
+// For initial sync (empty cursor), it fetches every page in scope.
+// For incremental sync, it fetches the pages edited since the database's
+// position in the cursor. Archived pages of configured page trees are deleted; other
+// deletions are reconciled by full syncs.
+func (c *Connector) FetchChanges(ctx context.Context, source *domain.Source, cursor string) ([]*domain.Change, string, error) {
+	set := &changeSet{
+		since: connectors.ParseTimeCursor(cursor).Since(c.databaseID),
+		seen:  make(map[string]bool),
+	}
+
+	switch {
+	case c.databaseID != "":
+		if err := c.fetchDatabaseChanges(ctx, c.databaseID, set); err != nil {
+			return nil, "", err
+		}
+	case len(source.Config.DatabaseIDs) > 0 || len(source.Config.PageIDs) > 0:
+		for _, id := range source.Config.DatabaseIDs {
+			if err := c.fetchDatabaseChanges(ctx, strings.TrimSpace(id), set); err != nil {
+				return nil, "", err
+			}
+		}
+		for _, id := range source.Config.PageIDs {
+			if err := c.fetchPageTreeChanges(ctx, strings.TrimSpace(id), set); err != nil {
+				return nil, "", err
+			}
+		}
+	default:
+		if err := c.fetchSearchChanges(ctx, set); err != nil {
+			return nil, "", err
+		}
+	}
+
+	// Move the database's position to the latest edit time, keeping it
+	// when nothing changed
+	return set.changes, connectors.ContainerTimeCursor(c.databaseID, set.since, set.lastEdited), nil
+}
+
+// fetchSearchChanges fetches the changes of every page shared with the
+// integration. Pages are searched most recently edited first, so the
+// search stops at the first page edited before the cursor.
+func (c *Connector) fetchSearchChanges(ctx context.Context, set *changeSet) error {
+	cursor := ""
+	for {
+		pages, nextCursor, err := c.client.SearchPages(ctx, cursor)
+		if err != nil {
+			return fmt.Errorf("search pages: %w", err)
+		}
+
+		for _, page := range pages {
+			if !set.edited(page) {
+				return nil
+			}
+			if page.Archived || page.InTrash || set.seen[page.ID] {
+				continue
+			}
+			set.seen[page.ID] = true
+
+			change, _, err := c.pageChange(ctx, page)
+			if err != nil {
+				return err
+			}
+			set.add(change, page)
+		}
+
+		if nextCursor == "" {
+			return nil
+		}
+		cursor = nextCursor
+	}
+}
+
+// fetchDatabaseChanges fetches the changes of the rows of a database.
+func (c *Connector) fetchDatabaseChanges(ctx context.Context, databaseID string, set *changeSet) error {
+	if set.seen[databaseID] {
+		return nil
+	}
+	set.seen[databaseID] = true
+
+	cursor := ""
+	for {
+		rows, nextCursor, err := c.client.QueryDatabase(ctx, databaseID, set.since, cursor)
+		if err != nil {
+			return fmt.Errorf("query database %s: %w", databaseID, err)
+		}
+
+		for _, row := range rows {
+			if !set.edited(row) || row.Archived || row.InTrash {
+				continue
+			}
+			set.seen[row.ID] = true
+
+			change, _, err := c.pageChange(ctx, row)
+			if err != nil {
+				return err
+			}
+			set.add(change, row)
+		}
+
+		if nextCursor == "" {
+			return nil
+		}
+		cursor = nextCursor
+	}
+}
+
+// fetchPageTreeChanges fetches the changes of a page and, recursively, of
+// its child pages and databases. Editing a child page does not change its
+// parent's edit time, so the tree is walked whether or not the page was
+// edited; only edited pages are rendered.
+func (c *Connector) fetchPageTreeChanges(ctx context.Context, pageID string, set *changeSet) error {
+	if set.seen[pageID] {
+		return nil
+	}
+	set.seen[pageID] = true
+
+	page, err := c.client.GetPage(ctx, pageID)
+	if err != nil {
+		return fmt.Errorf("get page %s: %w", pageID, err)
+	}
+	if page.Archived || page.InTrash {
+		if set.since != nil {
+			set.changes = append(set.changes, &domain.Change{
+				Type:       domain.ChangeTypeDeleted,
+				ExternalID: externalID(page.ID),
+			})
+		}
+		return nil
+	}
+
+	// Only an edited page is rendered; the children of others are listed
+	content := &pageContent{}
+	if set.edited(page) {
+		change, rendered, err := c.pageChange(ctx, page)
+		if err != nil {
+			return err
+		}
+		set.add(change, page)
+		content = rendered
+	} else if err := c.findChildren(ctx, page.ID, 0, content); err != nil {
+		return fmt.Errorf("page %s: %w", page.ID, err)
+	}
+
+	for _, id := range content.childPages {
+		if err := c.fetchPageTreeChanges(ctx, id, set); err != nil {
+			return err
+		}
+	}
+	for _, id := range content.childTables {
+		if err := c.fetchDatabaseChanges(ctx, id, set); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FetchDocument fetches a single page by external ID.
+func (c *Connector) FetchDocument(ctx context.Context, source *domain.Source, externalID string) (*domain.Change, error) {
+	id, ok := strings.CutPrefix(externalID, "page-")
+	if !ok || !idPattern.MatchString(id) {
+		return nil, fmt.Errorf("invalid external ID format: %s", externalID)
+	}
+
+	page, err := c.client.GetPage(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get page: %w", err)
+	}
+	if page.Archived || page.InTrash {
+		return nil, fmt.Errorf("%w: page %s is archived", domain.ErrNotFound, id)
+	}
+
+	change, _, err := c.pageChange(ctx, page)
+	if err != nil {
+		return nil, err
+	}
+	change.ExternalID = externalID
+	return change, nil
+}
+
+// TestConnection tests the connection to the database, or to Notion.
+func (c *Connector) TestConnection(ctx context.Context, source *domain.Source) error {
+	if c.databaseID != "" {
+		_, err := c.client.GetDatabase(ctx, c.databaseID)
+		return err
+	}
+	_, err := c.client.GetMe(ctx)
+	return err
+}
+
+// externalID formats the external ID of a page.
+// Format: "page-<page ID>"
+func externalID(pageID string) string {
+	return "page-" + pageID
+}
+
+// pageChange builds the change of a page, rendering its blocks.
+func (c *Connector) pageChange(ctx context.Context, page *Page) (*domain.Change, *pageContent, error) {
+	content, err := c.renderPage(ctx, page.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("page %s: %w", page.ID, err)
+	}
+
+	title := pageTitle(page)
+	if title == "" {
+		title = "Untitled"
+	}
+
+	text := "# " + title
+	if content.text != "" {
+		text += "\n\n" + content.text
+	}
+
+	return &domain.Change{
+		Type:       domain.ChangeTypeModified,
+		ExternalID: externalID(page.ID),
+		Document:   c.pageToDocument(page, title),
+		Content:    text,
+	}, content, nil
+}
+
+// pageToDocument converts a Notion page to a domain document. The
+// properties of database rows are kept as "property_<name>" metadata.
+func (c *Connector) pageToDocument(page *Page, title string) *domain.Document {
+	metadata := map[string]string{
+		"parent_type": strings.TrimSuffix(page.Parent.Type, "_id"),
+	}
+	if id := page.Parent.ID(); id != "" {
+		metadata["parent_id"] = id
+	}
+
+	if page.Parent.Type == "database_id" {
+		metadata["database_id"] = page.Parent.DatabaseID
+		for name, raw := range page.Properties {
+			value := formatPropertyValue(raw)
+			if value == "" {
+				continue
+			}
+			key := strings.Trim(nonAlphanumericPattern.ReplaceAllString(strings.ToLower(name), "_"), "_")
+			if key == "" {
+				continue
+			}
+			metadata["property_"+key] = value
+		}
+	}
+
+	return &domain.Document{
+		Title:     title,
+		Path:      page.URL,
+		MimeType:  "text/markdown",
+		Metadata:  metadata,
+		CreatedAt: page.CreatedTime,
+		UpdatedAt: page.LastEditedTime,
+	}
+}
@@ -0,0 +1,439 @@
+package notion
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+const (
+	handbookID = "11111111-1111-1111-1111-111111111111" // Page shared with the integration
+	onboardID  = "22222222-2222-2222-2222-222222222222" // Child page of the handbook
+	retiredID  = "33333333-3333-3333-3333-333333333333" // Archived child page of the handbook
+	tasksID    = "44444444-4444-4444-4444-444444444444" // Database in the handbook
+	taskID     = "55555555-5555-5555-5555-555555555555" // Row of the tasks database
+	staleID    = "66666666-6666-6666-6666-666666666666" // Page not edited recently
+)
+
+// newTestServer serves a Notion workspace: the handbook page, whose blocks
+// span two pages of results, holds the onboarding page, an archived page
+// and the tasks database. Requests are recorded by method, path and body.
+func newTestServer(t *testing.T) (*httptest.Server, *[]string) {
+	t.Helper()
+	var requests []string
+
+	text := func(s string) []any {
+		return []any{map[string]any{"plain_text": s, "annotations": map[string]any{}}}
+	}
+	block := func(id, blockType string, content map[string]any, hasChildren bool) map[string]any {
+		return map[string]any{"object": "block", "id": id, "type": blockType, blockType: content, "has_children": hasChildren}
+	}
+	page := func(id, title, edited string, parent map[string]any) map[string]any {
+		return map[string]any{
+			"object": "page", "id": id, "url": "https://www.notion.so/" + strings.ReplaceAll(id, "-", ""),
+			"created_time": "2026-01-01T09:00:00.000Z", "last_edited_time": edited, "parent": parent,
+			"properties": map[string]any{"title": map[string]any{"type": "title", "title": text(title)}},
+		}
+	}
+
+	workspace := map[string]any{"type": "workspace", "workspace": true}
+	pages := map[string]map[string]any{
+		handbookID: page(handbookID, "Handbook", "2026-03-01T10:00:00.000Z", workspace),
+		onboardID:  page(onboardID, "Onboarding", "2026-03-02T10:00:00.000Z", map[string]any{"type": "page_id", "page_id": handbookID}),
+		retiredID:  page(retiredID, "Retired", "2026-03-02T11:00:00.000Z", map[string]any{"type": "page_id", "page_id": handbookID}),
+		staleID:    page(staleID, "Stale", "2026-01-01T10:00:00.000Z", workspace),
+	}
+	pages[retiredID]["archived"] = true
+	task := page(taskID, "Write docs", "2026-03-02T12:00:00.000Z", map[string]any{"type": "database_id", "database_id": tasksID})
+	task["properties"] = map[string]any{
+		"Name":     map[string]any{"type": "title", "title": text("Write docs")},
+		"Status":   map[string]any{"type": "status", "status": map[string]any{"name": "In progress"}},
+		"Tags":     map[string]any{"type": "multi_select", "multi_select": []any{map[string]any{"name": "docs"}, map[string]any{"name": "q1"}}},
+		"Due":      map[string]any{"type": "date", "date": map[string]any{"start": "2026-03-10", "end": nil}},
+		"Estimate": map[string]any{"type": "number", "number": 3},
+		"Owner":    map[string]any{"type": "people", "people": []any{map[string]any{"id": "u1", "name": "Ann"}}},
+		"Notes":    map[string]any{"type": "rich_text", "rich_text": []any{}},
+	}
+	pages[taskID] = task
+
+	bold := []any{
+		map[string]any{"plain_text": "Read the ", "annotations": map[string]any{}},
+		map[string]any{"plain_text": "rules ", "annotations": map[string]any{"bold": true}},
+		map[string]any{"plain_text": "here", "href": "https://example.com", "annotations": map[string]any{}},
+	}
+	blocks := map[string][]any{
+		handbookID: {
+			block("b1", "heading_1", map[string]any{"rich_text": text("Welcome")}, false),
+			block("b2", "paragraph", map[string]any{"rich_text": bold}, false),
+			block("b3", "bulleted_list_item", map[string]any{"rich_text": text("Tools")}, true),
+			block("b4", "numbered_list_item", map[string]any{"rich_text": text("First")}, false),
+			block("b5", "numbered_list_item", map[string]any{"rich_text": text("Second")}, false),
+		},
+		"page-2": {
+			block("b6", "to_do", map[string]any{"rich_text": text("Sign up"), "checked": true}, false),
+			block("b7", "code", map[string]any{"rich_text": text("make dev"), "language": "shell"}, false),
+			block("b8", "table", map[string]any{"table_width": 2}, true),
+			block(onboardID, "child_page", map[string]any{"title": "Onboarding"}, false),
+			block(retiredID, "child_page", map[string]any{"title": "Retired"}, false),
+			block(tasksID, "child_database", map[string]any{"title": "Tasks"}, false),
+		},
+		"b3": {block("b31", "bulleted_list_item", map[string]any{"rich_text": text("Editor")}, false)},
+		"b8": {
+			block("r1", "table_row", map[string]any{"cells": []any{text("Tool"), text("Owner")}}, false),
+			block("r2", "table_row", map[string]any{"cells": []any{text("CI"), text("Ann")}}, false),
+		},
+		onboardID: {block("o1", "paragraph", map[string]any{"rich_text": text("Day one")}, false)},
+		taskID:    {block("t1", "paragraph", map[string]any{"rich_text": text("Draft the guide")}, false)},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		data, _ := json.Marshal(body)
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+string(data))
+
+		if r.Header.Get("Authorization") != "Bearer secret_test" || r.Header.Get("Notion-Version") != APIVersion {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		list := func(results []any, nextCursor string) {
+			_ = json.NewEncoder(w).Encode(map[string]any{"results": results, "has_more": nextCursor != "", "next_cursor": nextCursor})
+		}
+
+		path := strings.TrimPrefix(r.URL.Path, "/v1")
+		switch {
+		case path == "/search" && body["filter"].(map[string]any)["value"] == "database":
+			list([]any{map[string]any{
+				"object": "database", "id": tasksID, "title": text("Tasks"), "description": text("Team tasks"),
+				"url": "https://www.notion.so/tasks", "parent": map[string]any{"type": "page_id", "page_id": handbookID},
+			}}, "")
+		case path == "/search":
+			list([]any{pages[taskID], pages[onboardID], pages[handbookID], pages[staleID]}, "")
+		case path == "/databases/"+tasksID+"/query":
+			list([]any{pages[taskID]}, "")
+		case path == "/databases/"+tasksID:
+			_ = json.NewEncoder(w).Encode(map[string]any{"object": "database", "id": tasksID})
+		case strings.HasPrefix(path, "/pages/"):
+			if p, ok := pages[strings.TrimPrefix(path, "/pages/")]; ok {
+				_ = json.NewEncoder(w).Encode(p)
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+		case strings.HasPrefix(path, "/blocks/"):
+			id := strings.TrimSuffix(strings.TrimPrefix(path, "/blocks/"), "/children")
+			switch {
+			case id == handbookID && r.URL.Query().Get("start_cursor") == "":
+				list(blocks[handbookID], "page-2")
+			case id == handbookID:
+				list(blocks["page-2"], "")
+			default:
+				list(blocks[id], "")
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func newTestConnector(apiURL, databaseID string) *Connector {
+	config := DefaultConfig()
+	config.APIURL = apiURL
+	config.RequestInterval = 0
+	tokenProvider := driven.NewStaticTokenProvider(&domain.Credentials{AuthMethod: domain.AuthMethodAPIKey, APIKey: "secret_test"})
+	return NewConnector(tokenProvider, databaseID, config)
+}
+
+func changesByID(changes []*domain.Change) map[string]*domain.Change {
+	byID := make(map[string]*domain.Change)
+	for _, change := range changes {
+		byID[change.ExternalID] = change
+	}
+	return byID
+}
+
+func TestConnector_FetchChanges_PageTree(t *testing.T) {
+	server, _ := newTestServer(t)
+	c := newTestConnector(server.URL+"/v1", "")
+
+	source := &domain.Source{Config: domain.SourceConfig{PageIDs: []string{handbookID}}}
+	changes, cursor, err := c.FetchChanges(context.Background(), source, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	byID := changesByID(changes)
+	if len(changes) != 3 || byID["page-"+onboardID] == nil || byID["page-"+taskID] == nil {
+		t.Fatalf("expected the handbook, its child page and the database row, got %d changes", len(changes))
+	}
+
+	handbook := byID["page-"+handbookID]
+	if handbook == nil || handbook.Type != domain.ChangeTypeAdded || handbook.Document.MimeType != "text/markdown" {
+		t.Fatalf("unexpected handbook change: %+v", handbook)
+	}
+	want := strings.Join([]string{
+		"# Handbook",
+		"",
+		"# Welcome",
+		"",
+		"Read the **rules** [here](https://example.com)",
+		"",
+		"- Tools",
+		"  - Editor",
+		"1. First",
+		"2. Second",
+		"- [x] Sign up",
+		"```shell",
+		"make dev",
+		"```",
+		"",
+		"| Tool | Owner |",
+		"| CI | Ann |",
+	}, "\n")
+	if handbook.Content != want {
+		t.Errorf("unexpected content:\n%s\nwant:\n%s", handbook.Content, want)
+	}
+	if handbook.Document.Path != "https://www.notion.so/11111111111111111111111111111111" || handbook.Document.Metadata["parent_type"] != "workspace" {
+		t.Errorf("unexpected document: %+v", handbook.Document)
+	}
+
+	if cursor != `{"":"2026-03-02T12:00:00Z"}` {
+		t.Errorf("expected the latest edit time as cursor, got %q", cursor)
+	}
+}
+
+func TestConnector_FetchChanges_PageTreeIncremental(t *testing.T) {
+	server, requests := newTestServer(t)
+	c := newTestConnector(server.URL+"/v1", "")
+
+	source := &domain.Source{Config: domain.SourceConfig{PageIDs: []string{handbookID}}}
+	changes, cursor, err := c.FetchChanges(context.Background(), source, "2026-03-02T10:00:00Z")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	byID := changesByID(changes)
+	if len(changes) != 3 || byID["page-"+handbookID] != nil {
+		t.Errorf("expected the unchanged handbook to be skipped, got %d changes", len(changes))
+	}
+	if change := byID["page-"+onboardID]; change == nil || change.Type != domain.ChangeTypeModified {
+		t.Errorf("expected the page edited in the cursor's minute as modified, got %+v", change)
+	}
+	if change := byID["page-"+retiredID]; change == nil || change.Type != domain.ChangeTypeDeleted {
+		t.Errorf("expected the archived page to be deleted, got %+v", change)
+	}
+	if cursor != `{"":"2026-03-02T12:00:00Z"}` {
+		t.Errorf("unexpected cursor %q", cursor)
+	}
+
+	found := false
+	for _, r := range *requests {
+		if strings.Contains(r, "/query") && strings.Contains(r, `"on_or_after":"2026-03-02T10:00:00Z"`) {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected the database to be queried for rows edited since the cursor, got %v", *requests)
+	}
+	for _, r := range *requests {
+		if strings.Contains(r, "/blocks/b3/") || strings.Contains(r, "/blocks/b8/") {
+			t.Errorf("expected the unchanged handbook not to be rendered, got %s", r)
+		}
+	}
+}
+
+func TestConnector_FetchChanges_Database(t *testing.T) {
+	server, _ := newTestServer(t)
+	c := newTestConnector(server.URL+"/v1", tasksID)
+
+	changes, _, err := c.FetchChanges(context.Background(), &domain.Source{}, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(changes) != 1 {
+		t.Fatalf("expected the database row, got %d changes", len(changes))
+	}
+
+	row := changes[0]
+	if row.Document.Title != "Write docs" || row.Content != "# Write docs\n\nDraft the guide" {
+		t.Errorf("unexpected row: %+v", row)
+	}
+	metadata := row.Document.Metadata
+	want := map[string]string{
+		"database_id": tasksID, "property_status": "In progress", "property_tags": "docs,q1",
+		"property_due": "2026-03-10", "property_estimate": "3", "property_owner": "Ann", "property_name": "Write docs",
+	}
+	for key, value := range want {
+		if metadata[key] != value {
+			t.Errorf("expected metadata %s=%q, got %q", key, value, metadata[key])
+		}
+	}
+	if _, ok := metadata["property_notes"]; ok {
+		t.Error("expected empty properties to be skipped")
+	}
+}
+
+func TestConnector_FetchChanges_Search(t *testing.T) {
+	server, _ := newTestServer(t)
+	c := newTestConnector(server.URL+"/v1", "")
+
+	changes, _, err := c.FetchChanges(context.Background(), &domain.Source{}, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(changes) != 4 {
+		t.Errorf("expected every shared page on a full sync, got %d changes", len(changes))
+	}
+
+	changes, _, err = c.FetchChanges(context.Background(), &domain.Source{}, "2026-03-02T10:00:00Z")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	byID := changesByID(changes)
+	if len(changes) != 2 || byID["page-"+taskID] == nil || byID["page-"+onboardID] == nil {
+		t.Errorf("expected the pages edited since the cursor, got %d changes", len(changes))
+	}
+}
+
+func TestConnector_FetchDocument(t *testing.T) {
+	server, _ := newTestServer(t)
+	c := newTestConnector(server.URL+"/v1", "")
+
+	change, err := c.FetchDocument(context.Background(), &domain.Source{}, "page-"+onboardID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if change.Content != "# Onboarding\n\nDay one" || change.Document.Metadata["parent_id"] != handbookID {
+		t.Errorf("unexpected change: %+v", change)
+	}
+
+	if _, err := c.FetchDocument(context.Background(), &domain.Source{}, "page-"+retiredID); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for an archived page, got %v", err)
+	}
+	if _, err := c.FetchDocument(context.Background(), &domain.Source{}, "page-x"); err == nil {
+		t.Error("expected an error for an invalid external ID")
+	}
+}
+
+func TestContainerLister_ListContainers(t *testing.T) {
+	server, _ := newTestServer(t)
+	tokenProvider := driven.NewStaticTokenProvider(&domain.Credentials{AuthMethod: domain.AuthMethodAPIKey, APIKey: "secret_test"})
+	l := NewContainerLister(tokenProvider, server.URL+"/v1")
+
+	containers, next, err := l.ListContainers(context.Background(), "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(containers) != 1 || next != "" {
+		t.Fatalf("expected one database and no next page, got %d (next %q)", len(containers), next)
+	}
+	if database := containers[0]; database.ID != tasksID || database.Name != "Tasks" || database.Description != "Team tasks" {
+		t.Errorf("unexpected container: %+v", database)
+	}
+}
+
+func TestClient_RateLimited(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if r.URL.Path == "/users/me" && attempts == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		if r.URL.Path == "/users/me" {
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "bot", "type": "bot"})
+			return
+		}
+		w.Header().Set("Retry-After", "120")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	c := newTestConnector(server.URL, "")
+	if _, err := c.client.GetMe(context.Background()); err != nil || attempts != 2 {
+		t.Errorf("expected the rate-limited request to be retried, got %v after %d attempts", err, attempts)
+	}
+
+	_, err := c.client.GetPage(context.Background(), handbookID)
+	var rateLimitErr *domain.RateLimitError
+	if !errors.As(err, &rateLimitErr) || rateLimitErr.RetryAfter != 2*time.Minute {
+		t.Errorf("expected a rate limit error retrying after 2m, got %v", err)
+	}
+}
+
+func TestClient_RequestInterval(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": "bot"})
+	}))
+	defer server.Close()
+
+	c := newTestConnector(server.URL, "")
+	c.client.limiter = newLimiter(50 * time.Millisecond)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := c.client.GetMe(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+		t.Errorf("expected requests to be spaced by the interval, took %s", elapsed)
+	}
+}
+
+func TestBuilder_SharesLimiterPerToken(t *testing.T) {
+	b := NewBuilder()
+	build := func(apiKey, databaseID string) *Connector {
+		t.Helper()
+		tokenProvider := driven.NewStaticTokenProvider(&domain.Credentials{AuthMethod: domain.AuthMethodAPIKey, APIKey: apiKey})
+		connector, err := b.Build(context.Background(), tokenProvider, databaseID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return connector.(*Connector)
+	}
+
+	first, second := build("secret_a", "db-1"), build("secret_a", "db-2")
+	if first.client.limiter != second.client.limiter {
+		t.Error("expected the databases of one token to share a limiter")
+	}
+	if other := build("secret_b", "db-1"); other.client.limiter == first.client.limiter {
+		t.Error("expected another token to have its own limiter")
+	}
+}
+
+func TestFormatPropertyValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		property string
+		expected string
+	}{
+		{"checkbox", `{"type":"checkbox","checkbox":true}`, "true"},
+		{"select", `{"type":"select","select":{"name":"High"}}`, "High"},
+		{"empty select", `{"type":"select","select":null}`, ""},
+		{"date range", `{"type":"date","date":{"start":"2026-03-01","end":"2026-03-05"}}`, "2026-03-01 → 2026-03-05"},
+		{"rich text", `{"type":"rich_text","rich_text":[{"plain_text":"a "},{"plain_text":"b"}]}`, "a b"},
+		{"formula", `{"type":"formula","formula":{"type":"number","number":42}}`, "42"},
+		{"rollup", `{"type":"rollup","rollup":{"type":"array","array":[{"type":"number","number":1},{"type":"number","number":2}]}}`, "1,2"},
+		{"relation", `{"type":"relation","relation":[{"id":"abc"}]}`, "abc"},
+		{"unique ID", `{"type":"unique_id","unique_id":{"prefix":"TASK","number":7}}`, "TASK-7"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatPropertyValue(json.RawMessage(tt.property)); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
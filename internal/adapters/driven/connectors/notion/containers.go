@@ -0,0 +1,84 @@
+package notion
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Ensure ContainerLister implements the interface.
+var _ driven.ContainerLister = (*ContainerLister)(nil)
+
+// ContainerLister lists the Notion databases shared with an installation's
+// integration.
+type ContainerLister struct {
+	client *Client
+}
+
+// NewContainerLister creates a ContainerLister with the given token provider.
+func NewContainerLister(tokenProvider driven.TokenProvider, apiURL string) *ContainerLister {
+	return &ContainerLister{
+		client: NewClient(tokenProvider, apiURL),
+	}
+}
+
+// ListContainers lists a page of the shared databases, most recently
+// edited first.
+func (l *ContainerLister) ListContainers(ctx context.Context, cursor string) ([]*driven.Container, string, error) {
+	databases, nextCursor, err := l.client.SearchDatabases(ctx, cursor)
+	if err != nil {
+		return nil, "", fmt.Errorf("search databases: %w", err)
+	}
+
+	containers := make([]*driven.Container, 0, len(databases))
+	for _, database := range databases {
+		if database.Archived || database.InTrash {
+			continue
+		}
+		name := strings.TrimSpace(plainText(database.Title))
+		if name == "" {
+			name = "Untitled"
+		}
+		containers = append(containers, &driven.Container{
+			ID:          database.ID,
+			Name:        name,
+			Description: strings.TrimSpace(plainText(database.Description)),
+			Type:        "database",
+			Metadata: map[string]string{
+				"parent_type": strings.TrimSuffix(database.Parent.Type, "_id"),
+				"web_url":     database.URL,
+			},
+		})
+	}
+
+	return containers, nextCursor, nil
+}
+
+// ContainerListerFactory creates ContainerListers for Notion installations.
+type ContainerListerFactory struct {
+	installationStore driven.InstallationStore
+	tokenFactory      driven.TokenProviderFactory
+}
+
+// NewContainerListerFactory creates a factory for Notion container listers.
+func NewContainerListerFactory(
+	installationStore driven.InstallationStore,
+	tokenFactory driven.TokenProviderFactory,
+) *ContainerListerFactory {
+	return &ContainerListerFactory{
+		installationStore: installationStore,
+		tokenFactory:      tokenFactory,
+	}
+}
+
+// Create creates a ContainerLister for a Notion installation.
+func (f *ContainerListerFactory) Create(ctx context.Context, installationID string) (driven.ContainerLister, error) {
+	tokenProvider, err := f.tokenFactory.Create(ctx, installationID)
+	if err != nil {
+		return nil, fmt.Errorf("create token provider: %w", err)
+	}
+
+	return NewContainerLister(tokenProvider, DefaultAPIURL), nil
+}
@@ -0,0 +1,337 @@
+package notion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// pageContent is the text of a page's blocks, with the child pages and
+// databases found among them.
+type pageContent struct {
+	text        string
+	childPages  []string
+	childTables []string // Child database IDs
+}
+
+// renderPage walks the block tree of a page into Markdown-ish text.
+// Child pages and databases are separate documents: they are collected
+// rather than rendered.
+func (c *Connector) renderPage(ctx context.Context, pageID string) (*pageContent, error) {
+	content := &pageContent{}
+	var sb strings.Builder
+	if err := c.renderBlocks(ctx, pageID, 0, "", &sb, content); err != nil {
+		return nil, err
+	}
+	content.text = strings.TrimSpace(sb.String())
+	return content, nil
+}
+
+// renderBlocks renders the children of a block, each line prefixed with
+// indent, descending into nested blocks up to MaxDepth.
+func (c *Connector) renderBlocks(ctx context.Context, blockID string, depth int, indent string, sb *strings.Builder, content *pageContent) error {
+	cursor := ""
+	number := 0
+	for {
+		blocks, nextCursor, err := c.client.ListBlockChildren(ctx, blockID, cursor)
+		if err != nil {
+			return fmt.Errorf("list blocks: %w", err)
+		}
+
+		for _, block := range blocks {
+			// Consecutive numbered list items are numbered from 1
+			if block.Type == "numbered_list_item" {
+				number++
+			} else {
+				number = 0
+			}
+
+			switch block.Type {
+			case "child_page":
+				content.childPages = append(content.childPages, block.ID)
+				continue
+			case "child_database":
+				content.childTables = append(content.childTables, block.ID)
+				continue
+			}
+
+			if line, ok := formatBlock(block, number); ok {
+				for _, l := range strings.Split(line, "\n") {
+					sb.WriteString(indent)
+					sb.WriteString(l)
+					sb.WriteString("\n")
+				}
+				if isParagraphBlock(block.Type) {
+					sb.WriteString("\n")
+				}
+			}
+
+			if block.HasChildren && depth < c.config.MaxDepth {
+				// List items and toggles nest their children
+				childIndent := indent
+				if isNestingBlock(block.Type) {
+					childIndent += "  "
+				}
+				if err := c.renderBlocks(ctx, block.ID, depth+1, childIndent, sb, content); err != nil {
+					return err
+				}
+			}
+		}
+
+		if nextCursor == "" {
+			return nil
+		}
+		cursor = nextCursor
+	}
+}
+
+// findChildren collects the child pages and databases of a block without
+// rendering its text, so walking an unedited page costs only the listing
+// of its blocks. It descends into layout blocks up to MaxDepth; child pages
+// nested in other blocks, such as list items, are found when their parent
+// is next edited.
+func (c *Connector) findChildren(ctx context.Context, blockID string, depth int, content *pageContent) error {
+	cursor := ""
+	for {
+		blocks, nextCursor, err := c.client.ListBlockChildren(ctx, blockID, cursor)
+		if err != nil {
+			return fmt.Errorf("list blocks: %w", err)
+		}
+
+		for _, block := range blocks {
+			switch {
+			case block.Type == "child_page":
+				content.childPages = append(content.childPages, block.ID)
+			case block.Type == "child_database":
+				content.childTables = append(content.childTables, block.ID)
+			case block.HasChildren && depth < c.config.MaxDepth && isLayoutBlock(block.Type):
+				if err := c.findChildren(ctx, block.ID, depth+1, content); err != nil {
+					return err
+				}
+			}
+		}
+
+		if nextCursor == "" {
+			return nil
+		}
+		cursor = nextCursor
+	}
+}
+
+// formatBlock formats one block as Markdown-ish text, without its
+// children. It reports false for blocks without text.
+func formatBlock(block *Block, number int) (string, bool) {
+	text := formatRichText(block.Content.RichText)
+
+	switch block.Type {
+	case "paragraph":
+		return text, text != ""
+	case "heading_1":
+		return "# " + text, true
+	case "heading_2":
+		return "## " + text, true
+	case "heading_3":
+		return "### " + text, true
+	case "bulleted_list_item", "toggle":
+		return "- " + text, true
+	case "numbered_list_item":
+		return strconv.Itoa(number) + ". " + text, true
+	case "to_do":
+		if block.Content.Checked {
+			return "- [x] " + text, true
+		}
+		return "- [ ] " + text, true
+	case "quote":
+		return "> " + text, true
+	case "callout":
+		if block.Content.Icon != nil && block.Content.Icon.Emoji != "" {
+			return "> " + block.Content.Icon.Emoji + " " + text, true
+		}
+		return "> " + text, true
+	case "code":
+		return "```" + block.Content.Language + "\n" + plainText(block.Content.RichText) + "\n```", true
+	case "equation":
+		return "$$" + block.Content.Expression + "$$", true
+	case "divider":
+		return "---", true
+	case "table_row":
+		cells := make([]string, len(block.Content.Cells))
+		for i, cell := range block.Content.Cells {
+			cells[i] = formatRichText(cell)
+		}
+		return "| " + strings.Join(cells, " | ") + " |", true
+	case "bookmark", "embed", "link_preview":
+		if caption := formatRichText(block.Content.Caption); caption != "" {
+			return "[" + caption + "](" + block.Content.URL + ")", true
+		}
+		return block.Content.URL, block.Content.URL != ""
+	case "image", "video", "file", "pdf", "audio":
+		// Only captions and names of media are text
+		if caption := formatRichText(block.Content.Caption); caption != "" {
+			return caption, true
+		}
+		return block.Content.Name, block.Content.Name != ""
+	}
+
+	// Blocks that only hold children (columns, synced blocks, tables) or
+	// are unsupported
+	return "", false
+}
+
+// isParagraphBlock reports whether a block type is followed by a blank line.
+func isParagraphBlock(blockType string) bool {
+	switch blockType {
+	case "paragraph", "heading_1", "heading_2", "heading_3", "quote", "callout", "code", "equation", "divider":
+		return true
+	}
+	return false
+}
+
+// isNestingBlock reports whether the children of a block type are indented.
+func isNestingBlock(blockType string) bool {
+	switch blockType {
+	case "bulleted_list_item", "numbered_list_item", "to_do", "toggle":
+		return true
+	}
+	return false
+}
+
+// isLayoutBlock reports whether a block type lays out other blocks, and so
+// may hold child pages and databases.
+func isLayoutBlock(blockType string) bool {
+	switch blockType {
+	case "column_list", "column", "toggle", "synced_block", "callout", "heading_1", "heading_2", "heading_3":
+		return true
+	}
+	return false
+}
+
+// plainText concatenates rich text without formatting.
+func plainText(spans []RichText) string {
+	var sb strings.Builder
+	for _, span := range spans {
+		sb.WriteString(span.PlainText)
+	}
+	return sb.String()
+}
+
+// formatRichText formats rich text as Markdown: bold, italic,
+// strikethrough and code annotations, and links.
+func formatRichText(spans []RichText) string {
+	var sb strings.Builder
+	for _, span := range spans {
+		text := span.PlainText
+		if strings.TrimSpace(text) == "" {
+			sb.WriteString(text)
+			continue
+		}
+
+		// Markers wrap the text inside its surrounding whitespace
+		trimmed := strings.TrimSpace(text)
+		lead := text[:strings.Index(text, trimmed)]
+		trail := text[len(lead)+len(trimmed):]
+
+		if span.Annotations.Code {
+			trimmed = "`" + trimmed + "`"
+		}
+		if span.Annotations.Bold {
+			trimmed = "**" + trimmed + "**"
+		}
+		if span.Annotations.Italic {
+			trimmed = "*" + trimmed + "*"
+		}
+		if span.Annotations.Strikethrough {
+			trimmed = "~~" + trimmed + "~~"
+		}
+		if span.Href != "" {
+			trimmed = "[" + trimmed + "](" + span.Href + ")"
+		}
+
+		sb.WriteString(lead)
+		sb.WriteString(trimmed)
+		sb.WriteString(trail)
+	}
+	return sb.String()
+}
+
+// pageTitle returns the plain text of a page's title property.
+func pageTitle(page *Page) string {
+	for _, raw := range page.Properties {
+		var property struct {
+			Type  string     `json:"type"`
+			Title []RichText `json:"title"`
+		}
+		if json.Unmarshal(raw, &property) == nil && property.Type == "title" {
+			return strings.TrimSpace(plainText(property.Title))
+		}
+	}
+	return ""
+}
+
+// formatPropertyValue formats the value of a database property as text:
+// selects and people by name, dates as "start → end", relations by page ID
+// and lists as comma-separated values. It returns "" for empty values.
+func formatPropertyValue(raw json.RawMessage) string {
+	var property map[string]any
+	if err := json.Unmarshal(raw, &property); err != nil {
+		return ""
+	}
+	propertyType, _ := property["type"].(string)
+	return formatValue(propertyType, property[propertyType])
+}
+
+// formatValue formats a property value of the given type.
+func formatValue(valueType string, value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return strings.TrimSpace(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case []any:
+		// Rich text spans concatenate; other lists are comma-separated
+		separator := ","
+		if valueType == "title" || valueType == "rich_text" {
+			separator = ""
+		}
+		var values []string
+		for _, item := range v {
+			if s := formatValue("", item); s != "" {
+				values = append(values, s)
+			}
+		}
+		return strings.TrimSpace(strings.Join(values, separator))
+	case map[string]any:
+		switch {
+		case valueType == "date":
+			start, _ := v["start"].(string)
+			if end, _ := v["end"].(string); end != "" {
+				return start + " → " + end
+			}
+			return start
+		case valueType == "unique_id":
+			number := formatValue("", v["number"])
+			if prefix, _ := v["prefix"].(string); prefix != "" {
+				return prefix + "-" + number
+			}
+			return number
+		}
+		// Formulas, rollups and the items of rollup arrays are typed values
+		if itemType, ok := v["type"].(string); ok {
+			if item, ok := v[itemType]; ok {
+				return formatValue(itemType, item)
+			}
+		}
+		for _, key := range []string{"plain_text", "name", "id"} {
+			if s, ok := v[key].(string); ok && s != "" {
+				return s
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,179 @@
+package notion
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/custodia-labs/sercha-core/internal/adapters/driven/connectors"
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Ensure OAuthHandler implements the interface.
+var _ connectors.OAuthHandler = (*OAuthHandler)(nil)
+
+// Notion OAuth endpoints.
+const (
+	authURL  = DefaultAPIURL + "/oauth/authorize"
+	tokenURL = DefaultAPIURL + "/oauth/token"
+)
+
+// OAuthHandler handles OAuth operations for Notion.
+type OAuthHandler struct {
+	httpClient *http.Client
+	apiURL     string
+}
+
+// NewOAuthHandler creates a new Notion OAuth handler.
+func NewOAuthHandler() *OAuthHandler {
+	return &OAuthHandler{
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		apiURL:     DefaultAPIURL,
+	}
+}
+
+// BuildAuthURL constructs the Notion OAuth authorization URL.
+// Notion has no scopes - the integration's capabilities apply, to the
+// pages the user shares with it - and does not support PKCE, so scopes and
+// codeChallenge are ignored.
+func (h *OAuthHandler) BuildAuthURL(clientID, redirectURI, state, codeChallenge string, scopes []string) string {
+	params := url.Values{
+		"client_id":     {clientID},
+		"redirect_uri":  {redirectURI},
+		"state":         {state},
+		"response_type": {"code"},
+		"owner":         {"user"},
+	}
+	return h.apiURL + "/oauth/authorize?" + params.Encode()
+}
+
+// ExchangeCode exchanges an authorization code for tokens.
+func (h *OAuthHandler) ExchangeCode(ctx context.Context, clientID, clientSecret, code, redirectURI, codeVerifier string) (*driven.OAuthToken, error) {
+	token, err := h.requestToken(ctx, clientID, clientSecret, map[string]string{
+		"grant_type":   "authorization_code",
+		"code":         code,
+		"redirect_uri": redirectURI,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("token exchange failed: %w", err)
+	}
+	return token, nil
+}
+
+// RefreshToken refreshes an access token, for integrations issued
+// expiring tokens.
+func (h *OAuthHandler) RefreshToken(ctx context.Context, clientID, clientSecret, refreshToken string) (*driven.OAuthToken, error) {
+	token, err := h.requestToken(ctx, clientID, clientSecret, map[string]string{
+		"grant_type":    "refresh_token",
+		"refresh_token": refreshToken,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("token refresh failed: %w", err)
+	}
+	return token, nil
+}
+
+// requestToken posts params to the token endpoint as JSON, authenticating
+// the client with basic authentication.
+func (h *OAuthHandler) requestToken(ctx context.Context, clientID, clientSecret string, params map[string]string) (*driven.OAuthToken, error) {
+	payload, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("encode request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", h.apiURL+"/oauth/token", bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	req.SetBasicAuth(clientID, clientSecret)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Notion-Version", APIVersion)
+
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("do request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+
+	var tokenResp struct {
+		AccessToken  string `json:"access_token"`
+		TokenType    string `json:"token_type"`
+		RefreshToken string `json:"refresh_token"`
+		ExpiresIn    int    `json:"expires_in"`
+		Error        string `json:"error"`
+		ErrorDesc    string `json:"error_description"`
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		if json.Unmarshal(body, &tokenResp) == nil && tokenResp.Error != "" {
+			return nil, fmt.Errorf("oauth error: %s - %s", tokenResp.Error, tokenResp.ErrorDesc)
+		}
+		return nil, fmt.Errorf("status %d: %s", resp.StatusCode, string(body))
+	}
+
+	if err := json.Unmarshal(body, &tokenResp); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+
+	return &driven.OAuthToken{
+		AccessToken:  tokenResp.AccessToken,
+		RefreshToken: tokenResp.RefreshToken,
+		TokenType:    tokenResp.TokenType,
+		ExpiresIn:    tokenResp.ExpiresIn,
+	}, nil
+}
+
+// GetUserInfo fetches the user who installed the integration, through the
+// integration's bot user. Integrations installed by a workspace are
+// identified by the bot.
+func (h *OAuthHandler) GetUserInfo(ctx context.Context, accessToken string) (*driven.OAuthUserInfo, error) {
+	// Access tokens are sent like API keys, as bearer tokens
+	tokenProvider := driven.NewStaticTokenProvider(&domain.Credentials{
+		AuthMethod: domain.AuthMethodAPIKey,
+		APIKey:     accessToken,
+	})
+	client := NewClient(tokenProvider, h.apiURL)
+	client.limiter = newLimiter(0)
+
+	bot, err := client.GetMe(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get user info failed: %w", err)
+	}
+
+	info := &driven.OAuthUserInfo{ID: bot.ID, Name: bot.Name}
+	if bot.Bot != nil {
+		if owner := bot.Bot.Owner.User; owner != nil {
+			info.ID, info.Name = owner.ID, owner.Name
+			if owner.Person != nil {
+				info.Email = owner.Person.Email
+			}
+		} else if bot.Bot.WorkspaceName != "" {
+			info.Name = bot.Bot.WorkspaceName
+		}
+	}
+	return info, nil
+}
+
+// DefaultConfig returns Notion's default OAuth configuration.
+func (h *OAuthHandler) DefaultConfig() connectors.OAuthDefaults {
+	return connectors.OAuthDefaults{
+		AuthURL:      authURL,
+		TokenURL:     tokenURL,
+		Scopes:       []string{},
+		UserInfoURL:  DefaultAPIURL + "/users/me",
+		SupportsPKCE: false,
+	}
+}
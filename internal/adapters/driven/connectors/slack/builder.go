@@ -0,0 +1,73 @@
+package slack
+
+import (
+	"context"
+
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Ensure Builder implements the interfaces.
+var (
+	_ driven.ConnectorBuilder = (*Builder)(nil)
+	_ driven.CursorMerger     = (*Builder)(nil)
+)
+
+// Builder creates Slack connectors.
+type Builder struct {
+	config *Config
+}
+
+// NewBuilder creates a new Slack connector builder.
+func NewBuilder() *Builder {
+	return &Builder{
+		config: DefaultConfig(),
+	}
+}
+
+// NewBuilderWithConfig creates a builder with custom configuration.
+func NewBuilderWithConfig(config *Config) *Builder {
+	return &Builder{
+		config: config,
+	}
+}
+
+// Type returns the provider type.
+func (b *Builder) Type() domain.ProviderType {
+	return domain.ProviderTypeSlack
+}
+
+// Build creates a Slack connector scoped to a channel.
+// containerID is a channel ID, e.g. "C0123456789". Without one, the
+// connector syncs the source's configured channels, or else every channel
+// the app is a member of.
+func (b *Builder) Build(ctx context.Context, tokenProvider driven.TokenProvider, containerID string) (driven.Connector, error) {
+	return NewConnector(tokenProvider, containerID, b.config), nil
+}
+
+// SupportsOAuth returns true - Slack supports OAuth2.
+func (b *Builder) SupportsOAuth() bool {
+	return true
+}
+
+// OAuthConfig returns Slack OAuth configuration.
+func (b *Builder) OAuthConfig() *driven.OAuthConfig {
+	return &driven.OAuthConfig{
+		AuthURL:     authURL,
+		TokenURL:    tokenURL,
+		Scopes:      defaultScopes,
+		UserInfoURL: DefaultAPIURL + "/auth.test",
+	}
+}
+
+// SupportsContainerSelection returns true - Slack supports channel selection.
+func (b *Builder) SupportsContainerSelection() bool {
+	return true
+}
+
+// MergeCursors combines the cursors of two channels of one sync. Slack
+// cursors hold a position per channel; the most recent position of each
+// channel is kept.
+func (b *Builder) MergeCursors(cursor, other string) string {
+	return mergeCursors(cursor, other)
+}
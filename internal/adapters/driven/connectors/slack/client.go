@@ -0,0 +1,353 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// notFoundErrors are the Slack API errors reporting a missing object.
+var notFoundErrors = map[string]bool{
+	"channel_not_found": true,
+	"thread_not_found":  true,
+	"message_not_found": true,
+	"file_not_found":    true,
+	"file_deleted":      true,
+	"user_not_found":    true,
+}
+
+// Client provides Slack Web API operations.
+type Client struct {
+	tokenProvider driven.TokenProvider
+	httpClient    *http.Client
+	apiURL        string
+	pageSize      int
+	maxRetries    int
+}
+
+// NewClient creates a new Slack Web API client for the API at apiURL.
+func NewClient(tokenProvider driven.TokenProvider, apiURL string) *Client {
+	if apiURL == "" {
+		apiURL = DefaultAPIURL
+	}
+	return &Client{
+		tokenProvider: tokenProvider,
+		httpClient:    &http.Client{Timeout: 30 * time.Second},
+		apiURL:        strings.TrimSuffix(apiURL, "/"),
+		pageSize:      200,
+		maxRetries:    3,
+	}
+}
+
+// response is the envelope of every Slack Web API response.
+type response struct {
+	OK               bool   `json:"ok"`
+	Error            string `json:"error"`
+	ResponseMetadata struct {
+		NextCursor string `json:"next_cursor"`
+	} `json:"response_metadata"`
+}
+
+// AuthInfo describes the workspace and user of a token.
+type AuthInfo struct {
+	URL    string `json:"url"` // Workspace URL, e.g. https://acme.slack.com/
+	Team   string `json:"team"`
+	TeamID string `json:"team_id"`
+	User   string `json:"user"`
+	UserID string `json:"user_id"`
+}
+
+// Channel represents a Slack conversation.
+type Channel struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	IsPrivate  bool   `json:"is_private"`
+	IsArchived bool   `json:"is_archived"`
+	IsMember   bool   `json:"is_member"`
+	NumMembers int    `json:"num_members"`
+	Topic      struct {
+		Value string `json:"value"`
+	} `json:"topic"`
+	Purpose struct {
+		Value string `json:"value"`
+	} `json:"purpose"`
+}
+
+// Message represents a Slack message.
+type Message struct {
+	Type        string `json:"type"`
+	Subtype     string `json:"subtype"` // e.g. "tombstone" for a deleted thread parent
+	TS          string `json:"ts"`
+	ThreadTS    string `json:"thread_ts"`
+	User        string `json:"user"`
+	BotID       string `json:"bot_id"`
+	Username    string `json:"username"` // Set for bot messages
+	Text        string `json:"text"`
+	ReplyCount  int    `json:"reply_count"`
+	LatestReply string `json:"latest_reply"`
+	Edited      *struct {
+		User string `json:"user"`
+		TS   string `json:"ts"`
+	} `json:"edited"`
+	Files []*File `json:"files"`
+}
+
+// File represents a file shared in Slack.
+type File struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	Title              string `json:"title"`
+	Mimetype           string `json:"mimetype"`
+	Filetype           string `json:"filetype"`
+	Size               int64  `json:"size"`
+	Mode               string `json:"mode"` // "tombstone" for a deleted file
+	User               string `json:"user"`
+	Created            int64  `json:"created"`
+	Timestamp          int64  `json:"timestamp"`
+	Permalink          string `json:"permalink"`
+	URLPrivateDownload string `json:"url_private_download"`
+}
+
+// User represents a Slack user.
+type User struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	RealName string `json:"real_name"`
+	Deleted  bool   `json:"deleted"`
+	Profile  struct {
+		DisplayName string `json:"display_name"`
+		RealName    string `json:"real_name"`
+		Email       string `json:"email"`
+		Image192    string `json:"image_192"`
+	} `json:"profile"`
+}
+
+// DisplayName returns the name Slack shows for the user.
+func (u *User) DisplayName() string {
+	for _, name := range []string{u.Profile.DisplayName, u.Profile.RealName, u.RealName, u.Name} {
+		if name != "" {
+			return name
+		}
+	}
+	return u.ID
+}
+
+// AuthTest returns the workspace and user of the token.
+func (c *Client) AuthTest(ctx context.Context) (*AuthInfo, error) {
+	var info AuthInfo
+	if _, err := c.call(ctx, "auth.test", nil, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// ListChannels lists a page of the public and private channels visible to
+// the token. Archived channels are skipped.
+func (c *Client) ListChannels(ctx context.Context, cursor string) ([]*Channel, string, error) {
+	params := url.Values{
+		"types":            {"public_channel,private_channel"},
+		"exclude_archived": {"true"},
+	}
+	var resp struct {
+		Channels []*Channel `json:"channels"`
+	}
+	next, err := c.callPage(ctx, "conversations.list", params, cursor, &resp)
+	return resp.Channels, next, err
+}
+
+// GetChannel gets a channel by ID.
+func (c *Client) GetChannel(ctx context.Context, channelID string) (*Channel, error) {
+	var resp struct {
+		Channel *Channel `json:"channel"`
+	}
+	if _, err := c.call(ctx, "conversations.info", url.Values{"channel": {channelID}}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Channel, nil
+}
+
+// History lists a page of the top-level messages of a channel, newest
+// first, optionally only those after oldest.
+func (c *Client) History(ctx context.Context, channelID, oldest, cursor string) ([]*Message, string, error) {
+	params := url.Values{"channel": {channelID}}
+	if oldest != "" {
+		params.Set("oldest", oldest)
+	}
+	var resp struct {
+		Messages []*Message `json:"messages"`
+	}
+	next, err := c.callPage(ctx, "conversations.history", params, cursor, &resp)
+	return resp.Messages, next, err
+}
+
+// Replies lists a page of the messages of a thread, the parent first.
+func (c *Client) Replies(ctx context.Context, channelID, threadTS, cursor string) ([]*Message, string, error) {
+	params := url.Values{"channel": {channelID}, "ts": {threadTS}}
+	var resp struct {
+		Messages []*Message `json:"messages"`
+	}
+	next, err := c.callPage(ctx, "conversations.replies", params, cursor, &resp)
+	return resp.Messages, next, err
+}
+
+// GetUser gets a user by ID.
+func (c *Client) GetUser(ctx context.Context, userID string) (*User, error) {
+	var resp struct {
+		User *User `json:"user"`
+	}
+	if _, err := c.call(ctx, "users.info", url.Values{"user": {userID}}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.User, nil
+}
+
+// GetFile gets a file by ID.
+func (c *Client) GetFile(ctx context.Context, fileID string) (*File, error) {
+	var resp struct {
+		File *File `json:"file"`
+	}
+	if _, err := c.call(ctx, "files.info", url.Values{"file": {fileID}}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.File, nil
+}
+
+// DownloadFile downloads the content of a file from its private URL.
+func (c *Client) DownloadFile(ctx context.Context, downloadURL string, maxSize int64) ([]byte, error) {
+	resp, err := c.doRequest(ctx, downloadURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("read file: %w", err)
+	}
+	if int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("file exceeds %d bytes", maxSize)
+	}
+	return data, nil
+}
+
+// callPage calls a paginated API method with the given cursor (empty for
+// the first page) and returns the next cursor, empty on the last page.
+func (c *Client) callPage(ctx context.Context, method string, params url.Values, cursor string, out any) (string, error) {
+	params.Set("limit", strconv.Itoa(c.pageSize))
+	if cursor != "" {
+		params.Set("cursor", cursor)
+	}
+	return c.call(ctx, method, params, out)
+}
+
+// call calls an API method and decodes the response into out. It returns
+// the response's next cursor. Slack reports most errors with status 200
+// and "ok": false.
+func (c *Client) call(ctx context.Context, method string, params url.Values, out any) (string, error) {
+	endpoint := c.apiURL + "/" + method
+	if len(params) > 0 {
+		endpoint += "?" + params.Encode()
+	}
+
+	resp, err := c.doRequest(ctx, endpoint)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("read response: %w", err)
+	}
+
+	var envelope response
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return "", fmt.Errorf("decode response: %w", err)
+	}
+	if !envelope.OK {
+		apiErr := fmt.Errorf("slack API error: %s: %s", method, envelope.Error)
+		switch {
+		case notFoundErrors[envelope.Error]:
+			return "", fmt.Errorf("%w: %v", domain.ErrNotFound, apiErr)
+		case envelope.Error == "ratelimited":
+			return "", &domain.RateLimitError{RetryAfter: retryAfter(resp), Err: apiErr}
+		}
+		return "", apiErr
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return "", fmt.Errorf("decode response: %w", err)
+	}
+	return envelope.ResponseMetadata.NextCursor, nil
+}
+
+// doRequest performs an authenticated GET request with retry logic.
+func (c *Client) doRequest(ctx context.Context, endpoint string) (*http.Response, error) {
+	token, err := c.tokenProvider.GetAccessToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get access token: %w", err)
+	}
+
+	var resp *http.Response
+	for attempt := 0; attempt <= c.maxRetries; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+		if err != nil {
+			return nil, fmt.Errorf("create request: %w", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err = c.httpClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("do request: %w", err)
+		}
+
+		// Success or non-retryable error
+		if resp.StatusCode < 500 {
+			break
+		}
+
+		// Server error - retry with exponential backoff
+		if attempt == c.maxRetries {
+			break
+		}
+		resp.Body.Close()
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(time.Duration(attempt+1) * time.Second):
+		}
+	}
+
+	if resp.StatusCode >= 400 {
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		apiErr := fmt.Errorf("slack API error %d: %s", resp.StatusCode, string(body))
+		switch resp.StatusCode {
+		case http.StatusNotFound:
+			return nil, fmt.Errorf("%w: %v", domain.ErrNotFound, apiErr)
+		case http.StatusTooManyRequests:
+			return nil, &domain.RateLimitError{RetryAfter: retryAfter(resp), Err: apiErr}
+		}
+		return nil, apiErr
+	}
+
+	return resp, nil
+}
+
+// retryAfter returns how long Slack asked a rate-limited client to wait
+// (zero if unknown).
+func retryAfter(resp *http.Response) time.Duration {
+	if secs, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second
+	}
+	return 0
+}
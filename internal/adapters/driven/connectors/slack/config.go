@@ -0,0 +1,44 @@
+package slack
+
+import "time"
+
+// DefaultAPIURL is the URL of the Slack Web API.
+const DefaultAPIURL = "https://slack.com/api"
+
+// Config contains configuration for the Slack connector.
+type Config struct {
+	// APIURL is the URL of the Slack Web API.
+	// Defaults to https://slack.com/api.
+	APIURL string
+
+	// PageSize is the number of items to fetch per page.
+	// Slack recommends no more than 200.
+	PageSize int
+
+	// MaxRetries is the maximum number of retry attempts for failed requests.
+	MaxRetries int
+
+	// EditWindow is how far before the cursor incremental syncs look for
+	// edited messages, new replies and deleted threads. Changes to older
+	// threads are picked up by the next full sync.
+	EditWindow time.Duration
+
+	// IncludeFiles enables indexing of files shared in messages.
+	IncludeFiles bool
+
+	// MaxFileSize is the maximum file size in bytes to index.
+	// Default is 10MB.
+	MaxFileSize int64
+}
+
+// DefaultConfig returns the default Slack connector configuration.
+func DefaultConfig() *Config {
+	return &Config{
+		APIURL:       DefaultAPIURL,
+		PageSize:     200,
+		MaxRetries:   3,
+		EditWindow:   7 * 24 * time.Hour,
+		IncludeFiles: true,
+		MaxFileSize:  10 << 20, // 10MB
+	}
+}
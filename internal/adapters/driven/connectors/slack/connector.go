@@ -0,0 +1,638 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Ensure Connector implements the interface.
+var _ driven.Connector = (*Connector)(nil)
+
+// MimeTypeMessage is the MIME type of a thread of Slack messages, whose
+// content keeps Slack's message markup.
+const MimeTypeMessage = "application/x-slack-message"
+
+// maxTitleLength is the length, in characters, a thread title is cut to.
+const maxTitleLength = 80
+
+var (
+	// userMentionPattern matches a user mention without a label, e.g. "<@U123>".
+	userMentionPattern = regexp.MustCompile(`<@([UW][A-Z0-9]+)>`)
+
+	// labelledMentionPattern matches a user mention with a label, e.g. "<@U123|ann>".
+	labelledMentionPattern = regexp.MustCompile(`<@[^<>|]*\|([^<>]*)>`)
+
+	// labelledLinkPattern matches other markup with a label, e.g.
+	// "<https://example.com|example>" or "<#C123|general>".
+	labelledLinkPattern = regexp.MustCompile(`<[^<>|]*\|([^<>]*)>`)
+
+	// linkPattern matches markup without a label, e.g. "<https://example.com>".
+	linkPattern = regexp.MustCompile(`<([^<>|]*)>`)
+)
+
+// Connector fetches threads of messages, and the files shared in them,
+// from Slack channels.
+type Connector struct {
+	tokenProvider driven.TokenProvider
+	channelID     string // Empty for the source's configured channels
+	client        *Client
+	config        *Config
+
+	mu           sync.Mutex
+	workspaceURL string            // e.g. https://acme.slack.com/
+	users        map[string]string // Display names by user ID
+}
+
+// NewConnector creates a Slack connector scoped to a channel, or to the
+// source's configured channels if channelID is empty.
+func NewConnector(tokenProvider driven.TokenProvider, channelID string, config *Config) *Connector {
+	if config == nil {
+		config = DefaultConfig()
+	}
+	client := NewClient(tokenProvider, config.APIURL)
+	if config.PageSize > 0 && config.PageSize <= 1000 {
+		client.pageSize = config.PageSize
+	}
+	if config.MaxRetries > 0 {
+		client.maxRetries = config.MaxRetries
+	}
+	return &Connector{
+		tokenProvider: tokenProvider,
+		channelID:     channelID,
+		client:        client,
+		config:        config,
+		users:         make(map[string]string),
+	}
+}
+
+// Type returns the provider type.
+func (c *Connector) Type() domain.ProviderType {
+	return domain.ProviderTypeSlack
+}
+
+// ValidateConfig validates source configuration.
+func (c *Connector) ValidateConfig(config domain.SourceConfig) error {
+	for _, channel := range config.Channels {
+		if strings.TrimSpace(strings.TrimPrefix(channel, "#")) == "" {
+			return fmt.Errorf("%w: empty channel name", domain.ErrInvalidInput)
+		}
+	}
+	return nil
+}
+
+// FetchChanges fetches the threads changed in the connector's channels.
+// Each thread, a top-level message with its replies, is one document.
+// For initial sync (empty cursor), it fetches every thread. The cursor
+// holds the newest timestamp seen per channel; incremental syncs fetch
+// the history from the edit window before it, re-indexing threads that
+// were posted, edited or replied to since, and deleting the threads of the
+// previous window that are gone.
+func (c *Connector) FetchChanges(ctx context.Context, source *domain.Source, cursor string) ([]*domain.Change, string, error) {
+	channels, err := c.channels(ctx, source)
+	if err != nil {
+		return nil, "", err
+	}
+
+	previous := parseCursor(cursor)
+	next := cursorState{}
+	var changes []*domain.Change
+	for _, channel := range channels {
+		channelChanges, position, err := c.fetchChannelChanges(ctx, channel, previous[channel.ID])
+		if err != nil {
+			return nil, "", fmt.Errorf("channel %s: %w", channel.Name, err)
+		}
+		changes = append(changes, channelChanges...)
+		next[channel.ID] = position
+	}
+
+	return changes, next.String(), nil
+}
+
+// channels returns the channels the connector is scoped to: its channel,
+// else the source's configured channels (by name or ID), else every
+// channel the app is a member of.
+func (c *Connector) channels(ctx context.Context, source *domain.Source) ([]*Channel, error) {
+	if c.channelID != "" {
+		channel, err := c.client.GetChannel(ctx, c.channelID)
+		if err != nil {
+			return nil, fmt.Errorf("get channel: %w", err)
+		}
+		return []*Channel{channel}, nil
+	}
+
+	var all []*Channel
+	cursor := ""
+	for {
+		page, nextCursor, err := c.client.ListChannels(ctx, cursor)
+		if err != nil {
+			return nil, fmt.Errorf("list channels: %w", err)
+		}
+		all = append(all, page...)
+		if nextCursor == "" {
+			break
+		}
+		cursor = nextCursor
+	}
+
+	var configured []string
+	if source != nil {
+		configured = source.Config.Channels
+	}
+	if len(configured) == 0 {
+		var channels []*Channel
+		for _, channel := range all {
+			if channel.IsMember {
+				channels = append(channels, channel)
+			}
+		}
+		return channels, nil
+	}
+
+	channels := make([]*Channel, 0, len(configured))
+	for _, name := range configured {
+		name = strings.TrimPrefix(strings.TrimSpace(name), "#")
+		var match *Channel
+		for _, channel := range all {
+			if channel.ID == name || channel.Name == name {
+				match = channel
+				break
+			}
+		}
+		if match == nil {
+			return nil, fmt.Errorf("%w: channel %q", domain.ErrNotFound, name)
+		}
+		channels = append(channels, match)
+	}
+	return channels, nil
+}
+
+// fetchChannelChanges fetches the changes of one channel since its
+// position (nil for a full sync) and returns its new position.
+func (c *Connector) fetchChannelChanges(ctx context.Context, channel *Channel, since *channelCursor) ([]*domain.Change, *channelCursor, error) {
+	position := &channelCursor{}
+	oldest := ""
+	if since != nil {
+		position.Oldest = since.Oldest
+		oldest = tsBefore(since.Oldest, c.config.EditWindow)
+	}
+
+	var changes []*domain.Change
+	seen := make(map[string]int) // Reply counts by thread timestamp
+	cursor := ""
+	for {
+		messages, nextCursor, err := c.client.History(ctx, channel.ID, oldest, cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("get history: %w", err)
+		}
+
+		for _, msg := range messages {
+			// Replies also sent to the channel are indexed with their thread
+			if msg.ThreadTS != "" && msg.ThreadTS != msg.TS {
+				continue
+			}
+			seen[msg.TS] = msg.ReplyCount
+			position.Oldest = latestTS(position.Oldest, msg.TS, msg.LatestReply, editedTS(msg))
+
+			var thread []*Message
+			if since != nil && !changedSince(msg, since.Oldest) {
+				var changed bool
+				thread, changed, err = c.repliesChanged(ctx, channel, msg, since)
+				if errors.Is(err, domain.ErrNotFound) {
+					// Deleted since the history was read; the thread is
+					// fetched again below and reported deleted
+					thread, changed, err = nil, true, nil
+				}
+				if err != nil {
+					return nil, nil, err
+				}
+				if !changed {
+					continue
+				}
+				for _, reply := range thread {
+					position.Oldest = latestTS(position.Oldest, editedTS(reply))
+				}
+			}
+			threadChanges, err := c.threadChanges(ctx, channel, msg, thread, since == nil)
+			if err != nil {
+				return nil, nil, err
+			}
+			changes = append(changes, threadChanges...)
+		}
+
+		if nextCursor == "" {
+			break
+		}
+		cursor = nextCursor
+	}
+
+	// Threads of the previous window missing from the history were deleted
+	if since != nil {
+		for _, ts := range since.Threads {
+			if _, ok := seen[ts]; compareTS(ts, oldest) > 0 && !ok {
+				changes = append(changes, &domain.Change{
+					Type:       domain.ChangeTypeDeleted,
+					ExternalID: threadExternalID(channel.ID, ts),
+				})
+			}
+		}
+	}
+
+	// Remember the threads within the next sync's window
+	windowStart := tsBefore(position.Oldest, c.config.EditWindow)
+	for ts, replies := range seen {
+		if compareTS(ts, windowStart) > 0 {
+			position.Threads = append(position.Threads, ts)
+			if replies > 0 {
+				if position.Replies == nil {
+					position.Replies = make(map[string]int)
+				}
+				position.Replies[ts] = replies
+			}
+		}
+	}
+	sortTS(position.Threads)
+
+	return changes, position, nil
+}
+
+// changedSince reports whether a top-level message was posted, edited or
+// replied to after ts.
+func changedSince(msg *Message, ts string) bool {
+	return compareTS(latestTS(msg.TS, msg.LatestReply, editedTS(msg)), ts) > 0
+}
+
+// repliesChanged reports whether the replies of a thread were edited or
+// deleted since a channel's position. Neither changes the parent message's
+// timestamps: deletions show in its reply count, compared with the count
+// the position recorded, and edits only in the replies themselves, which
+// are fetched and returned for threads with replies.
+func (c *Connector) repliesChanged(ctx context.Context, channel *Channel, parent *Message, since *channelCursor) ([]*Message, bool, error) {
+	count, known := since.Replies[parent.TS]
+	if known && count != parent.ReplyCount {
+		return nil, true, nil
+	}
+	if parent.ReplyCount == 0 {
+		return nil, false, nil
+	}
+
+	thread, err := c.thread(ctx, channel.ID, parent.TS)
+	if err != nil {
+		return nil, false, fmt.Errorf("get thread %s: %w", parent.TS, err)
+	}
+	for _, reply := range thread {
+		if compareTS(editedTS(reply), since.Oldest) > 0 {
+			return thread, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+// editedTS returns when a message was last edited (empty if never).
+func editedTS(msg *Message) string {
+	if msg.Edited == nil {
+		return ""
+	}
+	return msg.Edited.TS
+}
+
+// threadChanges fetches the thread of a top-level message, unless already
+// fetched, and returns it as a change, followed by the files shared in it.
+func (c *Connector) threadChanges(ctx context.Context, channel *Channel, parent *Message, thread []*Message, initial bool) ([]*domain.Change, error) {
+	changeType := domain.ChangeTypeModified
+	if initial {
+		changeType = domain.ChangeTypeAdded
+	}
+
+	if thread == nil {
+		thread = []*Message{parent}
+		if parent.ReplyCount > 0 {
+			var err error
+			thread, err = c.thread(ctx, channel.ID, parent.TS)
+			if errors.Is(err, domain.ErrNotFound) {
+				// Deleted since the history was read
+				return []*domain.Change{{
+					Type:       domain.ChangeTypeDeleted,
+					ExternalID: threadExternalID(channel.ID, parent.TS),
+				}}, nil
+			}
+			if err != nil {
+				return nil, fmt.Errorf("get thread %s: %w", parent.TS, err)
+			}
+		}
+	}
+
+	change, err := c.threadChange(ctx, channel, thread)
+	if err != nil {
+		return nil, err
+	}
+	change.Type = changeType
+	changes := []*domain.Change{change}
+
+	if c.config.IncludeFiles {
+		for _, msg := range thread {
+			for _, file := range msg.Files {
+				fileChange, err := c.fileChange(ctx, file)
+				if err != nil || fileChange == nil {
+					// Skip files we can't fetch
+					continue
+				}
+				fileChange.Type = changeType
+				changes = append(changes, fileChange)
+			}
+		}
+	}
+
+	return changes, nil
+}
+
+// thread fetches every message of a thread, the parent first.
+func (c *Connector) thread(ctx context.Context, channelID, threadTS string) ([]*Message, error) {
+	var messages []*Message
+	cursor := ""
+	for {
+		page, nextCursor, err := c.client.Replies(ctx, channelID, threadTS, cursor)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, page...)
+		if nextCursor == "" {
+			return messages, nil
+		}
+		cursor = nextCursor
+	}
+}
+
+// threadChange converts a thread to a change carrying its content.
+func (c *Connector) threadChange(ctx context.Context, channel *Channel, thread []*Message) (*domain.Change, error) {
+	workspaceURL, err := c.workspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	parent := thread[0]
+	var sb strings.Builder
+	var participants []string
+	seenParticipants := make(map[string]bool)
+	updated := ""
+	for _, msg := range thread {
+		updated = latestTS(updated, msg.TS, editedTS(msg))
+		if msg.Subtype == "tombstone" {
+			continue
+		}
+
+		author := c.authorName(ctx, msg)
+		if !seenParticipants[author] {
+			seenParticipants[author] = true
+			participants = append(participants, author)
+		}
+
+		if sb.Len() > 0 {
+			sb.WriteString("\n\n")
+		}
+		sb.WriteString(fmt.Sprintf("%s (%s):\n", author, tsTime(msg.TS).Format("2006-01-02 15:04 UTC")))
+		sb.WriteString(c.resolveMentions(ctx, msg.Text))
+		for _, file := range msg.Files {
+			sb.WriteString(fmt.Sprintf("\n[file: %s]", fileTitle(file)))
+		}
+	}
+
+	metadata := map[string]string{
+		"channel":    channel.Name,
+		"channel_id": channel.ID,
+		"thread_ts":  parent.TS,
+		"replies":    strconv.Itoa(len(thread) - 1),
+	}
+	if parent.Subtype != "tombstone" {
+		metadata["author"] = c.authorName(ctx, parent)
+	}
+	if len(participants) > 0 {
+		metadata["participants"] = strings.Join(participants, ",")
+	}
+
+	return &domain.Change{
+		ExternalID: threadExternalID(channel.ID, parent.TS),
+		Document: &domain.Document{
+			Title:     threadTitle(channel, c.resolveMentions(ctx, parent.Text)),
+			Path:      fmt.Sprintf("%sarchives/%s/p%s", workspaceURL, channel.ID, strings.Replace(parent.TS, ".", "", 1)),
+			MimeType:  MimeTypeMessage,
+			Metadata:  metadata,
+			CreatedAt: tsTime(parent.TS),
+			UpdatedAt: tsTime(updated),
+		},
+		Content: sb.String(),
+	}, nil
+}
+
+// fileChange downloads a shared file as a change. Returns nil for deleted
+// and external files, and files larger than the configured maximum.
+func (c *Connector) fileChange(ctx context.Context, file *File) (*domain.Change, error) {
+	if file.Mode == "tombstone" || file.Mode == "external" || file.URLPrivateDownload == "" ||
+		file.Size > c.config.MaxFileSize {
+		return nil, nil
+	}
+
+	data, err := c.client.DownloadFile(ctx, file.URLPrivateDownload, c.config.MaxFileSize)
+	if err != nil {
+		return nil, fmt.Errorf("download file %s: %w", file.ID, err)
+	}
+
+	metadata := map[string]string{
+		"file_name": file.Name,
+		"filetype":  file.Filetype,
+		"size":      strconv.FormatInt(file.Size, 10),
+	}
+	if file.User != "" {
+		metadata["author"] = c.userName(ctx, file.User)
+	}
+
+	created := time.Unix(file.Created, 0).UTC()
+	updated := created
+	if file.Timestamp > file.Created {
+		updated = time.Unix(file.Timestamp, 0).UTC()
+	}
+
+	return &domain.Change{
+		ExternalID: fileExternalID(file.ID),
+		Document: &domain.Document{
+			Title:     fileTitle(file),
+			Path:      file.Permalink,
+			MimeType:  file.Mimetype,
+			Metadata:  metadata,
+			CreatedAt: created,
+			UpdatedAt: updated,
+		},
+		Content: string(data),
+	}, nil
+}
+
+// FetchDocument fetches a single thread or file by external ID.
+func (c *Connector) FetchDocument(ctx context.Context, source *domain.Source, externalID string) (*domain.Change, error) {
+	if fileID, ok := strings.CutPrefix(externalID, "file-"); ok {
+		file, err := c.client.GetFile(ctx, fileID)
+		if err != nil {
+			return nil, fmt.Errorf("get file: %w", err)
+		}
+		change, err := c.fileChange(ctx, file)
+		if err == nil && change == nil {
+			return nil, fmt.Errorf("%w: file %s is deleted, external or exceeds the size limit", domain.ErrNotFound, fileID)
+		}
+		if change != nil {
+			change.Type = domain.ChangeTypeModified
+		}
+		return change, err
+	}
+
+	channelID, threadTS, err := parseThreadExternalID(externalID)
+	if err != nil {
+		return nil, err
+	}
+
+	channel, err := c.client.GetChannel(ctx, channelID)
+	if err != nil {
+		return nil, fmt.Errorf("get channel: %w", err)
+	}
+	thread, err := c.thread(ctx, channelID, threadTS)
+	if err != nil {
+		return nil, fmt.Errorf("get thread: %w", err)
+	}
+	if len(thread) == 0 || thread[0].TS != threadTS {
+		return nil, fmt.Errorf("%w: thread %s", domain.ErrNotFound, threadTS)
+	}
+
+	change, err := c.threadChange(ctx, channel, thread)
+	if err != nil {
+		return nil, err
+	}
+	change.Type = domain.ChangeTypeModified
+	return change, nil
+}
+
+// TestConnection tests the token and, for a channel-scoped connector,
+// access to the channel.
+func (c *Connector) TestConnection(ctx context.Context, source *domain.Source) error {
+	if _, err := c.client.AuthTest(ctx); err != nil {
+		return err
+	}
+	if c.channelID != "" {
+		_, err := c.client.GetChannel(ctx, c.channelID)
+		return err
+	}
+	return nil
+}
+
+// workspace returns the workspace URL, used to build message permalinks.
+func (c *Connector) workspace(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.workspaceURL == "" {
+		info, err := c.client.AuthTest(ctx)
+		if err != nil {
+			return "", fmt.Errorf("get workspace: %w", err)
+		}
+		c.workspaceURL = info.URL
+		if !strings.HasSuffix(c.workspaceURL, "/") {
+			c.workspaceURL += "/"
+		}
+	}
+	return c.workspaceURL, nil
+}
+
+// authorName returns the display name of a message's author.
+func (c *Connector) authorName(ctx context.Context, msg *Message) string {
+	switch {
+	case msg.User != "":
+		return c.userName(ctx, msg.User)
+	case msg.Username != "":
+		return msg.Username
+	case msg.BotID != "":
+		return msg.BotID
+	}
+	return "unknown"
+}
+
+// userName returns the display name of a user, or the user ID if the user
+// cannot be looked up. Names are cached for the connector's lifetime.
+func (c *Connector) userName(ctx context.Context, userID string) string {
+	c.mu.Lock()
+	name, ok := c.users[userID]
+	c.mu.Unlock()
+	if ok {
+		return name
+	}
+
+	name = userID
+	if user, err := c.client.GetUser(ctx, userID); err == nil {
+		name = user.DisplayName()
+	}
+
+	c.mu.Lock()
+	c.users[userID] = name
+	c.mu.Unlock()
+	return name
+}
+
+// resolveMentions labels the user mentions of a message text with the
+// users' names ("<@U123>" becomes "<@U123|ann>"), so that the text can be
+// normalised without looking users up.
+func (c *Connector) resolveMentions(ctx context.Context, text string) string {
+	return userMentionPattern.ReplaceAllStringFunc(text, func(mention string) string {
+		userID := userMentionPattern.FindStringSubmatch(mention)[1]
+		return fmt.Sprintf("<@%s|%s>", userID, c.userName(ctx, userID))
+	})
+}
+
+// threadTitle builds the title of a thread from its channel and the first
+// line of its parent message.
+func threadTitle(channel *Channel, text string) string {
+	text = labelledMentionPattern.ReplaceAllString(text, "@$1")
+	text = labelledLinkPattern.ReplaceAllString(text, "$1")
+	text = linkPattern.ReplaceAllString(text, "$1")
+	text, _, _ = strings.Cut(strings.TrimSpace(text), "\n")
+	if utf8.RuneCountInString(text) > maxTitleLength {
+		text = string([]rune(text)[:maxTitleLength]) + "…"
+	}
+	if text == "" {
+		return "#" + channel.Name
+	}
+	return fmt.Sprintf("#%s: %s", channel.Name, text)
+}
+
+// fileTitle returns the title of a file, falling back to its name.
+func fileTitle(file *File) string {
+	if file.Title != "" {
+		return file.Title
+	}
+	return file.Name
+}
+
+// threadExternalID formats the external ID of a thread.
+// Format: "thread-<channel ID>-<thread timestamp>"
+func threadExternalID(channelID, threadTS string) string {
+	return fmt.Sprintf("thread-%s-%s", channelID, threadTS)
+}
+
+// fileExternalID formats the external ID of a file.
+// Format: "file-<file ID>"
+func fileExternalID(fileID string) string {
+	return "file-" + fileID
+}
+
+// parseThreadExternalID parses an external ID formatted by threadExternalID.
+func parseThreadExternalID(id string) (channelID, threadTS string, err error) {
+	parts := strings.SplitN(id, "-", 3)
+	if len(parts) != 3 || parts[0] != "thread" || parts[1] == "" || parts[2] == "" {
+		return "", "", fmt.Errorf("invalid external ID format: %s", id)
+	}
+	return parts[1], parts[2], nil
+}
@@ -0,0 +1,311 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// newTestServer serves a Slack workspace with two channels, #general (C1),
+// which the app is a member of, and #random (C2). #general holds a thread
+// with an edited reply, that reply also sent to the channel, and a message
+// sharing a file. Requests are recorded by path and query.
+func newTestServer(t *testing.T) (*httptest.Server, *[]string) {
+	t.Helper()
+	var requests []string
+
+	writeJSON := func(w http.ResponseWriter, v map[string]any) {
+		w.Header().Set("Content-Type", "application/json")
+		if _, ok := v["ok"]; !ok {
+			v["ok"] = true
+		}
+		_ = json.NewEncoder(w).Encode(v)
+	}
+
+	general := map[string]any{"id": "C1", "name": "general", "is_member": true, "num_members": 3}
+	random := map[string]any{"id": "C2", "name": "random", "is_private": true}
+	parent := map[string]any{
+		"type": "message", "ts": "1772000000.000100", "user": "U1", "text": "Deploy is failing, <@U2>?",
+		"reply_count": 1, "latest_reply": "1772000100.000200",
+	}
+	reply := map[string]any{
+		"type": "message", "ts": "1772000100.000200", "thread_ts": "1772000000.000100", "user": "U2",
+		"text": "Fixed in <https://ci.example.com/1|build 1>", "edited": map[string]any{"ts": "1772000120.000000"},
+	}
+	fileMessage := map[string]any{
+		"type": "message", "ts": "1772000200.000300", "user": "U2", "text": "Notes attached",
+		"files": []any{map[string]any{
+			"id": "F1", "name": "notes.txt", "title": "Notes", "mimetype": "text/plain", "size": 5,
+			"created": 1772000200, "permalink": "https://acme.slack.com/files/U2/F1/notes.txt",
+		}},
+	}
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path+"?"+r.URL.RawQuery)
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			writeJSON(w, map[string]any{"ok": false, "error": "invalid_auth"})
+			return
+		}
+
+		fileMessage["files"].([]any)[0].(map[string]any)["url_private_download"] = server.URL + "/files/F1/notes.txt"
+		query := r.URL.Query()
+		switch r.URL.Path {
+		case "/api/auth.test":
+			writeJSON(w, map[string]any{"url": "https://acme.slack.com/", "team": "Acme", "team_id": "T1"})
+		case "/api/conversations.list":
+			writeJSON(w, map[string]any{"channels": []any{general, random}})
+		case "/api/conversations.info":
+			if query.Get("channel") != "C1" {
+				writeJSON(w, map[string]any{"ok": false, "error": "channel_not_found"})
+				return
+			}
+			writeJSON(w, map[string]any{"channel": general})
+		case "/api/conversations.history":
+			broadcast := map[string]any{
+				"type": "message", "subtype": "thread_broadcast", "ts": "1772000100.000200",
+				"thread_ts": "1772000000.000100", "user": "U2", "text": "Fixed",
+			}
+			writeJSON(w, map[string]any{"messages": []any{fileMessage, broadcast, parent}})
+		case "/api/conversations.replies":
+			if query.Get("ts") != "1772000000.000100" {
+				writeJSON(w, map[string]any{"ok": false, "error": "thread_not_found"})
+				return
+			}
+			writeJSON(w, map[string]any{"messages": []any{parent, reply}})
+		case "/api/users.info":
+			names := map[string]string{"U1": "Ann", "U2": "Bob"}
+			writeJSON(w, map[string]any{"user": map[string]any{
+				"id": query.Get("user"), "profile": map[string]any{"display_name": names[query.Get("user")]},
+			}})
+		case "/files/F1/notes.txt":
+			_, _ = w.Write([]byte("notes"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func newTestConnector(apiURL, channelID string) *Connector {
+	config := DefaultConfig()
+	config.APIURL = apiURL
+	tokenProvider := driven.NewStaticTokenProvider(&domain.Credentials{AuthMethod: domain.AuthMethodAPIKey, APIKey: "test-token"})
+	return NewConnector(tokenProvider, channelID, config)
+}
+
+func changesByID(changes []*domain.Change) map[string]*domain.Change {
+	byID := make(map[string]*domain.Change)
+	for _, change := range changes {
+		byID[change.ExternalID] = change
+	}
+	return byID
+}
+
+func TestConnector_FetchChanges(t *testing.T) {
+	server, _ := newTestServer(t)
+	c := newTestConnector(server.URL+"/api", "C1")
+
+	changes, cursor, err := c.FetchChanges(context.Background(), &domain.Source{}, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(changes) != 3 {
+		t.Fatalf("expected 2 threads and 1 file, got %d changes", len(changes))
+	}
+
+	byID := changesByID(changes)
+	thread := byID["thread-C1-1772000000.000100"]
+	if thread == nil {
+		t.Fatal("expected the thread with a reply")
+	}
+	if thread.Type != domain.ChangeTypeAdded || thread.Document.MimeType != MimeTypeMessage {
+		t.Errorf("unexpected thread change: %+v", thread)
+	}
+	if thread.Document.Path != "https://acme.slack.com/archives/C1/p1772000000000100" {
+		t.Errorf("unexpected permalink %q", thread.Document.Path)
+	}
+	if thread.Document.Title != "#general: Deploy is failing, @Bob?" {
+		t.Errorf("unexpected title %q", thread.Document.Title)
+	}
+	if !strings.Contains(thread.Content, "Deploy is failing, <@U2|Bob>?") || !strings.Contains(thread.Content, "Bob (2026-") {
+		t.Errorf("expected mentions and authors resolved to names, got %q", thread.Content)
+	}
+	if thread.Document.Metadata["replies"] != "1" || thread.Document.Metadata["participants"] != "Ann,Bob" {
+		t.Errorf("unexpected thread metadata: %v", thread.Document.Metadata)
+	}
+	if !thread.Document.UpdatedAt.Equal(time.Unix(1772000120, 0).UTC()) {
+		t.Errorf("expected thread updated at its latest reply edit, got %s", thread.Document.UpdatedAt)
+	}
+
+	if file := byID["file-F1"]; file == nil || file.Content != "notes" || file.Document.MimeType != "text/plain" {
+		t.Errorf("unexpected file change: %+v", file)
+	}
+
+	position := parseCursor(cursor)["C1"]
+	if position == nil || position.Oldest != "1772000200.000300" || len(position.Threads) != 2 {
+		t.Errorf("unexpected cursor %q", cursor)
+	}
+}
+
+func TestConnector_FetchChanges_Incremental(t *testing.T) {
+	server, requests := newTestServer(t)
+	c := newTestConnector(server.URL+"/api", "C1")
+
+	// The thread at 1771999000 was seen by the previous sync and is gone
+	cursor := `{"C1":{"oldest":"1772000150.000000","threads":["1771999000.000000","1772000000.000100"]}}`
+	changes, next, err := c.FetchChanges(context.Background(), &domain.Source{}, cursor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	byID := changesByID(changes)
+	if len(changes) != 3 {
+		t.Errorf("expected the new thread, its file and a deletion, got %d changes", len(changes))
+	}
+	if byID["thread-C1-1772000000.000100"] != nil {
+		t.Error("expected the unchanged thread to be skipped")
+	}
+	if thread := byID["thread-C1-1772000200.000300"]; thread == nil || thread.Type != domain.ChangeTypeModified {
+		t.Errorf("expected the new thread as modified, got %+v", thread)
+	}
+	if deleted := byID["thread-C1-1771999000.000000"]; deleted == nil || deleted.Type != domain.ChangeTypeDeleted {
+		t.Errorf("expected the missing thread to be deleted, got %+v", deleted)
+	}
+
+	found := false
+	for _, r := range *requests {
+		if strings.HasPrefix(r, "/api/conversations.history") && strings.Contains(r, "oldest=1771395350.000000") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected history from the edit window before the cursor, got %v", *requests)
+	}
+	if position := parseCursor(next)["C1"]; position == nil || position.Oldest != "1772000200.000300" {
+		t.Errorf("unexpected cursor %q", next)
+	}
+}
+
+func TestConnector_FetchChanges_ReplyEditsAndDeletes(t *testing.T) {
+	server, _ := newTestServer(t)
+	c := newTestConnector(server.URL+"/api", "C1")
+
+	tests := []struct {
+		name    string
+		cursor  string
+		changed bool
+	}{
+		{"reply edited after the cursor", `{"C1":{"oldest":"1772000110.000000","threads":["1772000000.000100"]}}`, true},
+		{"reply deleted", `{"C1":{"oldest":"1772000150.000000","threads":["1772000000.000100"],"replies":{"1772000000.000100":2}}}`, true},
+		{"replies unchanged", `{"C1":{"oldest":"1772000150.000000","threads":["1772000000.000100"],"replies":{"1772000000.000100":1}}}`, false},
+	}
+	for _, tt := range tests {
+		changes, next, err := c.FetchChanges(context.Background(), &domain.Source{}, tt.cursor)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+		if changed := changesByID(changes)["thread-C1-1772000000.000100"] != nil; changed != tt.changed {
+			t.Errorf("%s: expected thread changed %v, got %v", tt.name, tt.changed, changed)
+		}
+		if position := parseCursor(next)["C1"]; position == nil || position.Replies["1772000000.000100"] != 1 {
+			t.Errorf("%s: expected the thread's reply count in the cursor, got %q", tt.name, next)
+		}
+	}
+}
+
+func TestConnector_ConfiguredChannels(t *testing.T) {
+	server, _ := newTestServer(t)
+	c := newTestConnector(server.URL+"/api", "")
+
+	source := &domain.Source{Config: domain.SourceConfig{Channels: []string{"#general"}}}
+	_, cursor, err := c.FetchChanges(context.Background(), source, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if state := parseCursor(cursor); len(state) != 1 || state["C1"] == nil {
+		t.Errorf("expected only #general to be synced, got %q", cursor)
+	}
+
+	source.Config.Channels = []string{"missing"}
+	if _, _, err := c.FetchChanges(context.Background(), source, ""); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for an unknown channel, got %v", err)
+	}
+}
+
+func TestConnector_FetchDocument(t *testing.T) {
+	server, _ := newTestServer(t)
+	c := newTestConnector(server.URL+"/api", "C1")
+
+	change, err := c.FetchDocument(context.Background(), &domain.Source{}, "thread-C1-1772000000.000100")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if change.Type != domain.ChangeTypeModified || change.Document.Metadata["replies"] != "1" {
+		t.Errorf("unexpected change: %+v", change)
+	}
+
+	_, err = c.FetchDocument(context.Background(), &domain.Source{}, "thread-C1-1771999000.000000")
+	if !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for a deleted thread, got %v", err)
+	}
+}
+
+func TestClient_RateLimited(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "3")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	c := newTestConnector(server.URL, "C1")
+	_, err := c.client.AuthTest(context.Background())
+
+	var rateLimitErr *domain.RateLimitError
+	if !errors.As(err, &rateLimitErr) || rateLimitErr.RetryAfter != 3*time.Second {
+		t.Errorf("expected a rate limit error retrying after 3s, got %v", err)
+	}
+}
+
+func TestBuilder_MergeCursors(t *testing.T) {
+	b := NewBuilder()
+
+	merged := b.MergeCursors(
+		`{"C1":{"oldest":"1772000200.000300"},"C2":{"oldest":"1772000000.000000"}}`,
+		`{"C2":{"oldest":"1772000100.000000"},"C3":{"oldest":"999.000000"}}`,
+	)
+	state := parseCursor(merged)
+	if state["C1"].Oldest != "1772000200.000300" || state["C2"].Oldest != "1772000100.000000" || state["C3"].Oldest != "999.000000" {
+		t.Errorf("expected the latest position of each channel, got %s", merged)
+	}
+	if b.MergeCursors("", "") != "" {
+		t.Error("expected empty cursors to merge to an empty cursor")
+	}
+}
+
+func TestCompareTS(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1772000000.000100", "1772000000.000100", 0},
+		{"1772000000.000100", "1772000000.000020", 1},
+		{"999.000000", "1772000000.000000", -1},
+		{"1772000000.5", "1772000000.400000", 1},
+		{"", "1.000000", -1},
+	}
+	for _, tt := range tests {
+		if got := compareTS(tt.a, tt.b); got != tt.want {
+			t.Errorf("compareTS(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Ensure ContainerLister implements the interface.
+var _ driven.ContainerLister = (*ContainerLister)(nil)
+
+// ContainerLister lists the Slack channels visible to an installation's
+// bot token.
+type ContainerLister struct {
+	client *Client
+}
+
+// NewContainerLister creates a ContainerLister with the given token provider.
+func NewContainerLister(tokenProvider driven.TokenProvider) *ContainerLister {
+	return &ContainerLister{
+		client: NewClient(tokenProvider, DefaultAPIURL),
+	}
+}
+
+// ListContainers lists a page of the public and private channels visible
+// to the app. Only channels the app is a member of can be synced, which
+// the "is_member" metadata reports.
+func (l *ContainerLister) ListContainers(ctx context.Context, cursor string) ([]*driven.Container, string, error) {
+	channels, nextCursor, err := l.client.ListChannels(ctx, cursor)
+	if err != nil {
+		return nil, "", fmt.Errorf("list channels: %w", err)
+	}
+
+	containers := make([]*driven.Container, len(channels))
+	for i, channel := range channels {
+		containerType := "public_channel"
+		if channel.IsPrivate {
+			containerType = "private_channel"
+		}
+		containers[i] = &driven.Container{
+			ID:          channel.ID,
+			Name:        "#" + channel.Name,
+			Description: channel.Purpose.Value,
+			Type:        containerType,
+			Metadata: map[string]string{
+				"is_member":   strconv.FormatBool(channel.IsMember),
+				"num_members": strconv.Itoa(channel.NumMembers),
+				"topic":       channel.Topic.Value,
+			},
+		}
+	}
+
+	return containers, nextCursor, nil
+}
+
+// ContainerListerFactory creates ContainerListers for Slack installations.
+type ContainerListerFactory struct {
+	installationStore driven.InstallationStore
+	tokenFactory      driven.TokenProviderFactory
+}
+
+// NewContainerListerFactory creates a factory for Slack container listers.
+func NewContainerListerFactory(
+	installationStore driven.InstallationStore,
+	tokenFactory driven.TokenProviderFactory,
+) *ContainerListerFactory {
+	return &ContainerListerFactory{
+		installationStore: installationStore,
+		tokenFactory:      tokenFactory,
+	}
+}
+
+// Create creates a ContainerLister for a Slack installation.
+func (f *ContainerListerFactory) Create(ctx context.Context, installationID string) (driven.ContainerLister, error) {
+	tokenProvider, err := f.tokenFactory.Create(ctx, installationID)
+	if err != nil {
+		return nil, fmt.Errorf("create token provider: %w", err)
+	}
+
+	return NewContainerLister(tokenProvider), nil
+}
@@ -0,0 +1,126 @@
+package slack
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cursorState is a Slack sync cursor: the position of each synced channel,
+// by channel ID. It is stored as JSON.
+type cursorState map[string]*channelCursor
+
+// channelCursor is the sync position of one channel.
+type channelCursor struct {
+	// Oldest is the timestamp of the newest message, reply or edit seen,
+	// used as the "oldest" bound of the next sync's history request
+	Oldest string `json:"oldest"`
+
+	// Threads are the timestamps of the threads within the edit window
+	// before Oldest. A thread missing from the next sync's history was
+	// deleted.
+	Threads []string `json:"threads,omitempty"`
+
+	// Replies are the reply counts of those threads, by timestamp. A count
+	// that differs in the next sync's history means replies were deleted.
+	Replies map[string]int `json:"replies,omitempty"`
+}
+
+// parseCursor parses a cursor formatted by cursorState.String. An empty or
+// unreadable cursor has no channels, so every channel is synced in full.
+func parseCursor(cursor string) cursorState {
+	state := cursorState{}
+	if cursor != "" {
+		_ = json.Unmarshal([]byte(cursor), &state)
+	}
+	return state
+}
+
+// String formats the cursor; a cursor without channels is empty.
+func (s cursorState) String() string {
+	if len(s) == 0 {
+		return ""
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// mergeCursors combines two cursors, keeping the most recent position of
+// each channel.
+func mergeCursors(cursor, other string) string {
+	merged := parseCursor(cursor)
+	for channelID, position := range parseCursor(other) {
+		if current, ok := merged[channelID]; !ok || compareTS(position.Oldest, current.Oldest) > 0 {
+			merged[channelID] = position
+		}
+	}
+	return merged.String()
+}
+
+// compareTS compares two Slack timestamps ("<seconds>.<microseconds>"),
+// returning -1, 0 or 1. The empty timestamp is before every other.
+func compareTS(a, b string) int {
+	return strings.Compare(sortableTS(a), sortableTS(b))
+}
+
+// sortableTS pads a timestamp so that timestamps compare as strings.
+func sortableTS(ts string) string {
+	if ts == "" {
+		return ""
+	}
+	secs, micros, _ := strings.Cut(ts, ".")
+	return fmt.Sprintf("%015s.%s", secs, padMicros(micros))
+}
+
+// padMicros right-pads the fractional part of a timestamp to six digits.
+func padMicros(micros string) string {
+	if len(micros) >= 6 {
+		return micros[:6]
+	}
+	return micros + strings.Repeat("0", 6-len(micros))
+}
+
+// latestTS returns the latest of the given timestamps.
+func latestTS(timestamps ...string) string {
+	latest := ""
+	for _, ts := range timestamps {
+		if compareTS(ts, latest) > 0 {
+			latest = ts
+		}
+	}
+	return latest
+}
+
+// sortTS sorts timestamps in ascending order.
+func sortTS(timestamps []string) {
+	sort.Slice(timestamps, func(i, j int) bool {
+		return compareTS(timestamps[i], timestamps[j]) < 0
+	})
+}
+
+// tsTime converts a timestamp to a time (the zero time if invalid).
+func tsTime(ts string) time.Time {
+	secs, micros, _ := strings.Cut(ts, ".")
+	s, err := strconv.ParseInt(secs, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	us, _ := strconv.ParseInt(padMicros(micros), 10, 64)
+	return time.Unix(s, us*1000).UTC()
+}
+
+// tsBefore returns the timestamp d before ts (empty for an empty ts).
+func tsBefore(ts string, d time.Duration) string {
+	t := tsTime(ts)
+	if ts == "" || t.IsZero() {
+		return ""
+	}
+	t = t.Add(-d)
+	return fmt.Sprintf("%d.%06d", t.Unix(), t.Nanosecond()/1000)
+}
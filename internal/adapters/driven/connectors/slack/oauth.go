@@ -0,0 +1,178 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-core/internal/adapters/driven/connectors"
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Ensure OAuthHandler implements the interface.
+var _ connectors.OAuthHandler = (*OAuthHandler)(nil)
+
+// Slack OAuth v2 endpoints.
+const (
+	authURL  = "https://slack.com/oauth/v2/authorize"
+	tokenURL = DefaultAPIURL + "/oauth.v2.access"
+)
+
+// defaultScopes are the bot token scopes requested from Slack: reading
+// public and private channels and their history, users (to resolve
+// mentions) and shared files.
+var defaultScopes = []string{
+	"channels:read",
+	"channels:history",
+	"groups:read",
+	"groups:history",
+	"users:read",
+	"files:read",
+}
+
+// OAuthHandler handles OAuth operations for Slack.
+type OAuthHandler struct {
+	httpClient *http.Client
+	apiURL     string
+}
+
+// NewOAuthHandler creates a new Slack OAuth handler.
+func NewOAuthHandler() *OAuthHandler {
+	return &OAuthHandler{
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		apiURL:     DefaultAPIURL,
+	}
+}
+
+// BuildAuthURL constructs the Slack OAuth authorization URL.
+// The scopes are bot token scopes. Slack does not support PKCE for
+// confidential clients, so codeChallenge is ignored.
+func (h *OAuthHandler) BuildAuthURL(clientID, redirectURI, state, codeChallenge string, scopes []string) string {
+	params := url.Values{
+		"client_id":    {clientID},
+		"redirect_uri": {redirectURI},
+		"state":        {state},
+		"scope":        {strings.Join(scopes, ",")},
+	}
+	return authURL + "?" + params.Encode()
+}
+
+// ExchangeCode exchanges an authorization code for a bot token.
+func (h *OAuthHandler) ExchangeCode(ctx context.Context, clientID, clientSecret, code, redirectURI, codeVerifier string) (*driven.OAuthToken, error) {
+	params := url.Values{
+		"client_id":     {clientID},
+		"client_secret": {clientSecret},
+		"code":          {code},
+		"redirect_uri":  {redirectURI},
+	}
+
+	token, err := h.requestToken(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("token exchange failed: %w", err)
+	}
+	return token, nil
+}
+
+// RefreshToken refreshes an expired access token.
+// Slack bot tokens only expire, and come with a refresh token, when token
+// rotation is enabled for the app.
+func (h *OAuthHandler) RefreshToken(ctx context.Context, clientID, clientSecret, refreshToken string) (*driven.OAuthToken, error) {
+	params := url.Values{
+		"client_id":     {clientID},
+		"client_secret": {clientSecret},
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+	}
+
+	token, err := h.requestToken(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("token refresh failed: %w", err)
+	}
+	return token, nil
+}
+
+// requestToken posts params to the token endpoint.
+func (h *OAuthHandler) requestToken(ctx context.Context, params url.Values) (*driven.OAuthToken, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST",
+		h.apiURL+"/oauth.v2.access",
+		strings.NewReader(params.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("do request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var tokenResp struct {
+		OK           bool   `json:"ok"`
+		Error        string `json:"error"`
+		AccessToken  string `json:"access_token"`
+		TokenType    string `json:"token_type"` // "bot"
+		Scope        string `json:"scope"`
+		RefreshToken string `json:"refresh_token"`
+		ExpiresIn    int    `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &tokenResp); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	if !tokenResp.OK {
+		return nil, fmt.Errorf("oauth error: %s", tokenResp.Error)
+	}
+
+	return &driven.OAuthToken{
+		AccessToken:  tokenResp.AccessToken,
+		RefreshToken: tokenResp.RefreshToken,
+		TokenType:    "Bearer",
+		Scope:        tokenResp.Scope,
+		ExpiresIn:    tokenResp.ExpiresIn,
+	}, nil
+}
+
+// GetUserInfo identifies the installation. A bot token belongs to the
+// workspace rather than a user, so the workspace ID and name are returned.
+func (h *OAuthHandler) GetUserInfo(ctx context.Context, accessToken string) (*driven.OAuthUserInfo, error) {
+	tokenProvider := driven.NewStaticTokenProvider(&domain.Credentials{
+		AuthMethod: domain.AuthMethodAPIKey,
+		APIKey:     accessToken,
+	})
+	info, err := NewClient(tokenProvider, h.apiURL).AuthTest(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get user info failed: %w", err)
+	}
+
+	return &driven.OAuthUserInfo{
+		ID:   info.TeamID,
+		Name: info.Team,
+	}, nil
+}
+
+// DefaultConfig returns Slack's default OAuth configuration.
+func (h *OAuthHandler) DefaultConfig() connectors.OAuthDefaults {
+	return connectors.OAuthDefaults{
+		AuthURL:      authURL,
+		TokenURL:     tokenURL,
+		Scopes:       defaultScopes,
+		UserInfoURL:  DefaultAPIURL + "/auth.test",
+		SupportsPKCE: false,
+	}
+}
@@ -0,0 +1,222 @@
+package embedded
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+)
+
+// BM25 parameters (the common Lucene/Vespa defaults)
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// indexedChunk is a chunk held in the index with its precomputed statistics
+type indexedChunk struct {
+	chunk  *domain.Chunk
+	length int     // Number of tokens in the content
+	norm   float64 // L2 norm of the embedding, 0 when there is none
+}
+
+// index is an in-memory inverted index with per-chunk embeddings.
+// It is not safe for concurrent use; SearchEngine guards it.
+type index struct {
+	chunks     map[string]*indexedChunk
+	postings   map[string]map[string]int // Term to chunk ID to term frequency
+	byDocument map[string]map[string]struct{}
+	totalLen   int
+}
+
+func newIndex() *index {
+	return &index{
+		chunks:     make(map[string]*indexedChunk),
+		postings:   make(map[string]map[string]int),
+		byDocument: make(map[string]map[string]struct{}),
+	}
+}
+
+// add indexes a copy of chunk, replacing any chunk with the same ID
+func (ix *index) add(chunk *domain.Chunk) {
+	if chunk == nil || chunk.ID == "" {
+		return
+	}
+	ix.remove(chunk.ID)
+
+	stored := *chunk
+	if chunk.Embedding != nil {
+		stored.Embedding = append([]float32(nil), chunk.Embedding...)
+	}
+
+	tokens := tokenize(stored.Content)
+	for _, term := range tokens {
+		postings, ok := ix.postings[term]
+		if !ok {
+			postings = make(map[string]int)
+			ix.postings[term] = postings
+		}
+		postings[stored.ID]++
+	}
+
+	ix.chunks[stored.ID] = &indexedChunk{
+		chunk:  &stored,
+		length: len(tokens),
+		norm:   vectorNorm(stored.Embedding),
+	}
+	ix.totalLen += len(tokens)
+
+	docChunks, ok := ix.byDocument[stored.DocumentID]
+	if !ok {
+		docChunks = make(map[string]struct{})
+		ix.byDocument[stored.DocumentID] = docChunks
+	}
+	docChunks[stored.ID] = struct{}{}
+}
+
+// remove deletes a chunk by ID. Unknown IDs are ignored.
+func (ix *index) remove(id string) {
+	ic, ok := ix.chunks[id]
+	if !ok {
+		return
+	}
+
+	for _, term := range tokenize(ic.chunk.Content) {
+		postings := ix.postings[term]
+		delete(postings, id)
+		if len(postings) == 0 {
+			delete(ix.postings, term)
+		}
+	}
+
+	ix.totalLen -= ic.length
+	delete(ix.chunks, id)
+
+	if docChunks := ix.byDocument[ic.chunk.DocumentID]; docChunks != nil {
+		delete(docChunks, id)
+		if len(docChunks) == 0 {
+			delete(ix.byDocument, ic.chunk.DocumentID)
+		}
+	}
+}
+
+// removeDocument deletes all chunks of a document
+func (ix *index) removeDocument(documentID string) {
+	for id := range ix.byDocument[documentID] {
+		ix.remove(id)
+	}
+}
+
+// removeSource deletes all chunks of a source
+func (ix *index) removeSource(sourceID string) {
+	for id, ic := range ix.chunks {
+		if ic.chunk.SourceID == sourceID {
+			ix.remove(id)
+		}
+	}
+}
+
+// bm25 scores every accepted chunk containing at least one query term
+func (ix *index) bm25(terms []string, accept func(*indexedChunk) bool) map[string]float64 {
+	scores := make(map[string]float64)
+	n := float64(len(ix.chunks))
+	if n == 0 {
+		return scores
+	}
+	avgLen := float64(ix.totalLen) / n
+	if avgLen == 0 {
+		avgLen = 1
+	}
+
+	for _, term := range terms {
+		postings := ix.postings[term]
+		if len(postings) == 0 {
+			continue
+		}
+		df := float64(len(postings))
+		idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+
+		for id, tf := range postings {
+			ic := ix.chunks[id]
+			if !accept(ic) {
+				continue
+			}
+			f := float64(tf)
+			norm := 1 - bm25B + bm25B*float64(ic.length)/avgLen
+			scores[id] += idf * f * (bm25K1 + 1) / (f + bm25K1*norm)
+		}
+	}
+	return scores
+}
+
+// cosine scores every accepted chunk whose embedding matches the query dimension
+func (ix *index) cosine(query []float32, accept func(*indexedChunk) bool) map[string]float64 {
+	scores := make(map[string]float64)
+	qNorm := vectorNorm(query)
+	if qNorm == 0 {
+		return scores
+	}
+
+	for id, ic := range ix.chunks {
+		if ic.norm == 0 || len(ic.chunk.Embedding) != len(query) || !accept(ic) {
+			continue
+		}
+		var dot float64
+		for i, v := range query {
+			dot += float64(v) * float64(ic.chunk.Embedding[i])
+		}
+		scores[id] = dot / (qNorm * ic.norm)
+	}
+	return scores
+}
+
+// all returns every accepted chunk ID with a zero score
+func (ix *index) all(accept func(*indexedChunk) bool) map[string]float64 {
+	scores := make(map[string]float64)
+	for id, ic := range ix.chunks {
+		if accept(ic) {
+			scores[id] = 0
+		}
+	}
+	return scores
+}
+
+// snapshot returns the indexed chunks ordered by ID
+func (ix *index) snapshot() []*domain.Chunk {
+	chunks := make([]*domain.Chunk, 0, len(ix.chunks))
+	for _, ic := range ix.chunks {
+		chunks = append(chunks, ic.chunk)
+	}
+	sort.Slice(chunks, func(i, j int) bool { return chunks[i].ID < chunks[j].ID })
+	return chunks
+}
+
+func vectorNorm(v []float32) float64 {
+	var sum float64
+	for _, x := range v {
+		sum += float64(x) * float64(x)
+	}
+	return math.Sqrt(sum)
+}
+
+// tokenize lowercases text and splits it into runs of letters and digits
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// queryTerms returns the distinct tokens of a query
+func queryTerms(query string) []string {
+	seen := make(map[string]bool)
+	var terms []string
+	for _, t := range tokenize(query) {
+		if !seen[t] {
+			seen[t] = true
+			terms = append(terms, t)
+		}
+	}
+	return terms
+}
@@ -0,0 +1,354 @@
+// Package embedded provides an in-process SearchEngine for single-binary
+// installs and hermetic tests. Text search uses a BM25 inverted index and
+// semantic search a brute-force cosine scan over chunk embeddings, which
+// is exact and fast enough for indexes of a few hundred thousand chunks.
+package embedded
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Verify interface compliance
+var _ driven.SearchEngine = (*SearchEngine)(nil)
+
+const (
+	// targetHits is how many nearest neighbours hybrid search merges with
+	// the text matches, matching the Vespa schema
+	targetHits = 100
+
+	// hybridTextWeight is the weight of the normalised BM25 score in hybrid
+	// ranking; the cosine similarity gets the remainder
+	hybridTextWeight = 0.5
+)
+
+// ErrClosed is returned by operations on a closed SearchEngine
+var ErrClosed = errors.New("embedded search engine is closed")
+
+// Config holds embedded search engine configuration
+type Config struct {
+	// Dir is where the index is persisted. Empty keeps the index in memory only.
+	Dir string
+
+	// Documents resolves chunk documents for MIME type filters, since
+	// chunks do not carry a MIME type. Without it MIME filters are ignored.
+	Documents driven.DocumentStore
+}
+
+// DefaultConfig returns a configuration persisting to dir
+func DefaultConfig(dir string) Config {
+	return Config{Dir: dir}
+}
+
+// SearchEngine implements driven.SearchEngine in process.
+// The whole index is held in memory; when a directory is configured every
+// mutation is also written to an on-disk log before it is applied. Only one
+// process may open a directory at a time.
+type SearchEngine struct {
+	mu        sync.RWMutex
+	index     *index
+	storage   *storage // nil for in-memory engines
+	documents driven.DocumentStore
+	closed    bool
+}
+
+// NewSearchEngine creates an embedded SearchEngine, loading any index
+// previously persisted to cfg.Dir
+func NewSearchEngine(cfg Config) (*SearchEngine, error) {
+	s := &SearchEngine{
+		index:     newIndex(),
+		documents: cfg.Documents,
+	}
+
+	if cfg.Dir != "" {
+		st, err := openStorage(cfg.Dir, s.index)
+		if err != nil {
+			return nil, err
+		}
+		s.storage = st
+	}
+
+	return s, nil
+}
+
+// Index indexes chunks, replacing chunks with the same IDs
+func (s *SearchEngine) Index(ctx context.Context, chunks []*domain.Chunk) error {
+	if len(chunks) == 0 {
+		return nil
+	}
+	return s.mutate(ctx, &walOp{Kind: opIndex, Chunks: chunks})
+}
+
+// Delete deletes chunks by IDs
+func (s *SearchEngine) Delete(ctx context.Context, chunkIDs []string) error {
+	if len(chunkIDs) == 0 {
+		return nil
+	}
+	return s.mutate(ctx, &walOp{Kind: opDelete, IDs: chunkIDs})
+}
+
+// DeleteByDocument deletes all chunks for a document
+func (s *SearchEngine) DeleteByDocument(ctx context.Context, documentID string) error {
+	return s.mutate(ctx, &walOp{Kind: opDeleteDocument, IDs: []string{documentID}})
+}
+
+// DeleteBySource deletes all chunks for a source
+func (s *SearchEngine) DeleteBySource(ctx context.Context, sourceID string) error {
+	return s.mutate(ctx, &walOp{Kind: opDeleteSource, IDs: []string{sourceID}})
+}
+
+// mutate logs an operation and applies it to the index
+func (s *SearchEngine) mutate(ctx context.Context, op *walOp) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return ErrClosed
+	}
+
+	if s.storage != nil {
+		if err := s.storage.append(op); err != nil {
+			return err
+		}
+	}
+	op.apply(s.index)
+
+	if s.storage != nil && s.storage.shouldCompact() {
+		// The mutation is already durable in the log, so a failed
+		// compaction only delays folding it into the snapshot
+		_ = s.storage.compact(s.index)
+	}
+	return nil
+}
+
+// Search performs a search query.
+// Text mode ranks by BM25 and semantic mode by cosine similarity. Hybrid
+// mode merges text matches with the nearest neighbours and ranks by a
+// weighted sum of normalised BM25 and cosine similarity; without a query
+// embedding it behaves like text mode.
+func (s *SearchEngine) Search(ctx context.Context, query string, queryEmbedding []float32, opts domain.SearchOptions) ([]*domain.RankedChunk, int, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	s.mu.RLock()
+	if s.closed {
+		s.mu.RUnlock()
+		return nil, 0, ErrClosed
+	}
+	scores := s.score(query, queryEmbedding, opts)
+	candidates := make(map[string]*domain.Chunk, len(scores))
+	for id := range scores {
+		candidates[id] = s.index.chunks[id].chunk
+	}
+	s.mu.RUnlock()
+
+	// MIME filtering may query the document store, so it runs unlocked
+	if err := s.filterMimeTypes(ctx, scores, candidates, opts.Filters.MimeTypes); err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]string, 0, len(scores))
+	for id := range scores {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if scores[ids[i]] != scores[ids[j]] {
+			return scores[ids[i]] > scores[ids[j]]
+		}
+		return ids[i] < ids[j]
+	})
+
+	total := len(ids)
+	start := min(max(opts.Offset, 0), total)
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = domain.DefaultSearchOptions().Limit
+	}
+	end := min(start+limit, total)
+
+	results := make([]*domain.RankedChunk, 0, end-start)
+	for _, id := range ids[start:end] {
+		chunk := *candidates[id]
+		chunk.Embedding = nil // Not returned by search, as with Vespa
+		results = append(results, &domain.RankedChunk{Chunk: &chunk, Score: scores[id]})
+	}
+	return results, total, nil
+}
+
+// score returns the matching chunk IDs and their scores. Must hold the read lock.
+func (s *SearchEngine) score(query string, queryEmbedding []float32, opts domain.SearchOptions) map[string]float64 {
+	accept := sourceFilter(opts.SourceIDs)
+	terms := queryTerms(query)
+
+	switch opts.Mode {
+	case domain.SearchModeTextOnly:
+		if len(terms) == 0 {
+			return s.index.all(accept)
+		}
+		return s.index.bm25(terms, accept)
+
+	case domain.SearchModeSemanticOnly:
+		if len(queryEmbedding) == 0 {
+			return map[string]float64{}
+		}
+		return s.index.cosine(queryEmbedding, accept)
+
+	default: // Hybrid
+		if len(queryEmbedding) == 0 {
+			if len(terms) == 0 {
+				return s.index.all(accept)
+			}
+			return s.index.bm25(terms, accept)
+		}
+		return hybridScores(s.index.bm25(terms, accept), s.index.cosine(queryEmbedding, accept), targetHits)
+	}
+}
+
+// hybridScores merges the text matches with the k nearest neighbours.
+// BM25 scores are normalised by the best text score so both signals fall
+// in [0, 1]; negative similarities count as zero.
+func hybridScores(text, vector map[string]float64, k int) map[string]float64 {
+	var maxText float64
+	for _, score := range text {
+		maxText = max(maxText, score)
+	}
+
+	merged := make(map[string]float64, len(text)+k)
+	for id := range text {
+		merged[id] = 0
+	}
+	for _, id := range topK(vector, k) {
+		merged[id] = 0
+	}
+
+	for id := range merged {
+		var textScore float64
+		if maxText > 0 {
+			textScore = text[id] / maxText
+		}
+		merged[id] = hybridTextWeight*textScore + (1-hybridTextWeight)*max(vector[id], 0)
+	}
+	return merged
+}
+
+// topK returns the IDs of the k highest scores
+func topK(scores map[string]float64, k int) []string {
+	ids := make([]string, 0, len(scores))
+	for id := range scores {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if scores[ids[i]] != scores[ids[j]] {
+			return scores[ids[i]] > scores[ids[j]]
+		}
+		return ids[i] < ids[j]
+	})
+	if len(ids) > k {
+		ids = ids[:k]
+	}
+	return ids
+}
+
+// sourceFilter accepts chunks from the given sources, or all chunks if none are given
+func sourceFilter(sourceIDs []string) func(*indexedChunk) bool {
+	if len(sourceIDs) == 0 {
+		return func(*indexedChunk) bool { return true }
+	}
+	allowed := make(map[string]bool, len(sourceIDs))
+	for _, id := range sourceIDs {
+		allowed[id] = true
+	}
+	return func(ic *indexedChunk) bool { return allowed[ic.chunk.SourceID] }
+}
+
+// filterMimeTypes drops candidates whose document has none of the given
+// MIME types. Documents are looked up once each; chunks whose document
+// cannot be found are dropped.
+func (s *SearchEngine) filterMimeTypes(ctx context.Context, scores map[string]float64, candidates map[string]*domain.Chunk, mimeTypes []string) error {
+	if len(mimeTypes) == 0 || s.documents == nil {
+		return nil
+	}
+
+	allowed := make(map[string]bool, len(mimeTypes))
+	for _, m := range mimeTypes {
+		allowed[strings.ToLower(strings.TrimSpace(m))] = true
+	}
+
+	matches := make(map[string]bool)
+	for id, chunk := range candidates {
+		ok, seen := matches[chunk.DocumentID]
+		if !seen {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			doc, err := s.documents.Get(ctx, chunk.DocumentID)
+			ok = err == nil && doc != nil && allowed[baseMimeType(doc.MimeType)]
+			matches[chunk.DocumentID] = ok
+		}
+		if !ok {
+			delete(scores, id)
+			delete(candidates, id)
+		}
+	}
+	return nil
+}
+
+// baseMimeType lowercases a MIME type and strips its parameters
+func baseMimeType(mimeType string) string {
+	mimeType, _, _ = strings.Cut(mimeType, ";")
+	return strings.ToLower(strings.TrimSpace(mimeType))
+}
+
+// HealthCheck verifies the search engine is available
+func (s *SearchEngine) HealthCheck(ctx context.Context) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed {
+		return ErrClosed
+	}
+	return nil
+}
+
+// Count returns the total number of indexed chunks
+func (s *SearchEngine) Count(ctx context.Context) (int64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed {
+		return 0, ErrClosed
+	}
+	return int64(len(s.index.chunks)), nil
+}
+
+// Close folds the log into a fresh snapshot and releases the index files
+func (s *SearchEngine) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+
+	if s.storage == nil {
+		return nil
+	}
+	compactErr := s.storage.compact(s.index)
+	if err := s.storage.close(); err != nil {
+		return fmt.Errorf("close write-ahead log: %w", err)
+	}
+	return compactErr
+}
@@ -0,0 +1,268 @@
+package embedded
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven/mocks"
+)
+
+func testChunks() []*domain.Chunk {
+	return []*domain.Chunk{
+		{ID: "c1", DocumentID: "d1", SourceID: "s1", Content: "Rotate the signing keys every quarter", Embedding: []float32{1, 0, 0}},
+		{ID: "c2", DocumentID: "d1", SourceID: "s1", Content: "Keys are stored in the vault", Embedding: []float32{0.9, 0.1, 0}},
+		{ID: "c3", DocumentID: "d2", SourceID: "s2", Content: "Onboarding checklist for new hires", Embedding: []float32{0, 1, 0}},
+		{ID: "c4", DocumentID: "d3", SourceID: "s2", Content: "Quarterly revenue report", Embedding: []float32{0, 0, 1}},
+	}
+}
+
+func newTestEngine(t *testing.T, cfg Config) *SearchEngine {
+	t.Helper()
+	engine, err := NewSearchEngine(cfg)
+	if err != nil {
+		t.Fatalf("failed to create engine: %v", err)
+	}
+	t.Cleanup(func() { _ = engine.Close() })
+
+	if err := engine.Index(context.Background(), testChunks()); err != nil {
+		t.Fatalf("failed to index: %v", err)
+	}
+	return engine
+}
+
+func resultIDs(results []*domain.RankedChunk) []string {
+	ids := make([]string, len(results))
+	for i, r := range results {
+		ids[i] = r.Chunk.ID
+	}
+	return ids
+}
+
+func TestSearchEngine_TextSearch(t *testing.T) {
+	engine := newTestEngine(t, Config{})
+
+	results, total, err := engine.Search(context.Background(), "signing KEYS", nil, domain.SearchOptions{Mode: domain.SearchModeTextOnly, Limit: 10})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if total != 2 || len(results) != 2 {
+		t.Fatalf("expected 2 text matches, got %v", resultIDs(results))
+	}
+	if results[0].Chunk.ID != "c1" {
+		t.Errorf("expected chunk matching both terms first, got %v", resultIDs(results))
+	}
+	if results[0].Score <= results[1].Score {
+		t.Errorf("expected descending scores, got %f then %f", results[0].Score, results[1].Score)
+	}
+	if results[0].Chunk.Embedding != nil {
+		t.Error("expected embeddings to be stripped from results")
+	}
+}
+
+func TestSearchEngine_SemanticSearch(t *testing.T) {
+	engine := newTestEngine(t, Config{})
+
+	results, total, err := engine.Search(context.Background(), "anything", []float32{0, 0.2, 1}, domain.SearchOptions{Mode: domain.SearchModeSemanticOnly, Limit: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if total != 4 || len(results) != 2 {
+		t.Fatalf("expected all embedded chunks counted and 2 returned, got %d/%v", total, resultIDs(results))
+	}
+	if results[0].Chunk.ID != "c4" || results[1].Chunk.ID != "c3" {
+		t.Errorf("expected nearest neighbours first, got %v", resultIDs(results))
+	}
+
+	results, _, _ = engine.Search(context.Background(), "keys", nil, domain.SearchOptions{Mode: domain.SearchModeSemanticOnly})
+	if len(results) != 0 {
+		t.Errorf("expected no semantic results without an embedding, got %v", resultIDs(results))
+	}
+}
+
+func TestSearchEngine_HybridSearch(t *testing.T) {
+	engine := newTestEngine(t, Config{})
+
+	// "vault" only matches c2 by text, while the embedding is closest to c3
+	results, _, err := engine.Search(context.Background(), "vault", []float32{0, 1, 0}, domain.SearchOptions{Mode: domain.SearchModeHybrid, Limit: 10})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ids := resultIDs(results)
+	if len(ids) != 4 {
+		t.Fatalf("expected text matches merged with nearest neighbours, got %v", ids)
+	}
+	if ids[0] != "c2" && ids[0] != "c3" {
+		t.Errorf("expected the text match or nearest neighbour first, got %v", ids)
+	}
+
+	// Without an embedding hybrid falls back to text ranking
+	results, total, _ := engine.Search(context.Background(), "vault", nil, domain.SearchOptions{Mode: domain.SearchModeHybrid, Limit: 10})
+	if total != 1 || results[0].Chunk.ID != "c2" {
+		t.Errorf("expected text-only fallback, got %v", resultIDs(results))
+	}
+}
+
+func TestSearchEngine_Filters(t *testing.T) {
+	ctx := context.Background()
+	documents := mocks.NewMockDocumentStore()
+	_ = documents.Save(ctx, &domain.Document{ID: "d1", MimeType: "text/markdown"})
+	_ = documents.Save(ctx, &domain.Document{ID: "d2", MimeType: "application/pdf"})
+	_ = documents.Save(ctx, &domain.Document{ID: "d3", MimeType: "application/pdf; version=1.7"})
+
+	engine := newTestEngine(t, Config{Documents: documents})
+
+	results, total, err := engine.Search(ctx, "", nil, domain.SearchOptions{Mode: domain.SearchModeTextOnly, SourceIDs: []string{"s2"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if total != 2 {
+		t.Errorf("expected source filter to keep 2 chunks, got %v", resultIDs(results))
+	}
+
+	results, total, err = engine.Search(ctx, "", nil, domain.SearchOptions{
+		Mode:    domain.SearchModeTextOnly,
+		Filters: domain.Filters{MimeTypes: []string{"application/pdf"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if total != 2 {
+		t.Errorf("expected MIME filter to keep the PDF chunks, got %v", resultIDs(results))
+	}
+}
+
+func TestSearchEngine_Pagination(t *testing.T) {
+	engine := newTestEngine(t, Config{})
+
+	page1, total, _ := engine.Search(context.Background(), "", nil, domain.SearchOptions{Mode: domain.SearchModeTextOnly, Limit: 3})
+	page2, _, _ := engine.Search(context.Background(), "", nil, domain.SearchOptions{Mode: domain.SearchModeTextOnly, Limit: 3, Offset: 3})
+
+	if total != 4 || len(page1) != 3 || len(page2) != 1 {
+		t.Errorf("expected pages of 3 and 1 from 4, got %d/%d of %d", len(page1), len(page2), total)
+	}
+}
+
+func TestSearchEngine_Deletes(t *testing.T) {
+	ctx := context.Background()
+	engine := newTestEngine(t, Config{})
+
+	if err := engine.Delete(ctx, []string{"c1"}); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if results, _, _ := engine.Search(ctx, "signing", nil, domain.SearchOptions{Mode: domain.SearchModeTextOnly}); len(results) != 0 {
+		t.Errorf("expected deleted chunk to be gone from postings, got %v", resultIDs(results))
+	}
+
+	_ = engine.DeleteByDocument(ctx, "d1")
+	_ = engine.DeleteBySource(ctx, "s2")
+
+	count, _ := engine.Count(ctx)
+	if count != 0 {
+		t.Errorf("expected empty index, got %d chunks", count)
+	}
+}
+
+func TestSearchEngine_Reindex(t *testing.T) {
+	ctx := context.Background()
+	engine := newTestEngine(t, Config{})
+
+	_ = engine.Index(ctx, []*domain.Chunk{{ID: "c1", DocumentID: "d1", SourceID: "s1", Content: "Completely different text"}})
+
+	if results, _, _ := engine.Search(ctx, "signing", nil, domain.SearchOptions{Mode: domain.SearchModeTextOnly}); len(results) != 0 {
+		t.Errorf("expected old content to be unindexed, got %v", resultIDs(results))
+	}
+	if count, _ := engine.Count(ctx); count != 4 {
+		t.Errorf("expected reindex to replace the chunk, got %d chunks", count)
+	}
+}
+
+func TestSearchEngine_Persistence(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	engine, err := NewSearchEngine(DefaultConfig(dir))
+	if err != nil {
+		t.Fatalf("failed to create engine: %v", err)
+	}
+	_ = engine.Index(ctx, testChunks())
+	_ = engine.DeleteBySource(ctx, "s2")
+
+	// Reopen from the log alone, without a clean Close
+	_ = engine.storage.close()
+	reopened, err := NewSearchEngine(DefaultConfig(dir))
+	if err != nil {
+		t.Fatalf("failed to reopen from log: %v", err)
+	}
+	if count, _ := reopened.Count(ctx); count != 2 {
+		t.Errorf("expected 2 chunks after log replay, got %d", count)
+	}
+
+	// Close compacts into a snapshot
+	if err := reopened.Close(); err != nil {
+		t.Fatalf("close failed: %v", err)
+	}
+	if info, err := os.Stat(filepath.Join(dir, walFile)); err != nil || info.Size() != 0 {
+		t.Errorf("expected empty log after close, got %v %v", info, err)
+	}
+
+	final := newTestEngine(t, DefaultConfig(dir))
+	results, _, _ := final.Search(ctx, "vault", []float32{1, 0, 0}, domain.SearchOptions{Mode: domain.SearchModeHybrid})
+	if len(results) == 0 || results[0].Chunk.ID != "c2" && results[0].Chunk.ID != "c1" {
+		t.Errorf("expected persisted chunks and embeddings to be searchable, got %v", resultIDs(results))
+	}
+}
+
+func TestSearchEngine_TornLogTail(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	engine, _ := NewSearchEngine(DefaultConfig(dir))
+	_ = engine.Index(ctx, testChunks()[:1])
+	_ = engine.Index(ctx, testChunks()[1:2])
+	_ = engine.storage.close()
+
+	// Simulate a crash part-way through writing the last record
+	path := filepath.Join(dir, walFile)
+	info, _ := os.Stat(path)
+	if err := os.Truncate(path, info.Size()-3); err != nil {
+		t.Fatalf("truncate: %v", err)
+	}
+
+	reopened, err := NewSearchEngine(DefaultConfig(dir))
+	if err != nil {
+		t.Fatalf("expected torn tail to be tolerated, got %v", err)
+	}
+	defer reopened.Close()
+
+	if count, _ := reopened.Count(ctx); count != 1 {
+		t.Errorf("expected only the intact record to be replayed, got %d chunks", count)
+	}
+
+	// New writes append after the discarded tail
+	_ = reopened.Index(ctx, testChunks()[2:3])
+	_ = reopened.storage.close()
+	again, _ := NewSearchEngine(DefaultConfig(dir))
+	defer again.Close()
+	if count, _ := again.Count(ctx); count != 2 {
+		t.Errorf("expected appended record after recovery, got %d chunks", count)
+	}
+}
+
+func TestSearchEngine_Closed(t *testing.T) {
+	engine, _ := NewSearchEngine(Config{})
+	_ = engine.Close()
+
+	if err := engine.HealthCheck(context.Background()); !errors.Is(err, ErrClosed) {
+		t.Errorf("expected ErrClosed, got %v", err)
+	}
+	if err := engine.Index(context.Background(), testChunks()); !errors.Is(err, ErrClosed) {
+		t.Errorf("expected ErrClosed on index, got %v", err)
+	}
+}
@@ -0,0 +1,292 @@
+package embedded
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+)
+
+// On-disk layout: a snapshot of all chunks plus a write-ahead log of the
+// mutations made since. The log is folded into a new snapshot once it
+// outgrows the snapshot, and on Close.
+const (
+	snapshotFile = "snapshot.gob"
+	walFile      = "wal.log"
+
+	// snapshotVersion is bumped when the snapshot encoding changes
+	snapshotVersion = 1
+
+	// minCompactBytes avoids rewriting small snapshots on every mutation
+	minCompactBytes = 4 << 20
+
+	// maxRecordBytes rejects corrupt length prefixes
+	maxRecordBytes = 1 << 30
+)
+
+// Log operation kinds
+const (
+	opIndex          = "index"
+	opDelete         = "delete"
+	opDeleteDocument = "delete_document"
+	opDeleteSource   = "delete_source"
+)
+
+// walOp is a single logged mutation
+type walOp struct {
+	Kind   string
+	Chunks []*domain.Chunk // opIndex
+	IDs    []string        // Chunk, document or source IDs for deletes
+}
+
+// apply replays the operation against an index
+func (op *walOp) apply(ix *index) {
+	switch op.Kind {
+	case opIndex:
+		for _, c := range op.Chunks {
+			ix.add(c)
+		}
+	case opDelete:
+		for _, id := range op.IDs {
+			ix.remove(id)
+		}
+	case opDeleteDocument:
+		for _, id := range op.IDs {
+			ix.removeDocument(id)
+		}
+	case opDeleteSource:
+		for _, id := range op.IDs {
+			ix.removeSource(id)
+		}
+	}
+}
+
+// snapshotData is the gob-encoded snapshot file
+type snapshotData struct {
+	Version int
+	Chunks  []*domain.Chunk
+}
+
+// storage persists the index to a directory
+type storage struct {
+	dir          string
+	wal          *os.File
+	walSize      int64
+	snapshotSize int64
+}
+
+// openStorage loads the index from dir, creating the directory if needed.
+// A truncated or corrupt tail of the log (from a crash mid-write) is
+// discarded; everything before it is kept.
+func openStorage(dir string, ix *index) (*storage, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create index directory: %w", err)
+	}
+	s := &storage{dir: dir}
+
+	if err := s.loadSnapshot(ix); err != nil {
+		return nil, err
+	}
+
+	wal, err := os.OpenFile(filepath.Join(dir, walFile), os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("open write-ahead log: %w", err)
+	}
+
+	valid, err := replayLog(wal, ix)
+	if err != nil {
+		wal.Close()
+		return nil, err
+	}
+	if err := wal.Truncate(valid); err != nil {
+		wal.Close()
+		return nil, fmt.Errorf("truncate write-ahead log: %w", err)
+	}
+	if _, err := wal.Seek(valid, io.SeekStart); err != nil {
+		wal.Close()
+		return nil, fmt.Errorf("seek write-ahead log: %w", err)
+	}
+
+	s.wal = wal
+	s.walSize = valid
+	return s, nil
+}
+
+func (s *storage) loadSnapshot(ix *index) error {
+	f, err := os.Open(filepath.Join(s.dir, snapshotFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("open snapshot: %w", err)
+	}
+	defer f.Close()
+
+	var data snapshotData
+	if err := gob.NewDecoder(bufio.NewReader(f)).Decode(&data); err != nil {
+		return fmt.Errorf("decode snapshot: %w", err)
+	}
+	if data.Version != snapshotVersion {
+		return fmt.Errorf("unsupported snapshot version %d", data.Version)
+	}
+
+	for _, c := range data.Chunks {
+		ix.add(c)
+	}
+
+	if info, err := f.Stat(); err == nil {
+		s.snapshotSize = info.Size()
+	}
+	return nil
+}
+
+// replayLog applies every intact record and returns the offset after the last one
+func replayLog(r io.Reader, ix *index) (int64, error) {
+	br := bufio.NewReader(r)
+	var offset int64
+
+	for {
+		op, n, err := readRecord(br)
+		if errors.Is(err, io.EOF) || errors.Is(err, errCorruptRecord) {
+			return offset, nil
+		}
+		if err != nil {
+			return 0, fmt.Errorf("replay write-ahead log: %w", err)
+		}
+		op.apply(ix)
+		offset += n
+	}
+}
+
+var errCorruptRecord = errors.New("corrupt log record")
+
+// Records are framed as: payload length (uint32), CRC-32 of payload, payload.
+// Each payload is a self-contained gob stream so records can be appended
+// across restarts.
+func readRecord(r io.Reader) (*walOp, int64, error) {
+	var header [8]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, 0, errCorruptRecord
+		}
+		return nil, 0, err
+	}
+
+	size := binary.LittleEndian.Uint32(header[0:4])
+	sum := binary.LittleEndian.Uint32(header[4:8])
+	if size > maxRecordBytes {
+		return nil, 0, errCorruptRecord
+	}
+
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return nil, 0, errCorruptRecord
+	}
+	if crc32.ChecksumIEEE(payload) != sum {
+		return nil, 0, errCorruptRecord
+	}
+
+	var op walOp
+	if err := gob.NewDecoder(bytes.NewReader(payload)).Decode(&op); err != nil {
+		return nil, 0, errCorruptRecord
+	}
+	return &op, int64(len(header)) + int64(size), nil
+}
+
+// append durably writes an operation to the log
+func (s *storage) append(op *walOp) error {
+	var payload bytes.Buffer
+	if err := gob.NewEncoder(&payload).Encode(op); err != nil {
+		return fmt.Errorf("encode log record: %w", err)
+	}
+
+	record := make([]byte, 8, 8+payload.Len())
+	binary.LittleEndian.PutUint32(record[0:4], uint32(payload.Len()))
+	binary.LittleEndian.PutUint32(record[4:8], crc32.ChecksumIEEE(payload.Bytes()))
+	record = append(record, payload.Bytes()...)
+
+	if _, err := s.wal.Write(record); err != nil {
+		return fmt.Errorf("write log record: %w", err)
+	}
+	if err := s.wal.Sync(); err != nil {
+		return fmt.Errorf("sync write-ahead log: %w", err)
+	}
+	s.walSize += int64(len(record))
+	return nil
+}
+
+// shouldCompact reports whether the log has outgrown the snapshot
+func (s *storage) shouldCompact() bool {
+	return s.walSize > max(s.snapshotSize, minCompactBytes)
+}
+
+// compact writes a new snapshot of ix and empties the log. The snapshot is
+// written to a temporary file and renamed. A crash between the rename and
+// the truncation replays the log over the new snapshot, which is harmless
+// because every operation sets or deletes chunks outright.
+func (s *storage) compact(ix *index) error {
+	tmp, err := os.CreateTemp(s.dir, snapshotFile+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("create snapshot: %w", err)
+	}
+	defer os.Remove(tmp.Name()) // No-op after a successful rename
+
+	w := bufio.NewWriter(tmp)
+	if err := gob.NewEncoder(w).Encode(snapshotData{Version: snapshotVersion, Chunks: ix.snapshot()}); err != nil {
+		tmp.Close()
+		return fmt.Errorf("encode snapshot: %w", err)
+	}
+	if err := w.Flush(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("write snapshot: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("sync snapshot: %w", err)
+	}
+	info, err := tmp.Stat()
+	if err != nil {
+		tmp.Close()
+		return fmt.Errorf("stat snapshot: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close snapshot: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), filepath.Join(s.dir, snapshotFile)); err != nil {
+		return fmt.Errorf("replace snapshot: %w", err)
+	}
+	syncDir(s.dir)
+
+	if err := s.wal.Truncate(0); err != nil {
+		return fmt.Errorf("truncate write-ahead log: %w", err)
+	}
+	if _, err := s.wal.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("seek write-ahead log: %w", err)
+	}
+
+	s.snapshotSize = info.Size()
+	s.walSize = 0
+	return nil
+}
+
+// syncDir flushes a directory entry update; failures are ignored as not
+// every platform supports it
+func syncDir(dir string) {
+	if d, err := os.Open(dir); err == nil {
+		_ = d.Sync()
+		d.Close()
+	}
+}
+
+func (s *storage) close() error {
+	return s.wal.Close()
+}
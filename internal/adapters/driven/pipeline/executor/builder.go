@@ -90,7 +90,7 @@ func (b *PipelineBuilder) Validate(def pipeline.PipelineDefinition) error {
 		prev := enabledStages[i-1]
 		curr := enabledStages[i]
 
-		if prev.OutputShape != curr.InputShape {
+		if !prev.OutputShape.Satisfies(curr.InputShape) {
 			return fmt.Errorf("shape mismatch between stages %d and %d: %s -> %s",
 				i-1, i, prev.OutputShape, curr.InputShape)
 		}
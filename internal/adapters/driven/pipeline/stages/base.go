@@ -67,6 +67,7 @@ func RegisterAll(registry pipelineport.StageRegistry) error {
 
 		// Search stages
 		search.NewQueryParserFactory(),
+		search.NewQueryExpanderFactory(),
 		search.NewBM25RetrieverFactory(),
 		search.NewVectorRetrieverFactory(),
 		search.NewHybridRetrieverFactory(),
@@ -0,0 +1,184 @@
+package search
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-core/internal/core/domain/pipeline"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+	pipelineport "github.com/custodia-labs/sercha-core/internal/core/ports/driven/pipeline"
+)
+
+const (
+	QueryExpanderStageID = "query-expander"
+
+	// DefaultExpansionWeight is the weight given to the strongest expansion term.
+	DefaultExpansionWeight = 0.5
+
+	// DefaultMaxExpansions caps how many alternative terms are kept.
+	DefaultMaxExpansions = 5
+
+	// DefaultExpansionTimeout bounds the LLM call so expansion never stalls search.
+	DefaultExpansionTimeout = 2 * time.Second
+)
+
+// QueryExpanderFactory creates query expander stages.
+type QueryExpanderFactory struct {
+	descriptor pipeline.StageDescriptor
+}
+
+// NewQueryExpanderFactory creates a new query expander factory.
+func NewQueryExpanderFactory() *QueryExpanderFactory {
+	return &QueryExpanderFactory{
+		descriptor: pipeline.StageDescriptor{
+			ID:          QueryExpanderStageID,
+			Name:        "LLM Query Expander",
+			Type:        pipeline.StageTypeExpander,
+			InputShape:  pipeline.ShapeParsedQuery,
+			OutputShape: pipeline.ShapeExpandedQuery,
+			Cardinality: pipeline.CardinalityOneToOne,
+			Capabilities: []pipeline.CapabilityRequirement{
+				{Type: pipeline.CapabilityLLM, Mode: pipeline.CapabilityOptional},
+			},
+			Version: "1.0.0",
+		},
+	}
+}
+
+func (f *QueryExpanderFactory) StageID() string                      { return f.descriptor.ID }
+func (f *QueryExpanderFactory) Descriptor() pipeline.StageDescriptor { return f.descriptor }
+
+// Validate validates the stage configuration.
+func (f *QueryExpanderFactory) Validate(config pipeline.StageConfig) error {
+	if w, ok := numberParam(config.Parameters, "weight"); ok && (w <= 0 || w > 1) {
+		return &StageError{Stage: f.descriptor.ID, Message: "weight must be in (0, 1]"}
+	}
+	return nil
+}
+
+// Create creates a new query expander stage.
+// The LLM capability is optional: without it the stage passes queries through unchanged.
+func (f *QueryExpanderFactory) Create(config pipeline.StageConfig, capabilities *pipeline.CapabilitySet) (pipelineport.Stage, error) {
+	var llm driven.LLMService
+	if capabilities != nil {
+		if inst, ok := capabilities.Get(pipeline.CapabilityLLM); ok {
+			llm, _ = inst.Instance.(driven.LLMService)
+		}
+	}
+
+	maxTerms := DefaultMaxExpansions
+	if m, ok := numberParam(config.Parameters, "max_terms"); ok {
+		maxTerms = int(m)
+	}
+
+	weight := DefaultExpansionWeight
+	if w, ok := numberParam(config.Parameters, "weight"); ok {
+		weight = w
+	}
+
+	timeout := DefaultExpansionTimeout
+	if t, ok := numberParam(config.Parameters, "timeout_ms"); ok {
+		timeout = time.Duration(t) * time.Millisecond
+	}
+
+	return &QueryExpanderStage{
+		descriptor: f.descriptor,
+		llm:        llm,
+		maxTerms:   maxTerms,
+		weight:     weight,
+		timeout:    timeout,
+	}, nil
+}
+
+// QueryExpanderStage adds LLM-generated alternative terms to a parsed query.
+type QueryExpanderStage struct {
+	descriptor pipeline.StageDescriptor
+	llm        driven.LLMService // nil when no LLM is configured
+	maxTerms   int
+	weight     float64
+	timeout    time.Duration
+}
+
+func (s *QueryExpanderStage) Descriptor() pipeline.StageDescriptor { return s.descriptor }
+
+// Process expands the parsed query. Expansion failures degrade to a
+// pass-through so that search keeps working when the LLM is slow or down.
+func (s *QueryExpanderStage) Process(ctx context.Context, input any) (any, error) {
+	parsed, ok := input.(*pipeline.ParsedQuery)
+	if !ok {
+		return nil, &StageError{Stage: s.descriptor.ID, Message: "expected *pipeline.ParsedQuery"}
+	}
+
+	expanded := &pipeline.ExpandedQuery{ParsedQuery: *parsed}
+
+	if s.llm == nil || s.maxTerms <= 0 || strings.TrimSpace(parsed.Original) == "" {
+		return expanded, nil
+	}
+
+	expandCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	terms, err := s.llm.ExpandQuery(expandCtx, parsed.Original)
+	if err != nil {
+		return expanded, nil
+	}
+
+	expanded.Expansions = s.weightTerms(parsed, terms)
+	return expanded, nil
+}
+
+// weightTerms drops terms already in the query and assigns weights that
+// decay linearly from s.weight down to half of it, in LLM output order.
+func (s *QueryExpanderStage) weightTerms(parsed *pipeline.ParsedQuery, terms []string) []pipeline.WeightedTerm {
+	seen := make(map[string]bool, len(parsed.Terms)+len(parsed.Phrases))
+	for _, t := range parsed.Terms {
+		seen[strings.ToLower(t)] = true
+	}
+	for _, p := range parsed.Phrases {
+		seen[strings.ToLower(p)] = true
+	}
+	seen[strings.ToLower(parsed.Original)] = true
+
+	var kept []string
+	for _, term := range terms {
+		term = strings.TrimSpace(term)
+		key := strings.ToLower(term)
+		if term == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		kept = append(kept, term)
+		if len(kept) >= s.maxTerms {
+			break
+		}
+	}
+
+	weighted := make([]pipeline.WeightedTerm, len(kept))
+	for i, term := range kept {
+		weighted[i] = pipeline.WeightedTerm{
+			Term:   term,
+			Weight: s.weight * (1 - 0.5*float64(i)/float64(len(kept))),
+		}
+	}
+	return weighted
+}
+
+// numberParam reads a numeric stage parameter. Definitions built in Go carry
+// ints while JSON-decoded definitions carry float64, so both are accepted.
+func numberParam(params map[string]any, key string) (float64, bool) {
+	switch v := params[key].(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
+
+// Interface assertions
+var (
+	_ pipelineport.StageFactory = (*QueryExpanderFactory)(nil)
+	_ pipelineport.Stage        = (*QueryExpanderStage)(nil)
+)
@@ -0,0 +1,205 @@
+package search
+
+import (
+	"context"
+	"testing"
+
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/domain/pipeline"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven/mocks"
+)
+
+func llmCapabilities(llm *mocks.MockLLMService) *pipeline.CapabilitySet {
+	cs := pipeline.NewCapabilitySet()
+	cs.Add(pipeline.CapabilityLLM, "mock", llm)
+	return cs
+}
+
+func TestQueryExpanderFactory_Descriptor(t *testing.T) {
+	factory := NewQueryExpanderFactory()
+
+	desc := factory.Descriptor()
+	if desc.Type != pipeline.StageTypeExpander {
+		t.Errorf("expected type Expander, got %s", desc.Type)
+	}
+	if desc.InputShape != pipeline.ShapeParsedQuery || desc.OutputShape != pipeline.ShapeExpandedQuery {
+		t.Errorf("unexpected shapes %s -> %s", desc.InputShape, desc.OutputShape)
+	}
+	if desc.RequiresCapability(pipeline.CapabilityLLM) {
+		t.Error("expected LLM capability to be optional")
+	}
+}
+
+func TestQueryExpanderFactory_Validate(t *testing.T) {
+	factory := NewQueryExpanderFactory()
+
+	if err := factory.Validate(pipeline.StageConfig{Parameters: map[string]any{"weight": 1.5}}); err == nil {
+		t.Error("expected error for weight above 1")
+	}
+	if err := factory.Validate(pipeline.StageConfig{Parameters: map[string]any{"weight": 0.3}}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestQueryExpanderStage_PassThroughWithoutLLM(t *testing.T) {
+	stage, err := NewQueryExpanderFactory().Create(pipeline.StageConfig{}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	parsed := &pipeline.ParsedQuery{Original: "vacation policy", Terms: []string{"vacation", "policy"}}
+	out, err := stage.Process(context.Background(), parsed)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expanded, ok := out.(*pipeline.ExpandedQuery)
+	if !ok {
+		t.Fatalf("expected *pipeline.ExpandedQuery, got %T", out)
+	}
+	if expanded.Original != "vacation policy" || len(expanded.Terms) != 2 {
+		t.Errorf("expected parsed query to be carried through, got %+v", expanded.ParsedQuery)
+	}
+	if len(expanded.Expansions) != 0 {
+		t.Errorf("expected no expansions, got %v", expanded.Expansions)
+	}
+}
+
+func TestQueryExpanderStage_ExpandsWithWeights(t *testing.T) {
+	llm := mocks.NewMockLLMService()
+	llm.SetExpansions("vacation policy", []string{"pto", "Vacation", "leave policy", "", "holiday"})
+
+	stage, err := NewQueryExpanderFactory().Create(pipeline.StageConfig{
+		Parameters: map[string]any{"max_terms": 2, "weight": 0.8},
+	}, llmCapabilities(llm))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out, err := stage.Process(context.Background(), &pipeline.ParsedQuery{
+		Original: "vacation policy",
+		Terms:    []string{"vacation", "policy"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expanded := out.(*pipeline.ExpandedQuery)
+	if len(expanded.Expansions) != 2 {
+		t.Fatalf("expected 2 expansions, got %v", expanded.Expansions)
+	}
+	if expanded.Expansions[0].Term != "pto" || expanded.Expansions[1].Term != "leave policy" {
+		t.Errorf("expected duplicates of query terms to be dropped, got %v", expanded.Expansions)
+	}
+	if expanded.Expansions[0].Weight != 0.8 {
+		t.Errorf("expected first weight 0.8, got %f", expanded.Expansions[0].Weight)
+	}
+	if expanded.Expansions[1].Weight >= expanded.Expansions[0].Weight {
+		t.Error("expected weights to decay")
+	}
+}
+
+func TestQueryExpanderStage_DegradesOnLLMError(t *testing.T) {
+	llm := mocks.NewMockLLMService()
+	llm.SetUnavailable()
+
+	stage, _ := NewQueryExpanderFactory().Create(pipeline.StageConfig{}, llmCapabilities(llm))
+
+	out, err := stage.Process(context.Background(), &pipeline.ParsedQuery{Original: "q"})
+	if err != nil {
+		t.Fatalf("expected LLM failure to be swallowed, got %v", err)
+	}
+	if len(out.(*pipeline.ExpandedQuery).Expansions) != 0 {
+		t.Error("expected no expansions on LLM failure")
+	}
+}
+
+func TestQueryExpanderStage_InvalidInput(t *testing.T) {
+	stage, _ := NewQueryExpanderFactory().Create(pipeline.StageConfig{}, nil)
+
+	if _, err := stage.Process(context.Background(), "raw string"); err == nil {
+		t.Error("expected error for invalid input type")
+	}
+}
+
+func TestBM25Retriever_UsesExpansions(t *testing.T) {
+	engine := mocks.NewMockSearchEngine()
+	_ = engine.Index(context.Background(), []*domain.Chunk{
+		{ID: "c1", DocumentID: "d1", SourceID: "s1", Content: "vacation policy for staff"},
+		{ID: "c2", DocumentID: "d2", SourceID: "s1", Content: "pto requests go to HR"},
+	})
+
+	cs := pipeline.NewCapabilitySet()
+	cs.Add(pipeline.CapabilityVectorStore, "mock", engine)
+
+	stage, err := NewBM25RetrieverFactory().Create(pipeline.StageConfig{}, cs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out, err := stage.Process(context.Background(), &pipeline.ExpandedQuery{
+		ParsedQuery: pipeline.ParsedQuery{Original: "vacation", Terms: []string{"vacation"}},
+		Expansions:  []pipeline.WeightedTerm{{Term: "pto", Weight: 0.5}, {Term: "vacation", Weight: 0.4}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	candidates := out.([]*pipeline.Candidate)
+	if len(candidates) != 2 {
+		t.Fatalf("expected 2 merged candidates, got %d", len(candidates))
+	}
+
+	scores := map[string]float64{}
+	for _, c := range candidates {
+		scores[c.ChunkID] = c.Score
+	}
+	if scores["c1"] != 1.0 {
+		t.Errorf("expected primary match to keep its full score, got %f", scores["c1"])
+	}
+	if scores["c2"] != 0.5 {
+		t.Errorf("expected expansion match to be weighted, got %f", scores["c2"])
+	}
+}
+
+func TestBM25Retriever_ExpansionsKeepScope(t *testing.T) {
+	engine := mocks.NewMockSearchEngine()
+	_ = engine.Index(context.Background(), []*domain.Chunk{
+		{ID: "c1", DocumentID: "d1", SourceID: "s1", Content: "pto requests go to HR"},
+		{ID: "c2", DocumentID: "d2", SourceID: "s2", Content: "pto balance report"},
+	})
+
+	cs := pipeline.NewCapabilitySet()
+	cs.Add(pipeline.CapabilityVectorStore, "mock", engine)
+
+	stage, err := NewBM25RetrieverFactory().Create(pipeline.StageConfig{}, cs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out, err := stage.Process(context.Background(), &pipeline.ExpandedQuery{
+		ParsedQuery: pipeline.ParsedQuery{
+			Original: "vacation",
+			Terms:    []string{"vacation"},
+			Scope:    pipeline.SearchFilters{Sources: []string{"s1"}},
+		},
+		Expansions: []pipeline.WeightedTerm{{Term: "pto", Weight: 0.5}, {Term: "balance", Weight: 0.4}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	candidates := out.([]*pipeline.Candidate)
+	if len(candidates) != 1 || candidates[0].ChunkID != "c1" {
+		t.Errorf("expected expansions restricted to the source filter, got %d candidates", len(candidates))
+	}
+}
+
+func TestShapeSatisfies(t *testing.T) {
+	if !pipeline.ShapeExpandedQuery.Satisfies(pipeline.ShapeParsedQuery) {
+		t.Error("expected expanded query to satisfy parsed query")
+	}
+	if pipeline.ShapeParsedQuery.Satisfies(pipeline.ShapeExpandedQuery) {
+		t.Error("expected parsed query not to satisfy expanded query")
+	}
+}
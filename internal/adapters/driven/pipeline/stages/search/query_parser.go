@@ -70,6 +70,7 @@ func (s *QueryParserStage) Process(ctx context.Context, input any) (any, error)
 	}
 
 	parsed := s.parseQuery(searchInput.Query)
+	parsed.Scope = searchInput.Filters
 
 	return parsed, nil
 }
@@ -3,6 +3,7 @@ package search
 import (
 	"context"
 	"strings"
+	"sync"
 
 	"github.com/custodia-labs/sercha-core/internal/core/domain"
 	"github.com/custodia-labs/sercha-core/internal/core/domain/pipeline"
@@ -77,9 +78,9 @@ type BM25RetrieverStage struct {
 func (s *BM25RetrieverStage) Descriptor() pipeline.StageDescriptor { return s.descriptor }
 
 func (s *BM25RetrieverStage) Process(ctx context.Context, input any) (any, error) {
-	parsed, ok := input.(*pipeline.ParsedQuery)
+	parsed, expansions, ok := queryInput(input)
 	if !ok {
-		return nil, &StageError{Stage: s.descriptor.ID, Message: "expected *pipeline.ParsedQuery"}
+		return nil, &StageError{Stage: s.descriptor.ID, Message: "expected *pipeline.ParsedQuery or *pipeline.ExpandedQuery"}
 	}
 
 	// Build query string from terms and phrases
@@ -88,10 +89,7 @@ func (s *BM25RetrieverStage) Process(ctx context.Context, input any) (any, error
 		queryStr += " " + strings.Join(parsed.Phrases, " ")
 	}
 
-	opts := domain.SearchOptions{
-		Limit: s.topK,
-		Mode:  domain.SearchModeTextOnly,
-	}
+	opts := searchOptions(parsed.Scope, domain.SearchModeTextOnly, s.topK)
 
 	results, _, err := s.searchEngine.Search(ctx, queryStr, nil, opts)
 	if err != nil {
@@ -99,7 +97,7 @@ func (s *BM25RetrieverStage) Process(ctx context.Context, input any) (any, error
 	}
 
 	candidates := convertToCandidates(results, "bm25")
-	return candidates, nil
+	return mergeCandidates(candidates, searchExpansions(ctx, s.searchEngine, expansions, searchOptions(parsed.Scope, domain.SearchModeTextOnly, s.topK))), nil
 }
 
 // VectorRetrieverFactory creates vector retriever stages.
@@ -173,9 +171,9 @@ type VectorRetrieverStage struct {
 func (s *VectorRetrieverStage) Descriptor() pipeline.StageDescriptor { return s.descriptor }
 
 func (s *VectorRetrieverStage) Process(ctx context.Context, input any) (any, error) {
-	parsed, ok := input.(*pipeline.ParsedQuery)
+	parsed, _, ok := queryInput(input)
 	if !ok {
-		return nil, &StageError{Stage: s.descriptor.ID, Message: "expected *pipeline.ParsedQuery"}
+		return nil, &StageError{Stage: s.descriptor.ID, Message: "expected *pipeline.ParsedQuery or *pipeline.ExpandedQuery"}
 	}
 
 	// Generate query embedding
@@ -184,10 +182,7 @@ func (s *VectorRetrieverStage) Process(ctx context.Context, input any) (any, err
 		return nil, &StageError{Stage: s.descriptor.ID, Message: "embedding failed", Err: err}
 	}
 
-	opts := domain.SearchOptions{
-		Limit: s.topK,
-		Mode:  domain.SearchModeSemanticOnly,
-	}
+	opts := searchOptions(parsed.Scope, domain.SearchModeSemanticOnly, s.topK)
 
 	results, _, err := s.searchEngine.Search(ctx, parsed.Original, queryEmbedding, opts)
 	if err != nil {
@@ -276,9 +271,9 @@ type HybridRetrieverStage struct {
 func (s *HybridRetrieverStage) Descriptor() pipeline.StageDescriptor { return s.descriptor }
 
 func (s *HybridRetrieverStage) Process(ctx context.Context, input any) (any, error) {
-	parsed, ok := input.(*pipeline.ParsedQuery)
+	parsed, expansions, ok := queryInput(input)
 	if !ok {
-		return nil, &StageError{Stage: s.descriptor.ID, Message: "expected *pipeline.ParsedQuery"}
+		return nil, &StageError{Stage: s.descriptor.ID, Message: "expected *pipeline.ParsedQuery or *pipeline.ExpandedQuery"}
 	}
 
 	// Generate query embedding
@@ -287,10 +282,7 @@ func (s *HybridRetrieverStage) Process(ctx context.Context, input any) (any, err
 		return nil, &StageError{Stage: s.descriptor.ID, Message: "embedding failed", Err: err}
 	}
 
-	opts := domain.SearchOptions{
-		Limit: s.topK,
-		Mode:  domain.SearchModeHybrid,
-	}
+	opts := searchOptions(parsed.Scope, domain.SearchModeHybrid, s.topK)
 
 	results, _, err := s.searchEngine.Search(ctx, parsed.Original, queryEmbedding, opts)
 	if err != nil {
@@ -298,7 +290,97 @@ func (s *HybridRetrieverStage) Process(ctx context.Context, input any) (any, err
 	}
 
 	candidates := convertToCandidates(results, "hybrid")
-	return candidates, nil
+	return mergeCandidates(candidates, searchExpansions(ctx, s.searchEngine, expansions, searchOptions(parsed.Scope, domain.SearchModeTextOnly, s.topK))), nil
+}
+
+// queryInput extracts the parsed query and any weighted expansions from
+// retriever input, which is either a parsed or an expanded query.
+func queryInput(input any) (*pipeline.ParsedQuery, []pipeline.WeightedTerm, bool) {
+	switch q := input.(type) {
+	case *pipeline.ParsedQuery:
+		return q, nil, true
+	case *pipeline.ExpandedQuery:
+		return &q.ParsedQuery, q.Expansions, true
+	default:
+		return nil, nil, false
+	}
+}
+
+// searchOptions returns the options of a retriever search, restricted by
+// the caller's filters.
+func searchOptions(scope pipeline.SearchFilters, mode domain.SearchMode, topK int) domain.SearchOptions {
+	opts := domain.SearchOptions{
+		Limit:     topK,
+		Mode:      mode,
+		SourceIDs: scope.Sources,
+		Filters:   domain.Filters{MimeTypes: scope.ContentTypes},
+	}
+	if scope.DateRange != nil {
+		opts.Filters.DateAfter = scope.DateRange.From
+		opts.Filters.DateBefore = scope.DateRange.To
+	}
+	return opts
+}
+
+// searchExpansions runs a search with opts per expansion term, concurrently,
+// and scales each result's score by the term's weight. Expansion is
+// best-effort, so failed lookups are skipped rather than failing the
+// retriever.
+func searchExpansions(ctx context.Context, searchEngine driven.SearchEngine, expansions []pipeline.WeightedTerm, opts domain.SearchOptions) []*pipeline.Candidate {
+	found := make([][]*pipeline.Candidate, len(expansions))
+	var wg sync.WaitGroup
+	for i, exp := range expansions {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results, _, err := searchEngine.Search(ctx, exp.Term, nil, opts)
+			if err != nil {
+				return
+			}
+			found[i] = convertToCandidates(results, "expansion")
+			for _, c := range found[i] {
+				c.Score *= exp.Weight
+				c.Metadata["expansion_term"] = exp.Term
+			}
+		}()
+	}
+	wg.Wait()
+
+	var candidates []*pipeline.Candidate
+	for _, set := range found {
+		candidates = append(candidates, set...)
+	}
+	return candidates
+}
+
+// mergeCandidates adds expansion candidates to the primary set, keeping the
+// highest score when the same chunk is found by several queries.
+func mergeCandidates(primary, extra []*pipeline.Candidate) []*pipeline.Candidate {
+	if len(extra) == 0 {
+		return primary
+	}
+
+	index := make(map[string]int, len(primary)+len(extra))
+	merged := make([]*pipeline.Candidate, 0, len(primary)+len(extra))
+
+	for _, set := range [][]*pipeline.Candidate{primary, extra} {
+		for _, c := range set {
+			key := c.ChunkID
+			if key == "" {
+				key = c.DocumentID
+			}
+			if i, ok := index[key]; ok {
+				if c.Score > merged[i].Score {
+					merged[i] = c
+				}
+				continue
+			}
+			index[key] = len(merged)
+			merged = append(merged, c)
+		}
+	}
+
+	return merged
 }
 
 // convertToCandidates converts ranked chunks to pipeline candidates.
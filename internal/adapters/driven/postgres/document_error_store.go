@@ -0,0 +1,119 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Verify interface compliance
+var _ driven.DocumentErrorStore = (*DocumentErrorStore)(nil)
+
+// DocumentErrorStore implements driven.DocumentErrorStore using PostgreSQL
+type DocumentErrorStore struct {
+	db *DB
+}
+
+// NewDocumentErrorStore creates a new DocumentErrorStore
+func NewDocumentErrorStore(db *DB) *DocumentErrorStore {
+	return &DocumentErrorStore{db: db}
+}
+
+// Save records a failed document, incrementing the attempts of a known failure
+func (s *DocumentErrorStore) Save(ctx context.Context, docErr *domain.DocumentError) error {
+	query := `
+		INSERT INTO document_errors (source_id, external_id, container_id, stage, error, attempts, first_failed_at, last_failed_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (source_id, external_id) DO UPDATE SET
+			container_id = EXCLUDED.container_id,
+			stage = EXCLUDED.stage,
+			error = EXCLUDED.error,
+			attempts = document_errors.attempts + 1,
+			last_failed_at = EXCLUDED.last_failed_at
+	`
+
+	_, err := s.db.ExecContext(ctx, query,
+		docErr.SourceID,
+		docErr.ExternalID,
+		docErr.ContainerID,
+		string(docErr.Stage),
+		docErr.Error,
+		docErr.Attempts,
+		docErr.FirstFailedAt,
+		docErr.LastFailedAt,
+	)
+	return err
+}
+
+// Delete removes a document's error
+func (s *DocumentErrorStore) Delete(ctx context.Context, sourceID, externalID string) error {
+	query := `DELETE FROM document_errors WHERE source_id = $1 AND external_id = $2`
+	_, err := s.db.ExecContext(ctx, query, sourceID, externalID)
+	return err
+}
+
+// List retrieves a page of a source's failed documents, most recently failed first
+func (s *DocumentErrorStore) List(ctx context.Context, sourceID string, limit, offset int) ([]*domain.DocumentError, int, error) {
+	var total int
+	if err := s.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM document_errors WHERE source_id = $1`, sourceID).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	docErrs, err := s.list(ctx, `
+		SELECT source_id, external_id, container_id, stage, error, attempts, first_failed_at, last_failed_at
+		FROM document_errors
+		WHERE source_id = $1
+		ORDER BY last_failed_at DESC, external_id
+		LIMIT $2 OFFSET $3
+	`, sourceID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return docErrs, total, nil
+}
+
+// ListAll retrieves all failed documents of a source
+func (s *DocumentErrorStore) ListAll(ctx context.Context, sourceID string) ([]*domain.DocumentError, error) {
+	return s.list(ctx, `
+		SELECT source_id, external_id, container_id, stage, error, attempts, first_failed_at, last_failed_at
+		FROM document_errors
+		WHERE source_id = $1
+		ORDER BY last_failed_at DESC, external_id
+	`, sourceID)
+}
+
+// list scans the document errors returned by query
+func (s *DocumentErrorStore) list(ctx context.Context, query string, args ...any) ([]*domain.DocumentError, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	docErrs := []*domain.DocumentError{}
+	for rows.Next() {
+		var docErr domain.DocumentError
+		err := rows.Scan(
+			&docErr.SourceID,
+			&docErr.ExternalID,
+			&docErr.ContainerID,
+			&docErr.Stage,
+			&docErr.Error,
+			&docErr.Attempts,
+			&docErr.FirstFailedAt,
+			&docErr.LastFailedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		docErrs = append(docErrs, &docErr)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return docErrs, nil
+}
@@ -31,14 +31,15 @@ func (s *DocumentStore) Save(ctx context.Context, doc *domain.Document) error {
 	}
 
 	query := `
-		INSERT INTO documents (id, source_id, external_id, path, title, mime_type, metadata, created_at, updated_at, indexed_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		INSERT INTO documents (id, source_id, external_id, path, title, mime_type, metadata, content_hash, created_at, updated_at, indexed_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 		ON CONFLICT (id) DO UPDATE SET
 			external_id = EXCLUDED.external_id,
 			path = EXCLUDED.path,
 			title = EXCLUDED.title,
 			mime_type = EXCLUDED.mime_type,
 			metadata = EXCLUDED.metadata,
+			content_hash = EXCLUDED.content_hash,
 			updated_at = EXCLUDED.updated_at,
 			indexed_at = EXCLUDED.indexed_at
 	`
@@ -51,6 +52,7 @@ func (s *DocumentStore) Save(ctx context.Context, doc *domain.Document) error {
 		doc.Title,
 		doc.MimeType,
 		metadataJSON,
+		doc.ContentHash,
 		doc.CreatedAt,
 		doc.UpdatedAt,
 		NullTime(&doc.IndexedAt),
@@ -66,14 +68,15 @@ func (s *DocumentStore) SaveBatch(ctx context.Context, docs []*domain.Document)
 
 	return s.db.Transaction(ctx, func(tx *sql.Tx) error {
 		query := `
-			INSERT INTO documents (id, source_id, external_id, path, title, mime_type, metadata, created_at, updated_at, indexed_at)
-			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+			INSERT INTO documents (id, source_id, external_id, path, title, mime_type, metadata, content_hash, created_at, updated_at, indexed_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 			ON CONFLICT (id) DO UPDATE SET
 				external_id = EXCLUDED.external_id,
 				path = EXCLUDED.path,
 				title = EXCLUDED.title,
 				mime_type = EXCLUDED.mime_type,
 				metadata = EXCLUDED.metadata,
+				content_hash = EXCLUDED.content_hash,
 				updated_at = EXCLUDED.updated_at,
 				indexed_at = EXCLUDED.indexed_at
 		`
@@ -98,6 +101,7 @@ func (s *DocumentStore) SaveBatch(ctx context.Context, docs []*domain.Document)
 				doc.Title,
 				doc.MimeType,
 				metadataJSON,
+				doc.ContentHash,
 				doc.CreatedAt,
 				doc.UpdatedAt,
 				NullTime(&doc.IndexedAt),
@@ -114,7 +118,7 @@ func (s *DocumentStore) SaveBatch(ctx context.Context, docs []*domain.Document)
 // Get retrieves a document by ID
 func (s *DocumentStore) Get(ctx context.Context, id string) (*domain.Document, error) {
 	query := `
-		SELECT id, source_id, external_id, path, title, mime_type, metadata, created_at, updated_at, indexed_at
+		SELECT id, source_id, external_id, path, title, mime_type, metadata, content_hash, created_at, updated_at, indexed_at
 		FROM documents
 		WHERE id = $1
 	`
@@ -125,7 +129,7 @@ func (s *DocumentStore) Get(ctx context.Context, id string) (*domain.Document, e
 // GetByExternalID retrieves a document by source and external ID
 func (s *DocumentStore) GetByExternalID(ctx context.Context, sourceID, externalID string) (*domain.Document, error) {
 	query := `
-		SELECT id, source_id, external_id, path, title, mime_type, metadata, created_at, updated_at, indexed_at
+		SELECT id, source_id, external_id, path, title, mime_type, metadata, content_hash, created_at, updated_at, indexed_at
 		FROM documents
 		WHERE source_id = $1 AND external_id = $2
 	`
@@ -136,7 +140,7 @@ func (s *DocumentStore) GetByExternalID(ctx context.Context, sourceID, externalI
 func (s *DocumentStore) scanDocument(row *sql.Row) (*domain.Document, error) {
 	var doc domain.Document
 	var metadataJSON []byte
-	var path, title, mimeType sql.NullString
+	var path, title, mimeType, contentHash sql.NullString
 	var indexedAt sql.NullTime
 
 	err := row.Scan(
@@ -147,6 +151,7 @@ func (s *DocumentStore) scanDocument(row *sql.Row) (*domain.Document, error) {
 		&title,
 		&mimeType,
 		&metadataJSON,
+		&contentHash,
 		&doc.CreatedAt,
 		&doc.UpdatedAt,
 		&indexedAt,
@@ -161,6 +166,7 @@ func (s *DocumentStore) scanDocument(row *sql.Row) (*domain.Document, error) {
 	doc.Path = path.String
 	doc.Title = title.String
 	doc.MimeType = mimeType.String
+	doc.ContentHash = contentHash.String
 
 	if indexedAt.Valid {
 		doc.IndexedAt = indexedAt.Time
@@ -181,7 +187,7 @@ func (s *DocumentStore) scanDocument(row *sql.Row) (*domain.Document, error) {
 // GetBySource retrieves all documents for a source with pagination
 func (s *DocumentStore) GetBySource(ctx context.Context, sourceID string, limit, offset int) ([]*domain.Document, error) {
 	query := `
-		SELECT id, source_id, external_id, path, title, mime_type, metadata, created_at, updated_at, indexed_at
+		SELECT id, source_id, external_id, path, title, mime_type, metadata, content_hash, created_at, updated_at, indexed_at
 		FROM documents
 		WHERE source_id = $1
 		ORDER BY created_at DESC
@@ -202,7 +208,7 @@ func (s *DocumentStore) scanDocuments(rows *sql.Rows) ([]*domain.Document, error
 	for rows.Next() {
 		var doc domain.Document
 		var metadataJSON []byte
-		var path, title, mimeType sql.NullString
+		var path, title, mimeType, contentHash sql.NullString
 		var indexedAt sql.NullTime
 
 		err := rows.Scan(
@@ -213,6 +219,7 @@ func (s *DocumentStore) scanDocuments(rows *sql.Rows) ([]*domain.Document, error
 			&title,
 			&mimeType,
 			&metadataJSON,
+			&contentHash,
 			&doc.CreatedAt,
 			&doc.UpdatedAt,
 			&indexedAt,
@@ -224,6 +231,7 @@ func (s *DocumentStore) scanDocuments(rows *sql.Rows) ([]*domain.Document, error
 		doc.Path = path.String
 		doc.Title = title.String
 		doc.MimeType = mimeType.String
+		doc.ContentHash = contentHash.String
 
 		if indexedAt.Valid {
 			doc.IndexedAt = indexedAt.Time
@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Verify interface compliance
+var _ driven.DryRunStore = (*DryRunStore)(nil)
+
+// DryRunStore implements driven.DryRunStore using PostgreSQL
+type DryRunStore struct {
+	db *DB
+}
+
+// NewDryRunStore creates a new DryRunStore
+func NewDryRunStore(db *DB) *DryRunStore {
+	return &DryRunStore{db: db}
+}
+
+// Save creates or replaces a dry run
+func (s *DryRunStore) Save(ctx context.Context, run *domain.DryRun) error {
+	resultJSON, err := json.Marshal(run)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO dry_runs (id, source_id, status, result, started_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (id) DO UPDATE SET
+			status = EXCLUDED.status,
+			result = EXCLUDED.result
+	`
+
+	_, err = s.db.ExecContext(ctx, query,
+		run.ID,
+		run.SourceID,
+		string(run.Status),
+		resultJSON,
+		run.StartedAt,
+	)
+	return err
+}
+
+// Get retrieves a dry run by ID
+func (s *DryRunStore) Get(ctx context.Context, id string) (*domain.DryRun, error) {
+	var resultJSON []byte
+	err := s.db.QueryRowContext(ctx, `SELECT result FROM dry_runs WHERE id = $1`, id).Scan(&resultJSON)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var run domain.DryRun
+	if err := json.Unmarshal(resultJSON, &run); err != nil {
+		return nil, err
+	}
+	return &run, nil
+}
@@ -2,7 +2,11 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
 	"hash/fnv"
+	"sync"
 	"time"
 
 	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
@@ -13,21 +17,32 @@ var _ driven.DistributedLock = (*AdvisoryLock)(nil)
 
 // AdvisoryLock implements DistributedLock using PostgreSQL advisory locks.
 //
+// Advisory locks belong to the database session that took them, so every
+// held lock pins a dedicated connection from the pool until it is released.
+// This keeps the lock and unlock on the same session, and keeps one instance
+// from re-entering a lock it already holds on a shared connection.
+//
 // IMPORTANT LIMITATIONS:
 // - Advisory locks are connection-scoped, not TTL-based
 // - If the connection is lost, the lock is automatically released
 // - TTL parameter is ignored (locks don't expire automatically)
-// - Extend is a no-op since locks don't have TTL
+// - Extend only checks that the lock's connection is still alive
 //
 // For production multi-worker deployments, Redis locks are recommended.
 // This is provided as a fallback when Redis is unavailable.
 type AdvisoryLock struct {
 	db *DB
+
+	mu    sync.Mutex
+	conns map[string]*sql.Conn // Connections holding the locks of this instance
 }
 
 // NewAdvisoryLock creates a new PostgreSQL advisory lock adapter.
 func NewAdvisoryLock(db *DB) *AdvisoryLock {
-	return &AdvisoryLock{db: db}
+	return &AdvisoryLock{
+		db:    db,
+		conns: make(map[string]*sql.Conn),
+	}
 }
 
 // hashLockName converts a string lock name to a 64-bit integer for PostgreSQL advisory locks.
@@ -44,37 +59,65 @@ func hashLockName(name string) int64 {
 // Note: The TTL parameter is ignored - PostgreSQL advisory locks don't have TTL.
 // The lock is held until explicitly released or the connection closes.
 func (l *AdvisoryLock) Acquire(ctx context.Context, name string, ttl time.Duration) (bool, error) {
-	lockID := hashLockName(name)
+	l.mu.Lock()
+	defer l.mu.Unlock()
 
-	var acquired bool
-	err := l.db.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", lockID).Scan(&acquired)
+	if _, held := l.conns[name]; held {
+		return false, nil
+	}
+
+	conn, err := l.db.Conn(ctx)
 	if err != nil {
 		return false, err
 	}
-	return acquired, nil
+
+	var acquired bool
+	err = conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", hashLockName(name)).Scan(&acquired)
+	if err != nil || !acquired {
+		conn.Close()
+		return false, err
+	}
+
+	l.conns[name] = conn
+	return true, nil
 }
 
-// Release releases a named advisory lock.
-// Uses pg_advisory_unlock to release the lock.
-// Safe to call even if the lock is not held (returns false but no error).
+// Release releases a named advisory lock and returns its connection to the pool.
+// Safe to call even if the lock is not held.
 func (l *AdvisoryLock) Release(ctx context.Context, name string) error {
-	lockID := hashLockName(name)
+	l.mu.Lock()
+	conn, held := l.conns[name]
+	delete(l.conns, name)
+	l.mu.Unlock()
+
+	if !held {
+		return nil
+	}
+	defer conn.Close()
 
 	var released bool
-	err := l.db.QueryRowContext(ctx, "SELECT pg_advisory_unlock($1)", lockID).Scan(&released)
+	err := conn.QueryRowContext(ctx, "SELECT pg_advisory_unlock($1)", hashLockName(name)).Scan(&released)
 	if err != nil {
+		// Discard the connection so its session, and with it the lock, ends
+		_ = conn.Raw(func(any) error { return driver.ErrBadConn })
 		return err
 	}
-	// Note: released=false means lock wasn't held, but that's not an error
 	return nil
 }
 
-// Extend is a no-op for PostgreSQL advisory locks since they don't have TTL.
-// Advisory locks are held until explicitly released or the connection closes.
+// Extend checks that a held advisory lock's connection is still alive.
+// Advisory locks don't have TTL, but a lost connection has released the lock.
 func (l *AdvisoryLock) Extend(ctx context.Context, name string, ttl time.Duration) error {
-	// No-op: PostgreSQL advisory locks don't expire
-	// We could check if we hold the lock, but that adds complexity
-	// for little benefit in the scheduler use case
+	l.mu.Lock()
+	conn, held := l.conns[name]
+	l.mu.Unlock()
+
+	if !held {
+		return fmt.Errorf("extend lock %s: lock not held", name)
+	}
+	if err := conn.PingContext(ctx); err != nil {
+		return fmt.Errorf("extend lock %s: %w", name, err)
+	}
 	return nil
 }
 
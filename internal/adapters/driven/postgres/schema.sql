@@ -90,12 +90,16 @@ CREATE TABLE IF NOT EXISTS documents (
     title TEXT,
     mime_type TEXT,
     metadata JSONB NOT NULL DEFAULT '{}',
+    content_hash TEXT,
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
     updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
     indexed_at TIMESTAMPTZ,
     UNIQUE(source_id, external_id)
 );
 
+-- Hash of the content last indexed, used to skip unchanged documents on sync
+ALTER TABLE documents ADD COLUMN IF NOT EXISTS content_hash TEXT;
+
 CREATE INDEX IF NOT EXISTS idx_documents_source_id ON documents(source_id);
 CREATE INDEX IF NOT EXISTS idx_documents_external_id ON documents(external_id);
 
@@ -128,6 +132,50 @@ CREATE TABLE IF NOT EXISTS sync_states (
     completed_at TIMESTAMPTZ
 );
 
+-- Sync runs table (history of finished syncs per source)
+CREATE TABLE IF NOT EXISTS sync_runs (
+    id TEXT PRIMARY KEY,
+    source_id TEXT NOT NULL REFERENCES sources(id) ON DELETE CASCADE,
+    trigger TEXT NOT NULL,
+    full_sync BOOLEAN NOT NULL DEFAULT false,
+    containers TEXT[] NOT NULL DEFAULT '{}',
+    status TEXT NOT NULL,
+    stats JSONB NOT NULL DEFAULT '{}',
+    error TEXT,
+    errors JSONB NOT NULL DEFAULT '[]',
+    started_at TIMESTAMPTZ NOT NULL,
+    completed_at TIMESTAMPTZ NOT NULL,
+    duration_seconds DOUBLE PRECISION NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_sync_runs_source_started ON sync_runs(source_id, started_at DESC);
+
+-- Dry runs table (what a sync of a source would index, without indexing it)
+CREATE TABLE IF NOT EXISTS dry_runs (
+    id TEXT PRIMARY KEY,
+    source_id TEXT NOT NULL REFERENCES sources(id) ON DELETE CASCADE,
+    status TEXT NOT NULL,
+    result JSONB NOT NULL,
+    started_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_dry_runs_source_started ON dry_runs(source_id, started_at DESC);
+
+-- Document errors table (documents that failed to sync, for retry)
+CREATE TABLE IF NOT EXISTS document_errors (
+    source_id TEXT NOT NULL REFERENCES sources(id) ON DELETE CASCADE,
+    external_id TEXT NOT NULL,
+    container_id TEXT NOT NULL DEFAULT '',
+    stage TEXT NOT NULL,
+    error TEXT NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 1,
+    first_failed_at TIMESTAMPTZ NOT NULL,
+    last_failed_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (source_id, external_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_document_errors_source_failed ON document_errors(source_id, last_failed_at DESC);
+
 -- Scheduled tasks table (recurring task configuration)
 CREATE TABLE IF NOT EXISTS scheduled_tasks (
     id TEXT PRIMARY KEY,
@@ -239,6 +287,19 @@ END $$;
 
 CREATE INDEX IF NOT EXISTS idx_sources_installation_id ON sources(installation_id);
 
+-- Per-source sync schedule (interval or cron expression; NULL uses the team default)
+ALTER TABLE sources ADD COLUMN IF NOT EXISTS sync_schedule TEXT;
+
+-- Progress of unfinished syncs, for resuming them
+ALTER TABLE sync_states ADD COLUMN IF NOT EXISTS checkpoint JSONB;
+
+-- External IDs seen by unfinished syncs, for reconciling deletions once they finish
+CREATE TABLE IF NOT EXISTS sync_checkpoint_seen (
+    source_id TEXT NOT NULL REFERENCES sources(id) ON DELETE CASCADE,
+    external_id TEXT NOT NULL,
+    PRIMARY KEY (source_id, external_id)
+);
+
 -- Provider configurations (OAuth app credentials, API endpoints)
 -- One config per provider type. Multiple installations can use the same config.
 -- Secrets encrypted at application level (AES-GCM), stored as bytea
@@ -0,0 +1,347 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+	"github.com/lib/pq"
+)
+
+// Verify interface compliance
+var _ driven.SearchEngine = (*SearchEngine)(nil)
+
+const (
+	// searchSchemaLockID serializes search schema creation across instances
+	searchSchemaLockID = 12345679
+
+	// textSearchConfig is the text search configuration used for the
+	// content_tsv column; queries must use the same one
+	textSearchConfig = "english"
+
+	// vectorTargetHits is how many nearest neighbours hybrid search merges
+	// with the text matches, matching the Vespa schema
+	vectorTargetHits = 100
+
+	// hybridTextWeight is the weight of the normalised text rank in hybrid
+	// ranking; the cosine similarity gets the remainder
+	hybridTextWeight = 0.5
+)
+
+//go:embed search_schema.sql
+var searchSchema string
+
+// SearchEngine implements driven.SearchEngine using PostgreSQL full-text
+// search and the pgvector extension
+type SearchEngine struct {
+	db *DB
+}
+
+// NewSearchEngine creates a new SearchEngine. Call EnsureSchema before use.
+func NewSearchEngine(db *DB) *SearchEngine {
+	return &SearchEngine{db: db}
+}
+
+// EnsureSchema creates the pgvector extension and search tables (idempotent).
+// It is separate from InitSchema so deployments using Vespa do not need pgvector.
+func (s *SearchEngine) EnsureSchema(ctx context.Context) error {
+	err := s.db.Transaction(ctx, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", searchSchemaLockID); err != nil {
+			return fmt.Errorf("failed to acquire search schema lock: %w", err)
+		}
+		_, err := tx.ExecContext(ctx, searchSchema)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to initialize search schema (is pgvector installed?): %w", err)
+	}
+	return nil
+}
+
+// Index indexes chunks, replacing chunks with the same IDs
+func (s *SearchEngine) Index(ctx context.Context, chunks []*domain.Chunk) error {
+	if len(chunks) == 0 {
+		return nil
+	}
+
+	return s.db.Transaction(ctx, func(tx *sql.Tx) error {
+		query := `
+			INSERT INTO search_chunks (id, document_id, source_id, content, embedding, position, start_char, end_char, created_at)
+			VALUES ($1, $2, $3, $4, $5::vector, $6, $7, $8, $9)
+			ON CONFLICT (id) DO UPDATE SET
+				document_id = EXCLUDED.document_id,
+				source_id = EXCLUDED.source_id,
+				content = EXCLUDED.content,
+				embedding = EXCLUDED.embedding,
+				position = EXCLUDED.position,
+				start_char = EXCLUDED.start_char,
+				end_char = EXCLUDED.end_char
+		`
+
+		stmt, err := tx.PrepareContext(ctx, query)
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+
+		for _, chunk := range chunks {
+			_, err = stmt.ExecContext(ctx,
+				chunk.ID,
+				chunk.DocumentID,
+				chunk.SourceID,
+				chunk.Content,
+				vectorLiteral(chunk.Embedding),
+				chunk.Position,
+				chunk.StartChar,
+				chunk.EndChar,
+				chunk.CreatedAt,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to index chunk %s: %w", chunk.ID, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// Search performs a search query.
+// Text mode ranks by ts_rank_cd and semantic mode by cosine similarity.
+// Hybrid mode merges text matches with the nearest neighbours and ranks by a
+// weighted sum of normalised text rank and cosine similarity; without a
+// query embedding it falls back to text ranking, as with Vespa.
+func (s *SearchEngine) Search(ctx context.Context, query string, queryEmbedding []float32, opts domain.SearchOptions) ([]*domain.RankedChunk, int, error) {
+	// Semantic search has nothing to rank without an embedding
+	if opts.Mode == domain.SearchModeSemanticOnly && len(queryEmbedding) == 0 {
+		return []*domain.RankedChunk{}, 0, nil
+	}
+
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = domain.DefaultSearchOptions().Limit
+	}
+	offset := max(opts.Offset, 0)
+
+	sqlQuery, args := buildSearchQuery(query, queryEmbedding, opts)
+	results, total, err := s.runSearch(ctx, sqlQuery, args, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// The total comes from the returned rows, so a page past the end needs a recount
+	if len(results) == 0 && offset > 0 {
+		_, total, err = s.runSearch(ctx, sqlQuery, args, 1, 0)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return results, total, nil
+}
+
+// runSearch executes a search query built by buildSearchQuery
+func (s *SearchEngine) runSearch(ctx context.Context, sqlQuery string, args []any, limit, offset int) ([]*domain.RankedChunk, int, error) {
+	args = append(args, limit, offset)
+	sqlQuery += fmt.Sprintf(" LIMIT $%d OFFSET $%d", len(args)-1, len(args))
+
+	rows, err := s.db.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("postgres search failed: %w", err)
+	}
+	defer rows.Close()
+
+	var results []*domain.RankedChunk
+	var total int
+	for rows.Next() {
+		var chunk domain.Chunk
+		var score float64
+		err := rows.Scan(
+			&chunk.ID,
+			&chunk.DocumentID,
+			&chunk.SourceID,
+			&chunk.Content,
+			&chunk.Position,
+			&chunk.StartChar,
+			&chunk.EndChar,
+			&chunk.CreatedAt,
+			&score,
+			&total,
+		)
+		if err != nil {
+			return nil, 0, err
+		}
+		results = append(results, &domain.RankedChunk{Chunk: &chunk, Score: score})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return results, total, nil
+}
+
+// searchColumns are the chunk columns returned by search queries
+const searchColumns = "c.id, c.document_id, c.source_id, c.content, c.position, c.start_char, c.end_char, c.created_at"
+
+// buildSearchQuery returns the ranked query for the search mode, without
+// LIMIT/OFFSET. Each row is the chunk columns, the score and the total count.
+// Semantic mode requires a query embedding.
+func buildSearchQuery(query string, queryEmbedding []float32, opts domain.SearchOptions) (string, []any) {
+	var args []any
+	arg := func(v any) string {
+		args = append(args, v)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	filters := searchFilters(opts, arg)
+	tsQuery := func() string {
+		return fmt.Sprintf("websearch_to_tsquery('%s', %s)", textSearchConfig, arg(query))
+	}
+
+	mode := opts.Mode
+	if mode == domain.SearchModeHybrid && len(queryEmbedding) == 0 {
+		mode = domain.SearchModeTextOnly
+	}
+
+	switch mode {
+	case domain.SearchModeTextOnly:
+		if strings.TrimSpace(query) == "" {
+			return fmt.Sprintf(`
+				SELECT %s, 0::float8 AS score, COUNT(*) OVER () AS total
+				FROM search_chunks c
+				WHERE %s
+				ORDER BY c.id`, searchColumns, filters), args
+		}
+		return fmt.Sprintf(`
+			SELECT %s, ts_rank_cd(c.content_tsv, q)::float8 AS score, COUNT(*) OVER () AS total
+			FROM search_chunks c, %s q
+			WHERE c.content_tsv @@ q AND %s
+			ORDER BY score DESC, c.id`, searchColumns, tsQuery(), filters), args
+
+	case domain.SearchModeSemanticOnly:
+		vec, dims := arg(vectorLiteral(queryEmbedding)), arg(len(queryEmbedding))
+		return fmt.Sprintf(`
+			SELECT %s, (1 - (c.embedding <=> %s::vector))::float8 AS score, COUNT(*) OVER () AS total
+			FROM search_chunks c
+			WHERE c.embedding IS NOT NULL AND vector_dims(c.embedding) = %s AND %s
+			ORDER BY score DESC, c.id`, searchColumns, vec, dims, filters), args
+
+	default: // Hybrid with an embedding
+		tsq := tsQuery()
+		vec, dims := arg(vectorLiteral(queryEmbedding)), arg(len(queryEmbedding))
+		return fmt.Sprintf(`
+			WITH text_hits AS (
+				SELECT c.id, ts_rank_cd(c.content_tsv, q)::float8 AS score
+				FROM search_chunks c, %[2]s q
+				WHERE c.content_tsv @@ q AND %[5]s
+			), vector_hits AS (
+				SELECT c.id, (1 - (c.embedding <=> %[3]s::vector))::float8 AS score
+				FROM search_chunks c
+				WHERE c.embedding IS NOT NULL AND vector_dims(c.embedding) = %[4]s AND %[5]s
+				ORDER BY c.embedding <=> %[3]s::vector, c.id
+				LIMIT %[6]d
+			), max_text AS (
+				SELECT MAX(score) AS score FROM text_hits
+			), merged AS (
+				SELECT COALESCE(t.id, v.id) AS id,
+					%[7]g * COALESCE(t.score / NULLIF(m.score, 0), 0)
+						+ %[8]g * GREATEST(COALESCE(v.score, 0), 0) AS score
+				FROM text_hits t
+				FULL OUTER JOIN vector_hits v ON v.id = t.id
+				CROSS JOIN max_text m
+			)
+			SELECT %[1]s, h.score, COUNT(*) OVER () AS total
+			FROM merged h
+			JOIN search_chunks c ON c.id = h.id
+			ORDER BY h.score DESC, c.id`,
+			searchColumns, tsq, vec, dims, filters, vectorTargetHits, hybridTextWeight, 1-hybridTextWeight), args
+	}
+}
+
+// searchFilters returns the WHERE conditions for source and MIME type
+// filters on alias c. MIME types are read from the documents table.
+func searchFilters(opts domain.SearchOptions, arg func(any) string) string {
+	conditions := []string{"true"}
+
+	if len(opts.SourceIDs) > 0 {
+		conditions = append(conditions, fmt.Sprintf("c.source_id = ANY(%s)", arg(pq.Array(opts.SourceIDs))))
+	}
+
+	if len(opts.Filters.MimeTypes) > 0 {
+		mimeTypes := make([]string, len(opts.Filters.MimeTypes))
+		for i, m := range opts.Filters.MimeTypes {
+			mimeTypes[i] = strings.ToLower(strings.TrimSpace(m))
+		}
+		conditions = append(conditions, fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM documents d WHERE d.id = c.document_id AND lower(trim(split_part(d.mime_type, ';', 1))) = ANY(%s))",
+			arg(pq.Array(mimeTypes))))
+	}
+
+	return strings.Join(conditions, " AND ")
+}
+
+// vectorLiteral formats an embedding in pgvector's text format, or NULL if empty
+func vectorLiteral(v []float32) sql.NullString {
+	if len(v) == 0 {
+		return sql.NullString{}
+	}
+	var b strings.Builder
+	b.WriteByte('[')
+	for i, x := range v {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(strconv.FormatFloat(float64(x), 'g', -1, 32))
+	}
+	b.WriteByte(']')
+	return sql.NullString{String: b.String(), Valid: true}
+}
+
+// Delete deletes chunks by IDs
+func (s *SearchEngine) Delete(ctx context.Context, chunkIDs []string) error {
+	if len(chunkIDs) == 0 {
+		return nil
+	}
+	query := `DELETE FROM search_chunks WHERE id = ANY($1)`
+	_, err := s.db.ExecContext(ctx, query, pq.Array(chunkIDs))
+	return err
+}
+
+// DeleteByDocument deletes all chunks for a document
+func (s *SearchEngine) DeleteByDocument(ctx context.Context, documentID string) error {
+	query := `DELETE FROM search_chunks WHERE document_id = $1`
+	_, err := s.db.ExecContext(ctx, query, documentID)
+	return err
+}
+
+// DeleteBySource deletes all chunks for a source
+func (s *SearchEngine) DeleteBySource(ctx context.Context, sourceID string) error {
+	query := `DELETE FROM search_chunks WHERE source_id = $1`
+	_, err := s.db.ExecContext(ctx, query, sourceID)
+	return err
+}
+
+// HealthCheck verifies the database is reachable and the search table exists
+func (s *SearchEngine) HealthCheck(ctx context.Context) error {
+	var exists bool
+	err := s.db.QueryRowContext(ctx, `SELECT to_regclass('search_chunks') IS NOT NULL`).Scan(&exists)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("search_chunks table does not exist")
+	}
+	return nil
+}
+
+// Count returns the total number of indexed chunks
+func (s *SearchEngine) Count(ctx context.Context) (int64, error) {
+	var count int64
+	err := s.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM search_chunks`).Scan(&count)
+	return count, err
+}
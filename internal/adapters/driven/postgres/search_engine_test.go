@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+)
+
+func TestVectorLiteral(t *testing.T) {
+	if v := vectorLiteral(nil); v.Valid {
+		t.Errorf("expected NULL for empty embedding, got %q", v.String)
+	}
+
+	v := vectorLiteral([]float32{1, -0.25, 3.5e-7})
+	if !v.Valid || v.String != "[1,-0.25,3.5e-07]" {
+		t.Errorf("unexpected vector literal %q", v.String)
+	}
+}
+
+func TestBuildSearchQuery(t *testing.T) {
+	opts := domain.SearchOptions{
+		SourceIDs: []string{"s1"},
+		Filters:   domain.Filters{MimeTypes: []string{" Application/PDF "}},
+	}
+
+	tests := []struct {
+		name      string
+		mode      domain.SearchMode
+		query     string
+		embedding []float32
+		contains  []string
+		args      int
+	}{
+		{"text", domain.SearchModeTextOnly, "keys", nil, []string{"websearch_to_tsquery('english', $3)", "ORDER BY score DESC"}, 3},
+		{"text without query lists all", domain.SearchModeTextOnly, " ", nil, []string{"ORDER BY c.id"}, 2},
+		{"semantic", domain.SearchModeSemanticOnly, "keys", []float32{1, 0}, []string{"c.embedding <=> $3::vector", "vector_dims(c.embedding) = $4"}, 4},
+		{"hybrid", domain.SearchModeHybrid, "keys", []float32{1, 0}, []string{"FULL OUTER JOIN vector_hits", "LIMIT 100", "0.5 * COALESCE"}, 5},
+		{"hybrid without embedding falls back to text", domain.SearchModeHybrid, "keys", nil, []string{"websearch_to_tsquery"}, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := opts
+			o.Mode = tt.mode
+			query, args := buildSearchQuery(tt.query, tt.embedding, o)
+
+			for _, want := range append(tt.contains, "c.source_id = ANY($1)", "= ANY($2))") {
+				if !strings.Contains(query, want) {
+					t.Errorf("expected query to contain %q:\n%s", want, query)
+				}
+			}
+			if len(args) != tt.args {
+				t.Errorf("expected %d args, got %d", tt.args, len(args))
+			}
+			if tt.mode == domain.SearchModeTextOnly && strings.Contains(query, "vector") {
+				t.Errorf("expected no vector terms in text query:\n%s", query)
+			}
+		})
+	}
+}
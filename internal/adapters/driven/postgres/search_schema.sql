@@ -0,0 +1,25 @@
+-- Search index for the PostgreSQL search engine backend
+-- Only applied when SEARCH_ENGINE=postgres; requires the pgvector extension
+
+CREATE EXTENSION IF NOT EXISTS vector;
+
+-- Indexed chunks with their full-text vector and embedding.
+-- No foreign key to documents: chunks are indexed before their document is saved.
+-- The embedding column is unconstrained so the embedding model can change;
+-- vector search is an exact scan over rows of the query's dimension.
+CREATE TABLE IF NOT EXISTS search_chunks (
+    id TEXT PRIMARY KEY,
+    document_id TEXT NOT NULL,
+    source_id TEXT NOT NULL,
+    content TEXT NOT NULL,
+    content_tsv TSVECTOR GENERATED ALWAYS AS (to_tsvector('english', content)) STORED,
+    embedding VECTOR,
+    position INT NOT NULL DEFAULT 0,
+    start_char INT NOT NULL DEFAULT 0,
+    end_char INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_search_chunks_content_tsv ON search_chunks USING GIN(content_tsv);
+CREATE INDEX IF NOT EXISTS idx_search_chunks_document_id ON search_chunks(document_id);
+CREATE INDEX IF NOT EXISTS idx_search_chunks_source_id ON search_chunks(source_id);
@@ -32,8 +32,8 @@ func (s *SourceStore) Save(ctx context.Context, source *domain.Source) error {
 	}
 
 	query := `
-		INSERT INTO sources (id, name, provider_type, config, enabled, created_at, updated_at, created_by, installation_id, selected_containers)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		INSERT INTO sources (id, name, provider_type, config, enabled, created_at, updated_at, created_by, installation_id, selected_containers, sync_schedule)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 		ON CONFLICT (id) DO UPDATE SET
 			name = EXCLUDED.name,
 			provider_type = EXCLUDED.provider_type,
@@ -41,7 +41,8 @@ func (s *SourceStore) Save(ctx context.Context, source *domain.Source) error {
 			enabled = EXCLUDED.enabled,
 			updated_at = EXCLUDED.updated_at,
 			installation_id = EXCLUDED.installation_id,
-			selected_containers = EXCLUDED.selected_containers
+			selected_containers = EXCLUDED.selected_containers,
+			sync_schedule = EXCLUDED.sync_schedule
 	`
 
 	_, err = s.db.ExecContext(ctx, query,
@@ -55,6 +56,7 @@ func (s *SourceStore) Save(ctx context.Context, source *domain.Source) error {
 		source.CreatedBy,
 		sql.NullString{String: source.InstallationID, Valid: source.InstallationID != ""},
 		pq.Array(source.SelectedContainers),
+		sql.NullString{String: source.SyncSchedule, Valid: source.SyncSchedule != ""},
 	)
 	return err
 }
@@ -63,14 +65,14 @@ func (s *SourceStore) Save(ctx context.Context, source *domain.Source) error {
 func (s *SourceStore) Get(ctx context.Context, id string) (*domain.Source, error) {
 	query := `
 		SELECT id, name, provider_type, config, enabled, created_at, updated_at, created_by,
-		       installation_id, selected_containers
+		       installation_id, selected_containers, sync_schedule
 		FROM sources
 		WHERE id = $1
 	`
 
 	var source domain.Source
 	var configJSON []byte
-	var createdBy, installationID sql.NullString
+	var createdBy, installationID, syncSchedule sql.NullString
 	var selectedContainers []string
 
 	err := s.db.QueryRowContext(ctx, query, id).Scan(
@@ -84,6 +86,7 @@ func (s *SourceStore) Get(ctx context.Context, id string) (*domain.Source, error
 		&createdBy,
 		&installationID,
 		pqArray(&selectedContainers),
+		&syncSchedule,
 	)
 	if err == sql.ErrNoRows {
 		return nil, domain.ErrNotFound
@@ -98,6 +101,7 @@ func (s *SourceStore) Get(ctx context.Context, id string) (*domain.Source, error
 	source.CreatedBy = createdBy.String
 	source.InstallationID = installationID.String
 	source.SelectedContainers = selectedContainers
+	source.SyncSchedule = syncSchedule.String
 
 	return &source, nil
 }
@@ -106,14 +110,14 @@ func (s *SourceStore) Get(ctx context.Context, id string) (*domain.Source, error
 func (s *SourceStore) GetByName(ctx context.Context, name string) (*domain.Source, error) {
 	query := `
 		SELECT id, name, provider_type, config, enabled, created_at, updated_at, created_by,
-		       installation_id, selected_containers
+		       installation_id, selected_containers, sync_schedule
 		FROM sources
 		WHERE name = $1
 	`
 
 	var source domain.Source
 	var configJSON []byte
-	var createdBy, installationID sql.NullString
+	var createdBy, installationID, syncSchedule sql.NullString
 	var selectedContainers []string
 
 	err := s.db.QueryRowContext(ctx, query, name).Scan(
@@ -127,6 +131,7 @@ func (s *SourceStore) GetByName(ctx context.Context, name string) (*domain.Sourc
 		&createdBy,
 		&installationID,
 		pqArray(&selectedContainers),
+		&syncSchedule,
 	)
 	if err == sql.ErrNoRows {
 		return nil, domain.ErrNotFound
@@ -141,6 +146,7 @@ func (s *SourceStore) GetByName(ctx context.Context, name string) (*domain.Sourc
 	source.CreatedBy = createdBy.String
 	source.InstallationID = installationID.String
 	source.SelectedContainers = selectedContainers
+	source.SyncSchedule = syncSchedule.String
 
 	return &source, nil
 }
@@ -149,7 +155,7 @@ func (s *SourceStore) GetByName(ctx context.Context, name string) (*domain.Sourc
 func (s *SourceStore) List(ctx context.Context) ([]*domain.Source, error) {
 	query := `
 		SELECT id, name, provider_type, config, enabled, created_at, updated_at, created_by,
-		       installation_id, selected_containers
+		       installation_id, selected_containers, sync_schedule
 		FROM sources
 		ORDER BY created_at DESC
 	`
@@ -161,7 +167,7 @@ func (s *SourceStore) List(ctx context.Context) ([]*domain.Source, error) {
 func (s *SourceStore) ListEnabled(ctx context.Context) ([]*domain.Source, error) {
 	query := `
 		SELECT id, name, provider_type, config, enabled, created_at, updated_at, created_by,
-		       installation_id, selected_containers
+		       installation_id, selected_containers, sync_schedule
 		FROM sources
 		WHERE enabled = true
 		ORDER BY created_at DESC
@@ -181,7 +187,7 @@ func (s *SourceStore) querySources(ctx context.Context, query string, args ...in
 	for rows.Next() {
 		var source domain.Source
 		var configJSON []byte
-		var createdBy, installationID sql.NullString
+		var createdBy, installationID, syncSchedule sql.NullString
 		var selectedContainers []string
 
 		err := rows.Scan(
@@ -195,6 +201,7 @@ func (s *SourceStore) querySources(ctx context.Context, query string, args ...in
 			&createdBy,
 			&installationID,
 			pqArray(&selectedContainers),
+			&syncSchedule,
 		)
 		if err != nil {
 			return nil, err
@@ -206,6 +213,7 @@ func (s *SourceStore) querySources(ctx context.Context, query string, args ...in
 		source.CreatedBy = createdBy.String
 		source.InstallationID = installationID.String
 		source.SelectedContainers = selectedContainers
+		source.SyncSchedule = syncSchedule.String
 		sources = append(sources, &source)
 	}
 
@@ -269,7 +277,7 @@ func (s *SourceStore) CountByInstallation(ctx context.Context, installationID st
 func (s *SourceStore) ListByInstallation(ctx context.Context, installationID string) ([]*domain.Source, error) {
 	query := `
 		SELECT id, name, provider_type, config, enabled, created_at, updated_at, created_by,
-		       installation_id, selected_containers
+		       installation_id, selected_containers, sync_schedule
 		FROM sources
 		WHERE installation_id = $1
 		ORDER BY created_at DESC
@@ -309,7 +317,7 @@ func (s *SourceStore) querySourcesWithInstallation(ctx context.Context, query st
 	for rows.Next() {
 		var source domain.Source
 		var configJSON []byte
-		var createdBy, installationID sql.NullString
+		var createdBy, installationID, syncSchedule sql.NullString
 		var selectedContainers []string
 
 		err := rows.Scan(
@@ -323,6 +331,7 @@ func (s *SourceStore) querySourcesWithInstallation(ctx context.Context, query st
 			&createdBy,
 			&installationID,
 			pqArray(&selectedContainers),
+			&syncSchedule,
 		)
 		if err != nil {
 			return nil, err
@@ -334,6 +343,7 @@ func (s *SourceStore) querySourcesWithInstallation(ctx context.Context, query st
 		source.CreatedBy = createdBy.String
 		source.InstallationID = installationID.String
 		source.SelectedContainers = selectedContainers
+		source.SyncSchedule = syncSchedule.String
 		sources = append(sources, &source)
 	}
 
@@ -0,0 +1,162 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+	"github.com/lib/pq"
+)
+
+// Verify interface compliance
+var _ driven.SyncRunStore = (*SyncRunStore)(nil)
+
+// SyncRunStore implements driven.SyncRunStore using PostgreSQL
+type SyncRunStore struct {
+	db *DB
+}
+
+// NewSyncRunStore creates a new SyncRunStore
+func NewSyncRunStore(db *DB) *SyncRunStore {
+	return &SyncRunStore{db: db}
+}
+
+// Save records a finished sync run
+func (s *SyncRunStore) Save(ctx context.Context, run *domain.SyncRun) error {
+	statsJSON, err := json.Marshal(run.Stats)
+	if err != nil {
+		return err
+	}
+	runErrors := run.Errors
+	if runErrors == nil {
+		runErrors = []domain.SyncRunError{}
+	}
+	errorsJSON, err := json.Marshal(runErrors)
+	if err != nil {
+		return err
+	}
+	containers := run.Containers
+	if containers == nil {
+		containers = []string{}
+	}
+
+	query := `
+		INSERT INTO sync_runs (id, source_id, trigger, full_sync, containers, status, stats, error, errors, started_at, completed_at, duration_seconds)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (id) DO UPDATE SET
+			status = EXCLUDED.status,
+			stats = EXCLUDED.stats,
+			error = EXCLUDED.error,
+			errors = EXCLUDED.errors,
+			completed_at = EXCLUDED.completed_at,
+			duration_seconds = EXCLUDED.duration_seconds
+	`
+
+	_, err = s.db.ExecContext(ctx, query,
+		run.ID,
+		run.SourceID,
+		string(run.Trigger),
+		run.Full,
+		pq.Array(containers),
+		string(run.Status),
+		statsJSON,
+		run.Error,
+		errorsJSON,
+		run.StartedAt,
+		run.CompletedAt,
+		run.Duration,
+	)
+	return err
+}
+
+// List retrieves a page of a source's sync runs, newest first
+func (s *SyncRunStore) List(ctx context.Context, sourceID string, limit, offset int) ([]*domain.SyncRun, int, error) {
+	var total int
+	if err := s.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM sync_runs WHERE source_id = $1`, sourceID).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := `
+		SELECT id, source_id, trigger, full_sync, containers, status, stats, error, errors, started_at, completed_at, duration_seconds
+		FROM sync_runs
+		WHERE source_id = $1
+		ORDER BY started_at DESC, id
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, sourceID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	runs := []*domain.SyncRun{}
+	for rows.Next() {
+		var run domain.SyncRun
+		var containers []string
+		var statsJSON, errorsJSON []byte
+		var errStr sql.NullString
+
+		err := rows.Scan(
+			&run.ID,
+			&run.SourceID,
+			&run.Trigger,
+			&run.Full,
+			pq.Array(&containers),
+			&run.Status,
+			&statsJSON,
+			&errStr,
+			&errorsJSON,
+			&run.StartedAt,
+			&run.CompletedAt,
+			&run.Duration,
+		)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		run.Containers = containers
+		run.Error = errStr.String
+
+		if len(statsJSON) > 0 {
+			if err := json.Unmarshal(statsJSON, &run.Stats); err != nil {
+				return nil, 0, err
+			}
+		}
+		if len(errorsJSON) > 0 {
+			if err := json.Unmarshal(errorsJSON, &run.Errors); err != nil {
+				return nil, 0, err
+			}
+		}
+
+		runs = append(runs, &run)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return runs, total, nil
+}
+
+// Prune deletes all but the newest keep runs of a source
+func (s *SyncRunStore) Prune(ctx context.Context, sourceID string, keep int) (int, error) {
+	query := `
+		DELETE FROM sync_runs
+		WHERE source_id = $1 AND id NOT IN (
+			SELECT id FROM sync_runs
+			WHERE source_id = $1
+			ORDER BY started_at DESC, id
+			LIMIT $2
+		)
+	`
+
+	result, err := s.db.ExecContext(ctx, query, sourceID, keep)
+	if err != nil {
+		return 0, err
+	}
+	deleted, err := result.RowsAffected()
+	return int(deleted), err
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/custodia-labs/sercha-core/internal/core/domain"
 	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+	"github.com/lib/pq"
 )
 
 // Verify interface compliance
@@ -60,7 +61,7 @@ func (s *SyncStateStore) Save(ctx context.Context, state *domain.SyncState) erro
 // Get retrieves sync state for a source
 func (s *SyncStateStore) Get(ctx context.Context, sourceID string) (*domain.SyncState, error) {
 	query := `
-		SELECT source_id, status, last_sync_at, next_sync_at, cursor, stats, error, started_at, completed_at
+		SELECT source_id, status, last_sync_at, next_sync_at, cursor, stats, error, started_at, completed_at, checkpoint
 		FROM sync_states
 		WHERE source_id = $1
 	`
@@ -68,7 +69,7 @@ func (s *SyncStateStore) Get(ctx context.Context, sourceID string) (*domain.Sync
 	var state domain.SyncState
 	var lastSyncAt, nextSyncAt, startedAt, completedAt sql.NullTime
 	var cursor, errStr sql.NullString
-	var statsJSON []byte
+	var statsJSON, checkpointJSON []byte
 
 	err := s.db.QueryRowContext(ctx, query, sourceID).Scan(
 		&state.SourceID,
@@ -80,6 +81,7 @@ func (s *SyncStateStore) Get(ctx context.Context, sourceID string) (*domain.Sync
 		&errStr,
 		&startedAt,
 		&completedAt,
+		&checkpointJSON,
 	)
 	if err == sql.ErrNoRows {
 		// Return default state for new source
@@ -104,6 +106,9 @@ func (s *SyncStateStore) Get(ctx context.Context, sourceID string) (*domain.Sync
 			return nil, err
 		}
 	}
+	if state.Checkpoint, err = unmarshalCheckpoint(checkpointJSON); err != nil {
+		return nil, err
+	}
 
 	return &state, nil
 }
@@ -111,7 +116,7 @@ func (s *SyncStateStore) Get(ctx context.Context, sourceID string) (*domain.Sync
 // List retrieves sync states for all sources
 func (s *SyncStateStore) List(ctx context.Context) ([]*domain.SyncState, error) {
 	query := `
-		SELECT source_id, status, last_sync_at, next_sync_at, cursor, stats, error, started_at, completed_at
+		SELECT source_id, status, last_sync_at, next_sync_at, cursor, stats, error, started_at, completed_at, checkpoint
 		FROM sync_states
 		ORDER BY last_sync_at DESC NULLS LAST
 	`
@@ -127,7 +132,7 @@ func (s *SyncStateStore) List(ctx context.Context) ([]*domain.SyncState, error)
 		var state domain.SyncState
 		var lastSyncAt, nextSyncAt, startedAt, completedAt sql.NullTime
 		var cursor, errStr sql.NullString
-		var statsJSON []byte
+		var statsJSON, checkpointJSON []byte
 
 		err := rows.Scan(
 			&state.SourceID,
@@ -139,6 +144,7 @@ func (s *SyncStateStore) List(ctx context.Context) ([]*domain.SyncState, error)
 			&errStr,
 			&startedAt,
 			&completedAt,
+			&checkpointJSON,
 		)
 		if err != nil {
 			return nil, err
@@ -156,6 +162,9 @@ func (s *SyncStateStore) List(ctx context.Context) ([]*domain.SyncState, error)
 				return nil, err
 			}
 		}
+		if state.Checkpoint, err = unmarshalCheckpoint(checkpointJSON); err != nil {
+			return nil, err
+		}
 
 		states = append(states, &state)
 	}
@@ -186,6 +195,20 @@ func (s *SyncStateStore) UpdateStatus(ctx context.Context, sourceID string, stat
 	return err
 }
 
+// CompareAndSetStatus updates the status only while it is from
+func (s *SyncStateStore) CompareAndSetStatus(ctx context.Context, sourceID string, from, status domain.SyncStatus) (bool, error) {
+	query := `UPDATE sync_states SET status = $3 WHERE source_id = $1 AND status = $2`
+	result, err := s.db.ExecContext(ctx, query, sourceID, string(from), string(status))
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
 // UpdateCursor updates the sync cursor
 func (s *SyncStateStore) UpdateCursor(ctx context.Context, sourceID string, cursor string) error {
 	query := `
@@ -197,3 +220,97 @@ func (s *SyncStateStore) UpdateCursor(ctx context.Context, sourceID string, curs
 	_, err := s.db.ExecContext(ctx, query, sourceID, string(domain.SyncStatusIdle), cursor)
 	return err
 }
+
+// checkpointSeenBatchSize is the number of seen external IDs inserted per statement
+const checkpointSeenBatchSize = 5000
+
+// SaveCheckpoint replaces the checkpoint of a source's sync and adds its
+// seen external IDs to those already saved
+func (s *SyncStateStore) SaveCheckpoint(ctx context.Context, sourceID string, checkpoint *domain.SyncCheckpoint) error {
+	var checkpointJSON []byte
+	if checkpoint != nil {
+		var err error
+		if checkpointJSON, err = json.Marshal(checkpoint); err != nil {
+			return err
+		}
+	}
+
+	return s.db.Transaction(ctx, func(tx *sql.Tx) error {
+		query := `
+			INSERT INTO sync_states (source_id, status, checkpoint)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (source_id) DO UPDATE SET
+				checkpoint = EXCLUDED.checkpoint
+		`
+		if _, err := tx.ExecContext(ctx, query, sourceID, string(domain.SyncStatusIdle), checkpointJSON); err != nil {
+			return err
+		}
+
+		if checkpoint == nil {
+			_, err := tx.ExecContext(ctx, `DELETE FROM sync_checkpoint_seen WHERE source_id = $1`, sourceID)
+			return err
+		}
+
+		insert := `
+			INSERT INTO sync_checkpoint_seen (source_id, external_id)
+			SELECT $1, unnest($2::text[])
+			ON CONFLICT DO NOTHING
+		`
+		for start := 0; start < len(checkpoint.Seen); start += checkpointSeenBatchSize {
+			end := min(start+checkpointSeenBatchSize, len(checkpoint.Seen))
+			if _, err := tx.ExecContext(ctx, insert, sourceID, pq.Array(checkpoint.Seen[start:end])); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetCheckpoint retrieves the checkpoint of a source's sync including the seen external IDs
+func (s *SyncStateStore) GetCheckpoint(ctx context.Context, sourceID string) (*domain.SyncCheckpoint, error) {
+	query := `SELECT checkpoint FROM sync_states WHERE source_id = $1`
+
+	var checkpointJSON []byte
+	err := s.db.QueryRowContext(ctx, query, sourceID).Scan(&checkpointJSON)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoint, err := unmarshalCheckpoint(checkpointJSON)
+	if err != nil || checkpoint == nil {
+		return nil, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, `SELECT external_id FROM sync_checkpoint_seen WHERE source_id = $1`, sourceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var externalID string
+		if err := rows.Scan(&externalID); err != nil {
+			return nil, err
+		}
+		checkpoint.Seen = append(checkpoint.Seen, externalID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return checkpoint, nil
+}
+
+// unmarshalCheckpoint decodes a checkpoint column, which is NULL without a checkpoint
+func unmarshalCheckpoint(data []byte) (*domain.SyncCheckpoint, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var checkpoint domain.SyncCheckpoint
+	if err := json.Unmarshal(data, &checkpoint); err != nil {
+		return nil, err
+	}
+	return &checkpoint, nil
+}
@@ -266,6 +266,67 @@ func (q *Queue) Ack(ctx context.Context, taskID string) error {
 	return nil
 }
 
+// AckCancelled marks a processing task as cancelled, without retry
+func (q *Queue) AckCancelled(ctx context.Context, taskID string, reason string) error {
+	now := time.Now()
+	query := `
+		UPDATE tasks
+		SET status = $1, completed_at = $2, updated_at = $3, error = $4
+		WHERE id = $5
+	`
+
+	result, err := q.db.ExecContext(ctx, query,
+		domain.TaskStatusCancelled,
+		now,
+		now,
+		reason,
+		taskID,
+	)
+	if err != nil {
+		return fmt.Errorf("update task: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+// Defer returns a processing task to pending at runAt without counting the attempt
+func (q *Queue) Defer(ctx context.Context, taskID string, reason string, runAt time.Time) error {
+	query := `
+		UPDATE tasks
+		SET status = $1, error = $2, updated_at = $3, scheduled_for = $4, attempts = GREATEST(attempts - 1, 0)
+		WHERE id = $5
+	`
+
+	result, err := q.db.ExecContext(ctx, query,
+		domain.TaskStatusPending,
+		reason,
+		time.Now(),
+		runAt,
+		taskID,
+	)
+	if err != nil {
+		return fmt.Errorf("update task: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
 // Nack marks a task as failed, potentially scheduling a retry
 func (q *Queue) Nack(ctx context.Context, taskID string, reason string) error {
 	// First get the task to check retry count
@@ -472,7 +533,7 @@ func (q *Queue) CancelTask(ctx context.Context, taskID string) error {
 	`
 
 	result, err := q.db.ExecContext(ctx, query,
-		domain.TaskStatusFailed,
+		domain.TaskStatusCancelled,
 		time.Now(),
 		taskID,
 		domain.TaskStatusPending,
@@ -492,19 +553,20 @@ func (q *Queue) CancelTask(ctx context.Context, taskID string) error {
 	return nil
 }
 
-// PurgeTasks removes old completed/failed tasks
+// PurgeTasks removes old completed/failed/cancelled tasks
 func (q *Queue) PurgeTasks(ctx context.Context, olderThanSeconds int) (int, error) {
 	cutoff := time.Now().Add(-time.Duration(olderThanSeconds) * time.Second)
 
 	query := `
 		DELETE FROM tasks
-		WHERE status IN ($1, $2)
-		  AND updated_at < $3
+		WHERE status IN ($1, $2, $3)
+		  AND updated_at < $4
 	`
 
 	result, err := q.db.ExecContext(ctx, query,
 		domain.TaskStatusCompleted,
 		domain.TaskStatusFailed,
+		domain.TaskStatusCancelled,
 		cutoff,
 	)
 	if err != nil {
@@ -549,6 +611,8 @@ func (q *Queue) Stats(ctx context.Context) (*driven.QueueStats, error) {
 			stats.CompletedCount = count
 		case domain.TaskStatusFailed:
 			stats.FailedCount = count
+		case domain.TaskStatusCancelled:
+			stats.CancelledCount = count
 		}
 	}
 
@@ -221,8 +221,8 @@ func (q *Queue) DequeueWithTimeout(ctx context.Context, timeout int) (*domain.Ta
 		return nil, fmt.Errorf("failed to get task data: %w", err)
 	}
 
-	if task == nil {
-		// Task data missing, acknowledge and skip
+	if task == nil || task.IsFinished() {
+		// Task data missing or cancelled while queued, acknowledge and skip
 		q.client.XAck(ctx, taskStream, taskGroup, msg.ID)
 		return nil, nil
 	}
@@ -273,6 +273,77 @@ func (q *Queue) Ack(ctx context.Context, taskID string) error {
 	return nil
 }
 
+// AckCancelled acknowledges a task that stopped because it was cancelled.
+func (q *Queue) AckCancelled(ctx context.Context, taskID string, reason string) error {
+	msgID, err := q.client.Get(ctx, taskKeyPrefix+taskID+":msg").Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return fmt.Errorf("failed to get message ID: %w", err)
+	}
+
+	pipe := q.client.Pipeline()
+
+	// Acknowledge the message so it is not redelivered
+	if msgID != "" {
+		pipe.XAck(ctx, taskStream, taskGroup, msgID)
+		pipe.XDel(ctx, taskStream, msgID)
+	}
+
+	task, err := q.GetTask(ctx, taskID)
+	if err == nil && task != nil {
+		task.MarkCancelled(reason)
+		taskData, _ := json.Marshal(task)
+		pipe.Set(ctx, taskKeyPrefix+taskID, taskData, 24*time.Hour)
+	}
+
+	pipe.Del(ctx, taskKeyPrefix+taskID+":msg")
+
+	_, err = pipe.Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to ack cancelled task: %w", err)
+	}
+
+	return nil
+}
+
+// Defer returns a task that could not start yet to the queue at runAt,
+// without counting the attempt.
+func (q *Queue) Defer(ctx context.Context, taskID string, reason string, runAt time.Time) error {
+	task, err := q.GetTask(ctx, taskID)
+	if err != nil {
+		return fmt.Errorf("failed to get task: %w", err)
+	}
+	if task == nil {
+		return errors.New("task not found")
+	}
+
+	msgID, _ := q.client.Get(ctx, taskKeyPrefix+taskID+":msg").Result()
+
+	pipe := q.client.Pipeline()
+
+	// Acknowledge the current message, the task is re-enqueued at runAt
+	if msgID != "" {
+		pipe.XAck(ctx, taskStream, taskGroup, msgID)
+		pipe.XDel(ctx, taskStream, msgID)
+	}
+
+	task.Defer(reason, runAt)
+	taskData, _ := json.Marshal(task)
+	pipe.Set(ctx, taskKeyPrefix+taskID, taskData, 24*time.Hour)
+	pipe.ZAdd(ctx, scheduledTasks, redis.Z{
+		Score:  float64(task.ScheduledFor.Unix()),
+		Member: task.ID,
+	})
+
+	pipe.Del(ctx, taskKeyPrefix+taskID+":msg")
+
+	_, err = pipe.Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to defer task: %w", err)
+	}
+
+	return nil
+}
+
 // Nack indicates task processing failed and should be retried.
 func (q *Queue) Nack(ctx context.Context, taskID string, reason string) error {
 	task, err := q.GetTask(ctx, taskID)
@@ -419,8 +490,8 @@ func (q *Queue) CancelTask(ctx context.Context, taskID string) error {
 	if task.Status == domain.TaskStatusProcessing {
 		return errors.New("cannot cancel task that is processing")
 	}
-	if task.Status == domain.TaskStatusCompleted || task.Status == domain.TaskStatusFailed {
-		return errors.New("cannot cancel completed or failed task")
+	if task.IsFinished() {
+		return errors.New("cannot cancel finished task")
 	}
 
 	pipe := q.client.Pipeline()
@@ -429,9 +500,7 @@ func (q *Queue) CancelTask(ctx context.Context, taskID string) error {
 	pipe.ZRem(ctx, scheduledTasks, taskID)
 
 	// Update task status
-	task.Status = domain.TaskStatusFailed
-	task.Error = "cancelled"
-	task.UpdatedAt = time.Now()
+	task.MarkCancelled("cancelled")
 	taskData, _ := json.Marshal(task)
 	pipe.Set(ctx, taskKeyPrefix+taskID, taskData, 24*time.Hour)
 
@@ -439,7 +508,7 @@ func (q *Queue) CancelTask(ctx context.Context, taskID string) error {
 	return err
 }
 
-// PurgeTasks removes completed/failed tasks older than the specified age.
+// PurgeTasks removes finished tasks older than the specified age.
 func (q *Queue) PurgeTasks(ctx context.Context, olderThanSeconds int) (int, error) {
 	cutoff := time.Now().Add(-time.Duration(olderThanSeconds) * time.Second)
 	var purged int
@@ -470,9 +539,8 @@ func (q *Queue) PurgeTasks(ctx context.Context, olderThanSeconds int) (int, erro
 				continue
 			}
 
-			// Only purge completed/failed tasks that are old enough
-			if (task.Status == domain.TaskStatusCompleted || task.Status == domain.TaskStatusFailed) &&
-				task.UpdatedAt.Before(cutoff) {
+			// Only purge finished tasks that are old enough
+			if task.IsFinished() && task.UpdatedAt.Before(cutoff) {
 				q.client.Del(ctx, key)
 				purged++
 			}
@@ -543,6 +611,8 @@ func (q *Queue) Stats(ctx context.Context) (*driven.QueueStats, error) {
 					stats.CompletedCount++
 				case domain.TaskStatusFailed:
 					stats.FailedCount++
+				case domain.TaskStatusCancelled:
+					stats.CancelledCount++
 				}
 			}
 		}
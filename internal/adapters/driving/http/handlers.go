@@ -1,12 +1,16 @@
 package http
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
 	"github.com/custodia-labs/sercha-core/internal/core/ports/driving"
 )
 
@@ -430,6 +434,146 @@ func (s *Server) handleSearch(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, http.StatusOK, result)
 }
 
+// handleSearchStream godoc
+// @Summary      Search and summarise (streaming)
+// @Description  Execute a search and stream the results as Server-Sent Events. A "results" event with the hits is sent first, followed by "token" events carrying incremental LLM summary text, and a final "summary" event with the full summary and citations. An "error" event is sent instead of a summary when no LLM is configured or generation fails.
+// @Tags         Search
+// @Accept       json
+// @Produce      text/event-stream
+// @Security     BearerAuth
+// @Param        request  body      searchRequest  true  "Search query"
+// @Success      200      {object}  domain.SummaryEvent  "Stream of summary events"
+// @Failure      400      {object}  ErrorResponse        "Invalid request or missing query"
+// @Failure      401      {object}  ErrorResponse        "Unauthorized"
+// @Failure      500      {object}  ErrorResponse        "Search failed"
+// @Router       /search/stream [post]
+func (s *Server) handleSearchStream(w http.ResponseWriter, r *http.Request) {
+	if s.answerService == nil {
+		writeError(w, http.StatusServiceUnavailable, "answer service not configured")
+		return
+	}
+
+	var req searchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if req.Query == "" {
+		writeError(w, http.StatusBadRequest, "query is required")
+		return
+	}
+
+	opts := domain.SearchOptions{
+		Mode:      req.Mode,
+		Limit:     req.Limit,
+		Offset:    req.Offset,
+		SourceIDs: req.SourceIDs,
+	}
+
+	// Streams can outlive the server write timeout; the LLM call carries its own.
+	rc := http.NewResponseController(w)
+	_ = rc.SetWriteDeadline(time.Time{})
+
+	// Headers are sent with the first event so search failures can still
+	// be reported with a normal error status.
+	started := false
+	emit := func(event *domain.SummaryEvent) error {
+		if !started {
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.Header().Set("Cache-Control", "no-cache")
+			w.Header().Set("Connection", "keep-alive")
+			w.Header().Set("X-Accel-Buffering", "no")
+			w.WriteHeader(http.StatusOK)
+			started = true
+		}
+		if err := writeSSE(w, string(event.Type), event); err != nil {
+			return err
+		}
+		return rc.Flush()
+	}
+
+	// r.Context() is cancelled when the client disconnects, which aborts
+	// the upstream LLM request.
+	err := s.answerService.StreamSummary(r.Context(), req.Query, opts, emit)
+	if err == nil || r.Context().Err() != nil {
+		return
+	}
+
+	if !started {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			writeError(w, http.StatusBadRequest, "query is required")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "search failed")
+		return
+	}
+
+	_ = emit(&domain.SummaryEvent{Type: domain.SummaryEventError, Error: "stream failed"})
+}
+
+// answerRequest represents a question answering request
+// @Description Question answering request
+type answerRequest struct {
+	Query     string            `json:"query" example:"how do I rotate the API signing key?"`
+	Mode      domain.SearchMode `json:"mode,omitempty" example:"hybrid" enums:"hybrid,text,semantic"`
+	SourceIDs []string          `json:"source_ids,omitempty"`
+	MaxChunks int               `json:"max_chunks,omitempty" example:"8"`
+}
+
+// handleAnswer godoc
+// @Summary      Answer a question
+// @Description  Search indexed documents and generate an answer with the configured LLM. The answer contains inline [n] markers that map to the returned citations.
+// @Tags         Search
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request  body      answerRequest  true  "Question"
+// @Success      200      {object}  domain.Answer
+// @Failure      400      {object}  ErrorResponse  "Invalid request or missing query"
+// @Failure      401      {object}  ErrorResponse  "Unauthorized"
+// @Failure      500      {object}  ErrorResponse  "Answer generation failed"
+// @Failure      503      {object}  ErrorResponse  "No LLM provider configured"
+// @Router       /answer [post]
+func (s *Server) handleAnswer(w http.ResponseWriter, r *http.Request) {
+	if s.answerService == nil {
+		writeError(w, http.StatusServiceUnavailable, "answer service not configured")
+		return
+	}
+
+	var req answerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if req.Query == "" {
+		writeError(w, http.StatusBadRequest, "query is required")
+		return
+	}
+
+	opts := domain.AnswerOptions{
+		Mode:      req.Mode,
+		SourceIDs: req.SourceIDs,
+		MaxChunks: req.MaxChunks,
+	}
+
+	answer, err := s.answerService.Answer(r.Context(), req.Query, opts)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrServiceUnavailable):
+			writeError(w, http.StatusServiceUnavailable, "answer generation requires an LLM provider - configure one in AI settings")
+		case errors.Is(err, domain.ErrInvalidInput):
+			writeError(w, http.StatusBadRequest, "query is required")
+		default:
+			writeError(w, http.StatusInternalServerError, "answer generation failed")
+		}
+		return
+	}
+
+	writeJSON(w, http.StatusOK, answer)
+}
+
 // Document endpoints
 
 // handleGetDocument godoc
@@ -552,11 +696,11 @@ func (s *Server) handleCreateSource(w http.ResponseWriter, r *http.Request) {
 
 	source, err := s.sourceService.Create(r.Context(), authCtx.UserID, req)
 	if err != nil {
-		switch err {
-		case domain.ErrAlreadyExists:
+		switch {
+		case errors.Is(err, domain.ErrAlreadyExists):
 			writeError(w, http.StatusConflict, "source already exists")
-		case domain.ErrInvalidInput:
-			writeError(w, http.StatusBadRequest, "invalid input")
+		case errors.Is(err, domain.ErrInvalidInput):
+			writeError(w, http.StatusBadRequest, err.Error())
 		default:
 			writeError(w, http.StatusInternalServerError, "failed to create source")
 		}
@@ -603,17 +747,20 @@ func (s *Server) handleDeleteSource(w http.ResponseWriter, r *http.Request) {
 // SyncAcceptedResponse represents the response when sync is triggered
 // @Description Sync accepted response
 type SyncAcceptedResponse struct {
+	// accepted, or coalesced when a pending sync of the source already covers the request
 	Status   string `json:"status" example:"accepted"`
 	SourceID string `json:"source_id" example:"src_abc123"`
+	TaskID   string `json:"task_id" example:"task_abc123"`
 }
 
 // handleTriggerSync godoc
 // @Summary      Trigger sync
-// @Description  Trigger a sync operation for a specific source (admin only)
+// @Description  Trigger a sync operation for a specific source (admin only). A full sync ignores the sync cursor, refetches every document and deletes documents the source no longer has. A request covered by a sync of the source that is still queued is coalesced into it; a sync started while another runs is rejected by the worker with a cancelled task.
 // @Tags         Sources
 // @Produce      json
 // @Security     BearerAuth
-// @Param        id  path      string  true  "Source ID"
+// @Param        id    path      string  true   "Source ID"
+// @Param        full  query     bool    false  "Run a full sync"
 // @Success      202       {object}  SyncAcceptedResponse
 // @Failure      400       {object}  ErrorResponse  "Missing source ID"
 // @Failure      401       {object}  ErrorResponse  "Unauthorized"
@@ -643,9 +790,24 @@ func (s *Server) handleTriggerSync(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Coalesce with a queued sync of the source that covers this one; a
+	// full sync covers an incremental one but not the other way round
+	full, _ := strconv.ParseBool(r.URL.Query().Get("full"))
+	if pending := s.pendingSyncTask(r.Context(), source.ID, full); pending != nil {
+		writeJSON(w, http.StatusAccepted, map[string]string{
+			"status":    "coalesced",
+			"source_id": sourceID,
+			"task_id":   pending.ID,
+		})
+		return
+	}
+
 	// Create and enqueue sync task
 	// Note: Using "default" as team_id since we're single-org
-	task := domain.NewSyncSourceTask("default", source.ID)
+	task := domain.NewSyncSourceTaskWithOptions("default", source.ID, domain.SyncOptions{
+		Full:    full,
+		Trigger: domain.SyncTriggerManual,
+	})
 	if err := s.taskQueue.Enqueue(r.Context(), task); err != nil {
 		writeError(w, http.StatusInternalServerError, "failed to enqueue sync task")
 		return
@@ -658,6 +820,28 @@ func (s *Server) handleTriggerSync(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// pendingSyncTask returns a queued sync of the source that covers a sync
+// with the given fullness, or nil. Failing to list tasks is not fatal; the
+// sync is then enqueued and the worker's source lock keeps it from
+// overlapping another.
+func (s *Server) pendingSyncTask(ctx context.Context, sourceID string, full bool) *domain.Task {
+	tasks, err := s.taskQueue.ListTasks(ctx, driven.TaskFilter{
+		TeamID: "default",
+		Status: domain.TaskStatusPending,
+		Type:   domain.TaskTypeSyncSource,
+	})
+	if err != nil {
+		return nil
+	}
+
+	for _, task := range tasks {
+		if task.SourceID() == sourceID && (task.SyncOptions().Full || !full) {
+			return task
+		}
+	}
+	return nil
+}
+
 // Settings endpoints
 
 // handleGetSettings godoc
@@ -1549,6 +1733,410 @@ func (s *Server) handleGetSyncState(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, http.StatusOK, state)
 }
 
+// handleCancelSync godoc
+// @Summary      Cancel sync
+// @Description  Request cancellation of the running sync for a source (admin only). The sync stops after the document in flight, keeping the documents already processed, and ends with status "cancelled". Returns the sync state, which is "cancelling" until the worker running the sync picks up the request. Does nothing if no sync is running.
+// @Tags         Sources
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Source ID"
+// @Success      200  {object}  domain.SyncState
+// @Failure      400  {object}  ErrorResponse  "Missing source ID"
+// @Failure      401  {object}  ErrorResponse  "Unauthorized"
+// @Failure      403  {object}  ErrorResponse  "Forbidden - admin only"
+// @Failure      404  {object}  ErrorResponse  "Source not found"
+// @Failure      500  {object}  ErrorResponse  "Internal server error"
+// @Router       /sources/{id}/sync/cancel [post]
+func (s *Server) handleCancelSync(w http.ResponseWriter, r *http.Request) {
+	if s.syncOrchestrator == nil {
+		writeError(w, http.StatusServiceUnavailable, "sync orchestrator not configured")
+		return
+	}
+
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "missing source id")
+		return
+	}
+
+	// Verify source exists
+	if _, err := s.sourceService.Get(r.Context(), id); err != nil {
+		if err == domain.ErrNotFound {
+			writeError(w, http.StatusNotFound, "source not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "failed to get source")
+		return
+	}
+
+	if err := s.syncOrchestrator.CancelSync(r.Context(), id); err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to cancel sync: "+err.Error())
+		return
+	}
+
+	state, err := s.syncOrchestrator.GetSyncState(r.Context(), id)
+	if err != nil {
+		switch err {
+		case domain.ErrNotFound:
+			writeError(w, http.StatusNotFound, "sync state not found")
+		default:
+			writeError(w, http.StatusInternalServerError, "failed to get sync state: "+err.Error())
+		}
+		return
+	}
+
+	writeJSON(w, http.StatusOK, state)
+}
+
+// SyncRunsResponse represents a paginated list of sync runs for a source
+// @Description Paginated sync history of a source, newest first
+type SyncRunsResponse struct {
+	Runs   []*domain.SyncRun `json:"runs"`
+	Total  int               `json:"total"`
+	Limit  int               `json:"limit"`
+	Offset int               `json:"offset"`
+}
+
+// handleListSyncRuns godoc
+// @Summary      List sync runs
+// @Description  Get the sync history of a source, newest first (admin only). Each run records its trigger, containers, duration, statistics and the first per-document errors. Only the most recent runs of each source are kept.
+// @Tags         Sources
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id      path      string  true   "Source ID"
+// @Param        limit   query     int     false  "Maximum number of runs to return (default 20, max 100)"
+// @Param        offset  query     int     false  "Number of runs to skip (default 0)"
+// @Success      200     {object}  SyncRunsResponse
+// @Failure      400     {object}  ErrorResponse  "Missing source ID"
+// @Failure      401     {object}  ErrorResponse  "Unauthorized"
+// @Failure      403     {object}  ErrorResponse  "Forbidden - admin only"
+// @Failure      404     {object}  ErrorResponse  "Source not found"
+// @Failure      500     {object}  ErrorResponse  "Internal server error"
+// @Router       /sources/{id}/sync/runs [get]
+func (s *Server) handleListSyncRuns(w http.ResponseWriter, r *http.Request) {
+	if s.syncOrchestrator == nil {
+		writeError(w, http.StatusServiceUnavailable, "sync orchestrator not configured")
+		return
+	}
+
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "missing source id")
+		return
+	}
+
+	// Parse pagination parameters
+	limit := 20
+	offset := 0
+
+	if l := r.URL.Query().Get("limit"); l != "" {
+		if parsed, err := parseInt(l); err == nil && parsed > 0 && parsed <= 100 {
+			limit = parsed
+		}
+	}
+	if o := r.URL.Query().Get("offset"); o != "" {
+		if parsed, err := parseInt(o); err == nil && parsed >= 0 {
+			offset = parsed
+		}
+	}
+
+	runs, total, err := s.syncOrchestrator.ListSyncRuns(r.Context(), id, limit, offset)
+	if err != nil {
+		switch err {
+		case domain.ErrNotFound:
+			writeError(w, http.StatusNotFound, "source not found")
+		default:
+			writeError(w, http.StatusInternalServerError, "failed to list sync runs: "+err.Error())
+		}
+		return
+	}
+
+	writeJSON(w, http.StatusOK, SyncRunsResponse{
+		Runs:   runs,
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
+	})
+}
+
+// DocumentErrorsResponse represents a paginated list of failed documents for a source
+// @Description Paginated list of documents that failed to sync, most recently failed first
+type DocumentErrorsResponse struct {
+	Errors []*domain.DocumentError `json:"errors"`
+	Total  int                     `json:"total"`
+	Limit  int                     `json:"limit"`
+	Offset int                     `json:"offset"`
+}
+
+// handleListDocumentErrors godoc
+// @Summary      List failed documents
+// @Description  Get the documents of a source that failed to sync, with the processing stage and error of the last attempt (admin only). A document is removed from the list once it syncs successfully.
+// @Tags         Sources
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id      path      string  true   "Source ID"
+// @Param        limit   query     int     false  "Maximum number of documents to return (default 20, max 100)"
+// @Param        offset  query     int     false  "Number of documents to skip (default 0)"
+// @Success      200     {object}  DocumentErrorsResponse
+// @Failure      400     {object}  ErrorResponse  "Missing source ID"
+// @Failure      401     {object}  ErrorResponse  "Unauthorized"
+// @Failure      403     {object}  ErrorResponse  "Forbidden - admin only"
+// @Failure      404     {object}  ErrorResponse  "Source not found"
+// @Failure      500     {object}  ErrorResponse  "Internal server error"
+// @Router       /sources/{id}/sync/errors [get]
+func (s *Server) handleListDocumentErrors(w http.ResponseWriter, r *http.Request) {
+	if s.syncOrchestrator == nil {
+		writeError(w, http.StatusServiceUnavailable, "sync orchestrator not configured")
+		return
+	}
+
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "missing source id")
+		return
+	}
+
+	// Parse pagination parameters
+	limit := 20
+	offset := 0
+
+	if l := r.URL.Query().Get("limit"); l != "" {
+		if parsed, err := parseInt(l); err == nil && parsed > 0 && parsed <= 100 {
+			limit = parsed
+		}
+	}
+	if o := r.URL.Query().Get("offset"); o != "" {
+		if parsed, err := parseInt(o); err == nil && parsed >= 0 {
+			offset = parsed
+		}
+	}
+
+	docErrs, total, err := s.syncOrchestrator.ListDocumentErrors(r.Context(), id, limit, offset)
+	if err != nil {
+		switch err {
+		case domain.ErrNotFound:
+			writeError(w, http.StatusNotFound, "source not found")
+		default:
+			writeError(w, http.StatusInternalServerError, "failed to list document errors: "+err.Error())
+		}
+		return
+	}
+
+	writeJSON(w, http.StatusOK, DocumentErrorsResponse{
+		Errors: docErrs,
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
+	})
+}
+
+// handleRetryFailedDocuments godoc
+// @Summary      Retry failed documents
+// @Description  Enqueue a task that refetches and reprocesses only the documents of a source that failed to sync (admin only). Documents no longer in the source are deleted.
+// @Tags         Sources
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Source ID"
+// @Success      202  {object}  SyncAcceptedResponse
+// @Failure      400  {object}  ErrorResponse  "Missing source ID"
+// @Failure      401  {object}  ErrorResponse  "Unauthorized"
+// @Failure      403  {object}  ErrorResponse  "Forbidden - admin only"
+// @Failure      404  {object}  ErrorResponse  "Source not found"
+// @Failure      500  {object}  ErrorResponse  "Internal server error"
+// @Router       /sources/{id}/sync/retry [post]
+func (s *Server) handleRetryFailedDocuments(w http.ResponseWriter, r *http.Request) {
+	sourceID := r.PathValue("id")
+	if sourceID == "" {
+		writeError(w, http.StatusBadRequest, "missing source id")
+		return
+	}
+
+	// Verify source exists
+	source, err := s.sourceService.Get(r.Context(), sourceID)
+	if err != nil {
+		if err == domain.ErrNotFound {
+			writeError(w, http.StatusNotFound, "source not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "failed to get source")
+		return
+	}
+
+	if s.taskQueue == nil {
+		writeError(w, http.StatusServiceUnavailable, "task queue not configured")
+		return
+	}
+
+	task := domain.NewRetryFailedDocumentsTask("default", source.ID)
+	if err := s.taskQueue.Enqueue(r.Context(), task); err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to enqueue retry task")
+		return
+	}
+
+	writeJSON(w, http.StatusAccepted, map[string]string{
+		"status":    "accepted",
+		"source_id": sourceID,
+		"task_id":   task.ID,
+	})
+}
+
+// PreviewRulesRequest represents the rules to preview for a source
+// @Description Proposed rules for a source; null previews removing its rules
+type PreviewRulesRequest struct {
+	Rules *domain.SourceRules `json:"rules"`
+}
+
+// handlePreviewRules godoc
+// @Summary      Preview source rules
+// @Description  Show which documents of a source would be added or removed if its include/exclude rules were replaced (admin only). The source is enumerated without syncing, so the request takes about as long as fetching the source. Up to 100 documents are listed per side; the counts are totals.
+// @Tags         Sources
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string               true  "Source ID"
+// @Param        request  body      PreviewRulesRequest  true  "Proposed rules"
+// @Success      200      {object}  domain.RulesPreview
+// @Failure      400      {object}  ErrorResponse  "Invalid rules"
+// @Failure      401      {object}  ErrorResponse  "Unauthorized"
+// @Failure      403      {object}  ErrorResponse  "Forbidden - admin only"
+// @Failure      404      {object}  ErrorResponse  "Source not found"
+// @Failure      500      {object}  ErrorResponse  "Internal server error"
+// @Router       /sources/{id}/rules/preview [post]
+func (s *Server) handlePreviewRules(w http.ResponseWriter, r *http.Request) {
+	if s.syncOrchestrator == nil {
+		writeError(w, http.StatusServiceUnavailable, "sync orchestrator not configured")
+		return
+	}
+
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "missing source id")
+		return
+	}
+
+	var req PreviewRulesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	preview, err := s.syncOrchestrator.PreviewRules(r.Context(), id, req.Rules)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			writeError(w, http.StatusNotFound, "source not found")
+		case errors.Is(err, domain.ErrInvalidInput):
+			writeError(w, http.StatusBadRequest, err.Error())
+		default:
+			writeError(w, http.StatusInternalServerError, "failed to preview rules: "+err.Error())
+		}
+		return
+	}
+
+	writeJSON(w, http.StatusOK, preview)
+}
+
+// handleDryRunSync godoc
+// @Summary      Dry-run sync
+// @Description  Enqueue a task that fetches a source and normalises and chunks its documents without storing or indexing anything (admin only). The result, with document counts by MIME type, estimated chunks and embedding tokens and sample documents, is read from the dry-run result endpoint under the returned task ID.
+// @Tags         Sources
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Source ID"
+// @Success      202  {object}  SyncAcceptedResponse
+// @Failure      400  {object}  ErrorResponse  "Missing source ID"
+// @Failure      401  {object}  ErrorResponse  "Unauthorized"
+// @Failure      403  {object}  ErrorResponse  "Forbidden - admin only"
+// @Failure      404  {object}  ErrorResponse  "Source not found"
+// @Failure      500  {object}  ErrorResponse  "Internal server error"
+// @Router       /sources/{id}/dry-run [post]
+func (s *Server) handleDryRunSync(w http.ResponseWriter, r *http.Request) {
+	sourceID := r.PathValue("id")
+	if sourceID == "" {
+		writeError(w, http.StatusBadRequest, "missing source id")
+		return
+	}
+
+	// Verify source exists
+	source, err := s.sourceService.Get(r.Context(), sourceID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			writeError(w, http.StatusNotFound, "source not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "failed to get source")
+		return
+	}
+
+	if s.taskQueue == nil {
+		writeError(w, http.StatusServiceUnavailable, "task queue not configured")
+		return
+	}
+
+	task := domain.NewDryRunSyncTask("default", source.ID)
+	if err := s.taskQueue.Enqueue(r.Context(), task); err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to enqueue dry-run task")
+		return
+	}
+
+	writeJSON(w, http.StatusAccepted, map[string]string{
+		"status":    "accepted",
+		"source_id": sourceID,
+		"task_id":   task.ID,
+	})
+}
+
+// handleGetDryRun godoc
+// @Summary      Get dry-run result
+// @Description  Get the result of a dry-run sync of a source (admin only). A dry run that has not started yet is reported with 202 and the status of its task; a running dry run returns its results so far.
+// @Tags         Sources
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string  true  "Source ID"
+// @Param        task_id  path      string  true  "Dry-run task ID"
+// @Success      200      {object}  domain.DryRun
+// @Success      202      {object}  SyncAcceptedResponse  "Dry run not started yet"
+// @Failure      401      {object}  ErrorResponse  "Unauthorized"
+// @Failure      403      {object}  ErrorResponse  "Forbidden - admin only"
+// @Failure      404      {object}  ErrorResponse  "Dry run not found"
+// @Failure      500      {object}  ErrorResponse  "Internal server error"
+// @Router       /sources/{id}/dry-run/{task_id} [get]
+func (s *Server) handleGetDryRun(w http.ResponseWriter, r *http.Request) {
+	if s.syncOrchestrator == nil {
+		writeError(w, http.StatusServiceUnavailable, "sync orchestrator not configured")
+		return
+	}
+
+	sourceID := r.PathValue("id")
+	taskID := r.PathValue("task_id")
+
+	run, err := s.syncOrchestrator.GetDryRun(r.Context(), sourceID, taskID)
+	if err == nil {
+		writeJSON(w, http.StatusOK, run)
+		return
+	}
+	if !errors.Is(err, domain.ErrNotFound) {
+		writeError(w, http.StatusInternalServerError, "failed to get dry run")
+		return
+	}
+
+	// No result yet: the task may still be queued
+	if s.taskQueue != nil {
+		task, err := s.taskQueue.GetTask(r.Context(), taskID)
+		if err == nil && task != nil && task.Type == domain.TaskTypeDryRunSync &&
+			task.SourceID() == sourceID && !task.IsFinished() {
+			writeJSON(w, http.StatusAccepted, map[string]string{
+				"status":    string(task.Status),
+				"source_id": sourceID,
+				"task_id":   task.ID,
+			})
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "dry run not found")
+}
+
 // handleListSyncStates godoc
 // @Summary      List sync states
 // @Description  Get sync states for all sources. Returns the sync status, last sync time, and statistics for each source.
@@ -1719,11 +2307,11 @@ func (s *Server) handleUpdateSource(w http.ResponseWriter, r *http.Request) {
 
 	source, err := s.sourceService.Update(r.Context(), id, req)
 	if err != nil {
-		switch err {
-		case domain.ErrNotFound:
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
 			writeError(w, http.StatusNotFound, "source not found")
-		case domain.ErrInvalidInput:
-			writeError(w, http.StatusBadRequest, "invalid source data")
+		case errors.Is(err, domain.ErrInvalidInput):
+			writeError(w, http.StatusBadRequest, err.Error())
 		default:
 			writeError(w, http.StatusInternalServerError, "failed to update source: "+err.Error())
 		}
@@ -1933,3 +2521,13 @@ func writeJSON(w http.ResponseWriter, status int, data interface{}) {
 func writeError(w http.ResponseWriter, status int, message string) {
 	writeJSON(w, status, map[string]string{"error": message})
 }
+
+// writeSSE writes a single server-sent event with a JSON payload
+func writeSSE(w http.ResponseWriter, event string, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, payload)
+	return err
+}
@@ -1,12 +1,15 @@
 package http
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -134,6 +137,25 @@ func (m *mockSearchService) Suggest(ctx context.Context, prefix string, limit in
 	return nil, errors.New("not implemented")
 }
 
+type mockAnswerService struct {
+	answerFn func(ctx context.Context, query string, opts domain.AnswerOptions) (*domain.Answer, error)
+	streamFn func(ctx context.Context, query string, opts domain.SearchOptions, emit func(*domain.SummaryEvent) error) error
+}
+
+func (m *mockAnswerService) Answer(ctx context.Context, query string, opts domain.AnswerOptions) (*domain.Answer, error) {
+	if m.answerFn != nil {
+		return m.answerFn(ctx, query, opts)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockAnswerService) StreamSummary(ctx context.Context, query string, opts domain.SearchOptions, emit func(*domain.SummaryEvent) error) error {
+	if m.streamFn != nil {
+		return m.streamFn(ctx, query, opts, emit)
+	}
+	return errors.New("not implemented")
+}
+
 type mockSourceService struct {
 	createFn          func(ctx context.Context, creatorID string, req driving.CreateSourceRequest) (*domain.Source, error)
 	getFn             func(ctx context.Context, id string) (*domain.Source, error)
@@ -1275,6 +1297,205 @@ func TestHandleSearch_ServiceError(t *testing.T) {
 	}
 }
 
+// Answer Handler Tests
+
+func TestHandleAnswer_Success(t *testing.T) {
+	mockAnswer := &mockAnswerService{
+		answerFn: func(ctx context.Context, query string, opts domain.AnswerOptions) (*domain.Answer, error) {
+			if opts.MaxChunks != 5 {
+				t.Errorf("expected max_chunks 5, got %d", opts.MaxChunks)
+			}
+			return &domain.Answer{
+				Query:  query,
+				Answer: "Rotate it from the admin console [1].",
+				Citations: []*domain.Citation{
+					{Index: 1, DocumentID: "doc-1", ChunkID: "chunk-1", Path: "docs/keys.md", StartChar: 120, EndChar: 480},
+				},
+			}, nil
+		},
+	}
+
+	server := &Server{answerService: mockAnswer}
+
+	body, _ := json.Marshal(answerRequest{Query: "how do I rotate keys?", MaxChunks: 5})
+	req := httptest.NewRequest("POST", "/api/v1/answer", bytes.NewBuffer(body))
+	rr := httptest.NewRecorder()
+
+	server.handleAnswer(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", rr.Code)
+	}
+
+	var response domain.Answer
+	if err := json.NewDecoder(rr.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(response.Citations) != 1 || response.Citations[0].DocumentID != "doc-1" || response.Citations[0].EndChar != 480 {
+		t.Errorf("unexpected citations: %+v", response.Citations)
+	}
+}
+
+func TestHandleAnswer_MissingQuery(t *testing.T) {
+	server := &Server{answerService: &mockAnswerService{}}
+
+	body, _ := json.Marshal(answerRequest{})
+	req := httptest.NewRequest("POST", "/api/v1/answer", bytes.NewBuffer(body))
+	rr := httptest.NewRecorder()
+
+	server.handleAnswer(rr, req)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Errorf("expected status 400, got %d", rr.Code)
+	}
+}
+
+func TestHandleAnswer_LLMUnavailable(t *testing.T) {
+	mockAnswer := &mockAnswerService{
+		answerFn: func(ctx context.Context, query string, opts domain.AnswerOptions) (*domain.Answer, error) {
+			return nil, fmt.Errorf("%w: no llm", domain.ErrServiceUnavailable)
+		},
+	}
+
+	server := &Server{answerService: mockAnswer}
+
+	body, _ := json.Marshal(answerRequest{Query: "anything"})
+	req := httptest.NewRequest("POST", "/api/v1/answer", bytes.NewBuffer(body))
+	rr := httptest.NewRecorder()
+
+	server.handleAnswer(rr, req)
+
+	if rr.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected status 503, got %d", rr.Code)
+	}
+}
+
+// Search Stream Handler Tests
+
+func TestHandleSearchStream_EventsInOrder(t *testing.T) {
+	mockAnswer := &mockAnswerService{
+		streamFn: func(ctx context.Context, query string, opts domain.SearchOptions, emit func(*domain.SummaryEvent) error) error {
+			events := []*domain.SummaryEvent{
+				{Type: domain.SummaryEventResults, Results: &domain.SearchResult{Query: query, Results: []*domain.RankedChunk{}}},
+				{Type: domain.SummaryEventToken, Token: "Keys are "},
+				{Type: domain.SummaryEventToken, Token: "rotated [1]."},
+				{Type: domain.SummaryEventSummary, Summary: &domain.Answer{Query: query, Answer: "Keys are rotated [1]."}},
+			}
+			for _, e := range events {
+				if err := emit(e); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+
+	server := &Server{answerService: mockAnswer}
+	// Route through the logging middleware to check flushing survives the wrapper
+	handler := NewLoggingMiddleware().Handler(http.HandlerFunc(server.handleSearchStream))
+
+	body, _ := json.Marshal(searchRequest{Query: "key rotation"})
+	req := httptest.NewRequest("POST", "/api/v1/search/stream", bytes.NewBuffer(body))
+	rr := httptest.NewRecorder()
+
+	handler.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", rr.Code)
+	}
+	if ct := rr.Header().Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("expected text/event-stream, got %s", ct)
+	}
+	if !rr.Flushed {
+		t.Error("expected response to be flushed through the middleware")
+	}
+
+	out := rr.Body.String()
+	order := []string{"event: results", "event: token", "event: summary"}
+	last := -1
+	for _, marker := range order {
+		idx := strings.Index(out, marker)
+		if idx <= last {
+			t.Fatalf("expected %q after previous event, got body %q", marker, out)
+		}
+		last = idx
+	}
+	if !strings.Contains(out, `"token":"rotated [1]."`) {
+		t.Errorf("expected token payload in stream, got %q", out)
+	}
+}
+
+func TestHandleSearchStream_SearchErrorBeforeStream(t *testing.T) {
+	mockAnswer := &mockAnswerService{
+		streamFn: func(ctx context.Context, query string, opts domain.SearchOptions, emit func(*domain.SummaryEvent) error) error {
+			return errors.New("search engine down")
+		},
+	}
+
+	server := &Server{answerService: mockAnswer}
+
+	body, _ := json.Marshal(searchRequest{Query: "anything"})
+	req := httptest.NewRequest("POST", "/api/v1/search/stream", bytes.NewBuffer(body))
+	rr := httptest.NewRecorder()
+
+	server.handleSearchStream(rr, req)
+
+	if rr.Code != http.StatusInternalServerError {
+		t.Errorf("expected status 500, got %d", rr.Code)
+	}
+	if ct := rr.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("expected JSON error response, got %s", ct)
+	}
+}
+
+func TestHandleSearchStream_ClientDisconnectCancelsUpstream(t *testing.T) {
+	cancelled := make(chan struct{})
+	mockAnswer := &mockAnswerService{
+		streamFn: func(ctx context.Context, query string, opts domain.SearchOptions, emit func(*domain.SummaryEvent) error) error {
+			if err := emit(&domain.SummaryEvent{Type: domain.SummaryEventResults, Results: &domain.SearchResult{}}); err != nil {
+				return err
+			}
+			// Simulate a slow LLM that only stops when the request context ends
+			select {
+			case <-ctx.Done():
+				close(cancelled)
+				return ctx.Err()
+			case <-time.After(5 * time.Second):
+				return nil
+			}
+		},
+	}
+
+	server := &Server{answerService: mockAnswer}
+	ts := httptest.NewServer(NewLoggingMiddleware().Handler(http.HandlerFunc(server.handleSearchStream)))
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	body, _ := json.Marshal(searchRequest{Query: "slow"})
+	req, _ := http.NewRequestWithContext(ctx, "POST", ts.URL, bytes.NewBuffer(body))
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	// The first event must arrive before the handler finishes
+	line, err := bufio.NewReader(resp.Body).ReadString('\n')
+	if err != nil || !strings.HasPrefix(line, "event: results") {
+		t.Fatalf("expected results event to be flushed early, got %q (%v)", line, err)
+	}
+
+	cancel()
+
+	select {
+	case <-cancelled:
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected client disconnect to cancel the stream context")
+	}
+}
+
 // Source Handler Tests
 
 func TestHandleListSources_Success(t *testing.T) {
@@ -1596,7 +1817,8 @@ func TestHandleDeleteSource_InternalError(t *testing.T) {
 
 // mockTaskQueue implements driven.TaskQueue for testing
 type mockTaskQueue struct {
-	enqueueFn func(ctx context.Context, task *domain.Task) error
+	enqueueFn   func(ctx context.Context, task *domain.Task) error
+	listTasksFn func(ctx context.Context, filter driven.TaskFilter) ([]*domain.Task, error)
 }
 
 func (m *mockTaskQueue) Enqueue(ctx context.Context, task *domain.Task) error {
@@ -1626,11 +1848,22 @@ func (m *mockTaskQueue) Nack(ctx context.Context, taskID string, reason string)
 	return nil
 }
 
+func (m *mockTaskQueue) AckCancelled(ctx context.Context, taskID string, reason string) error {
+	return nil
+}
+
+func (m *mockTaskQueue) Defer(ctx context.Context, taskID string, reason string, runAt time.Time) error {
+	return nil
+}
+
 func (m *mockTaskQueue) GetTask(ctx context.Context, taskID string) (*domain.Task, error) {
 	return nil, errors.New("not implemented")
 }
 
 func (m *mockTaskQueue) ListTasks(ctx context.Context, filter driven.TaskFilter) ([]*domain.Task, error) {
+	if m.listTasksFn != nil {
+		return m.listTasksFn(ctx, filter)
+	}
 	return nil, errors.New("not implemented")
 }
 
@@ -1694,6 +1927,166 @@ func TestHandleTriggerSync_Success(t *testing.T) {
 	}
 }
 
+func TestHandleTriggerSync_Full(t *testing.T) {
+	mockSource := &mockSourceService{
+		getFn: func(ctx context.Context, id string) (*domain.Source, error) {
+			return &domain.Source{ID: id}, nil
+		},
+	}
+	var enqueued *domain.Task
+	mockQueue := &mockTaskQueue{
+		enqueueFn: func(ctx context.Context, task *domain.Task) error {
+			enqueued = task
+			return nil
+		},
+	}
+
+	server := &Server{
+		sourceService: mockSource,
+		taskQueue:     mockQueue,
+	}
+
+	req := httptest.NewRequest("POST", "/api/v1/sources/source-1/sync?full=true", nil)
+	req.SetPathValue("id", "source-1")
+	rr := httptest.NewRecorder()
+
+	server.handleTriggerSync(rr, req)
+
+	if rr.Code != http.StatusAccepted {
+		t.Errorf("expected status 202, got %d", rr.Code)
+	}
+	if enqueued == nil || !enqueued.SyncOptions().Full || enqueued.SourceID() != "source-1" {
+		t.Errorf("expected a full sync task for source-1, got %+v", enqueued)
+	}
+}
+
+func TestHandleTriggerSync_CoalescesPending(t *testing.T) {
+	mockSource := &mockSourceService{
+		getFn: func(ctx context.Context, id string) (*domain.Source, error) {
+			return &domain.Source{ID: id}, nil
+		},
+	}
+	pending := domain.NewSyncSourceTask("default", "source-1")
+	var enqueued *domain.Task
+	mockQueue := &mockTaskQueue{
+		enqueueFn: func(ctx context.Context, task *domain.Task) error {
+			enqueued = task
+			return nil
+		},
+		listTasksFn: func(ctx context.Context, filter driven.TaskFilter) ([]*domain.Task, error) {
+			if filter.Status != domain.TaskStatusPending || filter.Type != domain.TaskTypeSyncSource {
+				t.Errorf("expected pending sync tasks to be listed, got %+v", filter)
+			}
+			return []*domain.Task{
+				domain.NewSyncSourceTask("default", "source-2"),
+				pending,
+			}, nil
+		},
+	}
+
+	server := &Server{
+		sourceService: mockSource,
+		taskQueue:     mockQueue,
+	}
+
+	req := httptest.NewRequest("POST", "/api/v1/sources/source-1/sync", nil)
+	req.SetPathValue("id", "source-1")
+	rr := httptest.NewRecorder()
+
+	server.handleTriggerSync(rr, req)
+
+	if rr.Code != http.StatusAccepted {
+		t.Errorf("expected status 202, got %d", rr.Code)
+	}
+	var response map[string]string
+	if err := json.NewDecoder(rr.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if response["status"] != "coalesced" || response["task_id"] != pending.ID {
+		t.Errorf("expected coalesced into %s, got %v", pending.ID, response)
+	}
+	if enqueued != nil {
+		t.Error("expected no new task to be enqueued")
+	}
+
+	// A pending incremental sync does not cover a full sync
+	req = httptest.NewRequest("POST", "/api/v1/sources/source-1/sync?full=true", nil)
+	req.SetPathValue("id", "source-1")
+	rr = httptest.NewRecorder()
+
+	server.handleTriggerSync(rr, req)
+
+	if enqueued == nil || !enqueued.SyncOptions().Full {
+		t.Errorf("expected a full sync task to be enqueued, got %+v", enqueued)
+	}
+}
+
+func TestHandleRetryFailedDocuments(t *testing.T) {
+	mockSource := &mockSourceService{
+		getFn: func(ctx context.Context, id string) (*domain.Source, error) {
+			return &domain.Source{ID: id}, nil
+		},
+	}
+	var enqueued *domain.Task
+	mockQueue := &mockTaskQueue{
+		enqueueFn: func(ctx context.Context, task *domain.Task) error {
+			enqueued = task
+			return nil
+		},
+	}
+
+	server := &Server{
+		sourceService: mockSource,
+		taskQueue:     mockQueue,
+	}
+
+	req := httptest.NewRequest("POST", "/api/v1/sources/source-1/sync/retry", nil)
+	req.SetPathValue("id", "source-1")
+	rr := httptest.NewRecorder()
+
+	server.handleRetryFailedDocuments(rr, req)
+
+	if rr.Code != http.StatusAccepted {
+		t.Errorf("expected status 202, got %d", rr.Code)
+	}
+	if enqueued == nil || enqueued.Type != domain.TaskTypeRetryFailedDocuments || enqueued.SourceID() != "source-1" {
+		t.Errorf("expected a retry task for source-1, got %+v", enqueued)
+	}
+}
+
+func TestHandleDryRunSync(t *testing.T) {
+	mockSource := &mockSourceService{
+		getFn: func(ctx context.Context, id string) (*domain.Source, error) {
+			return &domain.Source{ID: id}, nil
+		},
+	}
+	var enqueued *domain.Task
+	mockQueue := &mockTaskQueue{
+		enqueueFn: func(ctx context.Context, task *domain.Task) error {
+			enqueued = task
+			return nil
+		},
+	}
+
+	server := &Server{
+		sourceService: mockSource,
+		taskQueue:     mockQueue,
+	}
+
+	req := httptest.NewRequest("POST", "/api/v1/sources/source-1/dry-run", nil)
+	req.SetPathValue("id", "source-1")
+	rr := httptest.NewRecorder()
+
+	server.handleDryRunSync(rr, req)
+
+	if rr.Code != http.StatusAccepted {
+		t.Errorf("expected status 202, got %d", rr.Code)
+	}
+	if enqueued == nil || enqueued.Type != domain.TaskTypeDryRunSync || enqueued.SourceID() != "source-1" {
+		t.Errorf("expected a dry-run task for source-1, got %+v", enqueued)
+	}
+}
+
 func TestHandleTriggerSync_MissingID(t *testing.T) {
 	server := &Server{}
 
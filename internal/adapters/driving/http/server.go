@@ -29,6 +29,7 @@ type Server struct {
 	authService         driving.AuthService
 	userService         driving.UserService
 	searchService       driving.SearchService
+	answerService       driving.AnswerService
 	sourceService       driving.SourceService
 	docService          driving.DocumentService
 	settingsService     driving.SettingsService
@@ -66,6 +67,7 @@ func NewServer(
 	authService driving.AuthService,
 	userService driving.UserService,
 	searchService driving.SearchService,
+	answerService driving.AnswerService,
 	sourceService driving.SourceService,
 	docService driving.DocumentService,
 	settingsService driving.SettingsService,
@@ -84,6 +86,7 @@ func NewServer(
 		authService:         authService,
 		userService:         userService,
 		searchService:       searchService,
+		answerService:       answerService,
 		sourceService:       sourceService,
 		docService:          docService,
 		settingsService:     settingsService,
@@ -148,6 +151,10 @@ func (s *Server) setupRoutes() {
 	// Search endpoints (authenticated)
 	s.router.Handle("POST /api/v1/search",
 		authMiddleware.Authenticate(http.HandlerFunc(s.handleSearch)))
+	s.router.Handle("POST /api/v1/search/stream",
+		authMiddleware.Authenticate(http.HandlerFunc(s.handleSearchStream)))
+	s.router.Handle("POST /api/v1/answer",
+		authMiddleware.Authenticate(http.HandlerFunc(s.handleAnswer)))
 
 	// Document endpoints (authenticated)
 	s.router.Handle("GET /api/v1/documents/{id}",
@@ -185,6 +192,27 @@ func (s *Server) setupRoutes() {
 	s.router.Handle("GET /api/v1/sources/{id}/sync",
 		authMiddleware.Authenticate(
 			authMiddleware.RequireAdmin(http.HandlerFunc(s.handleGetSyncState))))
+	s.router.Handle("POST /api/v1/sources/{id}/sync/cancel",
+		authMiddleware.Authenticate(
+			authMiddleware.RequireAdmin(http.HandlerFunc(s.handleCancelSync))))
+	s.router.Handle("GET /api/v1/sources/{id}/sync/runs",
+		authMiddleware.Authenticate(
+			authMiddleware.RequireAdmin(http.HandlerFunc(s.handleListSyncRuns))))
+	s.router.Handle("GET /api/v1/sources/{id}/sync/errors",
+		authMiddleware.Authenticate(
+			authMiddleware.RequireAdmin(http.HandlerFunc(s.handleListDocumentErrors))))
+	s.router.Handle("POST /api/v1/sources/{id}/sync/retry",
+		authMiddleware.Authenticate(
+			authMiddleware.RequireAdmin(http.HandlerFunc(s.handleRetryFailedDocuments))))
+	s.router.Handle("POST /api/v1/sources/{id}/rules/preview",
+		authMiddleware.Authenticate(
+			authMiddleware.RequireAdmin(http.HandlerFunc(s.handlePreviewRules))))
+	s.router.Handle("POST /api/v1/sources/{id}/dry-run",
+		authMiddleware.Authenticate(
+			authMiddleware.RequireAdmin(http.HandlerFunc(s.handleDryRunSync))))
+	s.router.Handle("GET /api/v1/sources/{id}/dry-run/{task_id}",
+		authMiddleware.Authenticate(
+			authMiddleware.RequireAdmin(http.HandlerFunc(s.handleGetDryRun))))
 	s.router.Handle("GET /api/v1/sources/sync-states",
 		authMiddleware.Authenticate(
 			authMiddleware.RequireAdmin(http.HandlerFunc(s.handleListSyncStates))))
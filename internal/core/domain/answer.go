@@ -0,0 +1,59 @@
+package domain
+
+import "time"
+
+// AnswerOptions configures an answer request
+type AnswerOptions struct {
+	Mode      SearchMode `json:"mode"`
+	SourceIDs []string   `json:"source_ids,omitempty"` // Restrict retrieval to sources
+	MaxChunks int        `json:"max_chunks"`           // Chunks packed into the prompt
+}
+
+// DefaultAnswerOptions returns sensible defaults
+func DefaultAnswerOptions() AnswerOptions {
+	return AnswerOptions{
+		Mode:      SearchModeHybrid,
+		MaxChunks: 8,
+	}
+}
+
+// Answer is an LLM-generated answer grounded in retrieved chunks
+type Answer struct {
+	Query     string        `json:"query"`
+	Answer    string        `json:"answer"`          // Answer text with inline [n] markers
+	Citations []*Citation   `json:"citations"`       // Sources referenced by the markers
+	Model     string        `json:"model,omitempty"` // LLM model that produced the answer
+	Took      time.Duration `json:"took" swaggertype:"integer" example:"1500000"`
+}
+
+// Citation maps an inline [n] marker back to the chunk it was drawn from
+type Citation struct {
+	Index      int    `json:"index"` // Number used in the answer text
+	DocumentID string `json:"document_id"`
+	ChunkID    string `json:"chunk_id"`
+	SourceID   string `json:"source_id"`
+	Title      string `json:"title,omitempty"`
+	Path       string `json:"path,omitempty"`
+	StartChar  int    `json:"start_char"` // Chunk offsets within the document
+	EndChar    int    `json:"end_char"`
+	Snippet    string `json:"snippet,omitempty"`
+}
+
+// SummaryEventType identifies an event in a streamed search summary
+type SummaryEventType string
+
+const (
+	SummaryEventResults SummaryEventType = "results" // Search hits, sent first
+	SummaryEventToken   SummaryEventType = "token"   // Incremental summary text
+	SummaryEventSummary SummaryEventType = "summary" // Final summary with citations
+	SummaryEventError   SummaryEventType = "error"   // Summary could not be generated
+)
+
+// SummaryEvent is a single event in a streamed search summary
+type SummaryEvent struct {
+	Type    SummaryEventType `json:"type"`
+	Results *SearchResult    `json:"results,omitempty"`
+	Token   string           `json:"token,omitempty"`
+	Summary *Answer          `json:"summary,omitempty"`
+	Error   string           `json:"error,omitempty"`
+}
@@ -4,16 +4,17 @@ import "time"
 
 // Document represents an indexed document from a source
 type Document struct {
-	ID         string            `json:"id"`
-	SourceID   string            `json:"source_id"`
-	ExternalID string            `json:"external_id"` // ID from the source system
-	Path       string            `json:"path"`        // Path or URL in source
-	Title      string            `json:"title"`
-	MimeType   string            `json:"mime_type"`
-	Metadata   map[string]string `json:"metadata"`
-	CreatedAt  time.Time         `json:"created_at"`
-	UpdatedAt  time.Time         `json:"updated_at"`
-	IndexedAt  time.Time         `json:"indexed_at"`
+	ID          string            `json:"id"`
+	SourceID    string            `json:"source_id"`
+	ExternalID  string            `json:"external_id"` // ID from the source system
+	Path        string            `json:"path"`        // Path or URL in source
+	Title       string            `json:"title"`
+	MimeType    string            `json:"mime_type"`
+	Metadata    map[string]string `json:"metadata"`
+	ContentHash string            `json:"content_hash,omitempty"` // Hash of the last indexed content
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+	IndexedAt   time.Time         `json:"indexed_at"`
 }
 
 // Chunk represents a searchable chunk of a document
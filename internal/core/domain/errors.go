@@ -1,6 +1,10 @@
 package domain
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
 
 // Domain errors - used across all layers
 var (
@@ -22,6 +26,9 @@ var (
 	// ErrSyncInProgress indicates a sync is already running
 	ErrSyncInProgress = errors.New("sync already in progress")
 
+	// ErrSyncCancelled indicates a sync was stopped by a cancellation request
+	ErrSyncCancelled = errors.New("sync cancelled")
+
 	// ErrConnectorNotFound indicates the connector type is not registered
 	ErrConnectorNotFound = errors.New("connector not found")
 
@@ -54,4 +61,30 @@ var (
 
 	// ErrInUse indicates the resource is in use and cannot be deleted
 	ErrInUse = errors.New("resource in use")
+
+	// ErrRateLimited indicates an external provider rejected a request because of rate limiting
+	ErrRateLimited = errors.New("rate limited")
 )
+
+// RateLimitError reports that an external provider rate limited a request.
+// It matches ErrRateLimited with errors.Is.
+type RateLimitError struct {
+	// RetryAfter is how long the provider asked to wait, zero if unknown
+	RetryAfter time.Duration
+	Err        error
+}
+
+func (e *RateLimitError) Error() string {
+	msg := ErrRateLimited.Error()
+	if e.RetryAfter > 0 {
+		msg = fmt.Sprintf("%s, retry after %s", msg, e.RetryAfter)
+	}
+	if e.Err != nil {
+		msg = fmt.Sprintf("%s: %v", msg, e.Err)
+	}
+	return msg
+}
+
+func (e *RateLimitError) Unwrap() error { return e.Err }
+
+func (e *RateLimitError) Is(target error) bool { return target == ErrRateLimited }
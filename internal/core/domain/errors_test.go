@@ -2,7 +2,9 @@ package domain
 
 import (
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 )
 
 func TestErrors(t *testing.T) {
@@ -22,6 +24,7 @@ func TestErrors(t *testing.T) {
 		{"ErrTokenInvalid", ErrTokenInvalid, "token invalid"},
 		{"ErrSessionNotFound", ErrSessionNotFound, "session not found"},
 		{"ErrInvalidCredentials", ErrInvalidCredentials, "invalid credentials"},
+		{"ErrRateLimited", ErrRateLimited, "rate limited"},
 	}
 
 	for _, tt := range tests {
@@ -46,6 +49,7 @@ func TestErrorsAreDistinct(t *testing.T) {
 		ErrTokenInvalid,
 		ErrSessionNotFound,
 		ErrInvalidCredentials,
+		ErrRateLimited,
 	}
 
 	for i, err1 := range allErrors {
@@ -67,3 +71,19 @@ func TestErrorsIs(t *testing.T) {
 		t.Error("ErrNotFound should not match ErrUnauthorized")
 	}
 }
+
+func TestRateLimitError(t *testing.T) {
+	err := fmt.Errorf("fetch issues: %w", &RateLimitError{RetryAfter: time.Minute, Err: errors.New("API error 429")})
+
+	if !errors.Is(err, ErrRateLimited) {
+		t.Error("expected RateLimitError to match ErrRateLimited")
+	}
+
+	var rateLimitErr *RateLimitError
+	if !errors.As(err, &rateLimitErr) || rateLimitErr.RetryAfter != time.Minute {
+		t.Errorf("expected RateLimitError with RetryAfter, got %v", err)
+	}
+	if err.Error() != "fetch issues: rate limited, retry after 1m0s: API error 429" {
+		t.Errorf("unexpected message %q", err.Error())
+	}
+}
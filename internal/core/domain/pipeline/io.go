@@ -93,4 +93,19 @@ type ParsedQuery struct {
 	Phrases    []string `json:"phrases,omitempty"`
 	Filters    []string `json:"filters,omitempty"` // Extracted filter expressions
 	Intent     string   `json:"intent,omitempty"`  // Detected intent
+
+	// Scope holds the caller's filters, applied to every retrieval
+	Scope SearchFilters `json:"scope"`
+}
+
+// ExpandedQuery is a parsed query enriched with weighted alternative terms.
+type ExpandedQuery struct {
+	ParsedQuery
+	Expansions []WeightedTerm `json:"expansions,omitempty"`
+}
+
+// WeightedTerm is an alternative search term with a relative weight in (0, 1].
+type WeightedTerm struct {
+	Term   string  `json:"term"`
+	Weight float64 `json:"weight"`
 }
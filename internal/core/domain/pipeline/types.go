@@ -30,6 +30,16 @@ const (
 	ShapePresentedResult ShapeName = "presented_result"
 )
 
+// Satisfies reports whether data of this shape can be consumed by a stage
+// expecting the required shape. An expanded query carries the full parsed
+// query, so it satisfies stages that expect a parsed query.
+func (s ShapeName) Satisfies(required ShapeName) bool {
+	if s == required {
+		return true
+	}
+	return s == ShapeExpandedQuery && required == ShapeParsedQuery
+}
+
 // CapabilityType identifies external dependencies a stage may require.
 type CapabilityType string
 
@@ -0,0 +1,297 @@
+package domain
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SourceRules decides which of a source's documents are indexed. A document
+// is indexed only if it passes every rule that is set; unset rules pass.
+// The rules apply to every connector alike and are evaluated by the sync
+// orchestrator, after a connector's own exclusions.
+type SourceRules struct {
+	// IncludePaths are glob patterns of the paths to index; empty indexes
+	// every path. "*" matches within a path segment and "**" across
+	// segments; a pattern without "/" matches any file or directory name.
+	// A document's path is its "file_path" metadata if set, else its Path.
+	IncludePaths []string `json:"include_paths,omitempty"`
+
+	// ExcludePaths are glob patterns of paths never indexed, taking
+	// precedence over IncludePaths
+	ExcludePaths []string `json:"exclude_paths,omitempty"`
+
+	// IncludeMimeTypes are the MIME types to index, where "text/*" matches
+	// a whole type; empty indexes every MIME type
+	IncludeMimeTypes []string `json:"include_mime_types,omitempty"`
+
+	// ExcludeMimeTypes are MIME types never indexed
+	ExcludeMimeTypes []string `json:"exclude_mime_types,omitempty"`
+
+	// MaxSizeBytes is the largest document indexed (0 for no limit). The
+	// size is the connector's "size" metadata, or else the content length.
+	MaxSizeBytes int64 `json:"max_size_bytes,omitempty"`
+
+	// TitlePattern is a regular expression titles must match
+	TitlePattern string `json:"title_pattern,omitempty"`
+
+	// ExcludeTitlePattern is a regular expression of titles never indexed
+	ExcludeTitlePattern string `json:"exclude_title_pattern,omitempty"`
+
+	// Metadata are predicates on the document metadata that must all hold
+	Metadata []MetadataRule `json:"metadata,omitempty"`
+
+	// MaxAgeDays excludes documents last modified in the source more than
+	// this many days ago (0 for no limit). Documents without a modification
+	// time pass.
+	MaxAgeDays int `json:"max_age_days,omitempty"`
+}
+
+// MetadataOperator is how a MetadataRule compares a metadata value
+type MetadataOperator string
+
+const (
+	MetadataEquals    MetadataOperator = "equals"
+	MetadataNotEquals MetadataOperator = "not_equals"
+	MetadataExists    MetadataOperator = "exists"
+	MetadataNotExists MetadataOperator = "not_exists"
+	MetadataMatches   MetadataOperator = "matches" // Value is a regular expression
+)
+
+// MetadataRule is a predicate on one metadata key of a document
+type MetadataRule struct {
+	Key      string           `json:"key"`
+	Operator MetadataOperator `json:"operator"`
+	Value    string           `json:"value,omitempty"`
+}
+
+// RuleSet is a compiled SourceRules, ready to evaluate documents.
+// The zero value includes every document.
+type RuleSet struct {
+	rules        SourceRules
+	titleInclude *regexp.Regexp
+	titleExclude *regexp.Regexp
+	metadata     []*regexp.Regexp // Compiled values of matches rules, by index
+}
+
+// CompileRules validates and compiles rules; nil rules include every
+// document. Invalid patterns and operators are reported as ErrInvalidInput.
+func CompileRules(rules *SourceRules) (*RuleSet, error) {
+	set := &RuleSet{}
+	if rules == nil {
+		return set, nil
+	}
+	set.rules = *rules
+
+	for _, pattern := range append(append([]string{}, rules.IncludePaths...), rules.ExcludePaths...) {
+		if _, err := path.Match(strings.ReplaceAll(pattern, "**", "*"), ""); err != nil {
+			return nil, fmt.Errorf("%w: invalid path pattern %q", ErrInvalidInput, pattern)
+		}
+	}
+	if rules.MaxSizeBytes < 0 || rules.MaxAgeDays < 0 {
+		return nil, fmt.Errorf("%w: size and age limits must not be negative", ErrInvalidInput)
+	}
+
+	var err error
+	if rules.TitlePattern != "" {
+		if set.titleInclude, err = regexp.Compile(rules.TitlePattern); err != nil {
+			return nil, fmt.Errorf("%w: invalid title pattern: %v", ErrInvalidInput, err)
+		}
+	}
+	if rules.ExcludeTitlePattern != "" {
+		if set.titleExclude, err = regexp.Compile(rules.ExcludeTitlePattern); err != nil {
+			return nil, fmt.Errorf("%w: invalid exclude title pattern: %v", ErrInvalidInput, err)
+		}
+	}
+
+	set.metadata = make([]*regexp.Regexp, len(rules.Metadata))
+	for i, rule := range rules.Metadata {
+		if rule.Key == "" {
+			return nil, fmt.Errorf("%w: metadata rule without key", ErrInvalidInput)
+		}
+		switch rule.Operator {
+		case MetadataEquals, MetadataNotEquals, MetadataExists, MetadataNotExists:
+		case MetadataMatches:
+			if set.metadata[i], err = regexp.Compile(rule.Value); err != nil {
+				return nil, fmt.Errorf("%w: invalid metadata pattern for %q: %v", ErrInvalidInput, rule.Key, err)
+			}
+		default:
+			return nil, fmt.Errorf("%w: unknown metadata operator %q", ErrInvalidInput, rule.Operator)
+		}
+	}
+
+	return set, nil
+}
+
+// Exclusion returns why the rules exclude doc, or "" if it is included.
+// size is the content length, used when the document has no "size"
+// metadata; doc.UpdatedAt is taken as its modification time in the source.
+func (s *RuleSet) Exclusion(doc *Document, size int64, now time.Time) string {
+	r := &s.rules
+
+	docPath := doc.Path
+	if filePath := doc.Metadata["file_path"]; filePath != "" {
+		docPath = filePath
+	}
+	if len(r.IncludePaths) > 0 && !matchAnyPath(r.IncludePaths, docPath) {
+		return "path not included"
+	}
+	if matchAnyPath(r.ExcludePaths, docPath) {
+		return "path excluded"
+	}
+
+	if len(r.IncludeMimeTypes) > 0 && !matchAnyMimeType(r.IncludeMimeTypes, doc.MimeType) {
+		return "mime type not included"
+	}
+	if matchAnyMimeType(r.ExcludeMimeTypes, doc.MimeType) {
+		return "mime type excluded"
+	}
+
+	if r.MaxSizeBytes > 0 {
+		if metaSize, err := strconv.ParseInt(doc.Metadata["size"], 10, 64); err == nil {
+			size = metaSize
+		}
+		if size > r.MaxSizeBytes {
+			return fmt.Sprintf("larger than %d bytes", r.MaxSizeBytes)
+		}
+	}
+
+	if s.titleInclude != nil && !s.titleInclude.MatchString(doc.Title) {
+		return "title not included"
+	}
+	if s.titleExclude != nil && s.titleExclude.MatchString(doc.Title) {
+		return "title excluded"
+	}
+
+	for i, rule := range r.Metadata {
+		value, ok := doc.Metadata[rule.Key]
+		var pass bool
+		switch rule.Operator {
+		case MetadataEquals:
+			pass = ok && value == rule.Value
+		case MetadataNotEquals:
+			pass = !ok || value != rule.Value
+		case MetadataExists:
+			pass = ok
+		case MetadataNotExists:
+			pass = !ok
+		case MetadataMatches:
+			pass = ok && s.metadata[i].MatchString(value)
+		}
+		if !pass {
+			return fmt.Sprintf("metadata %q not %s", rule.Key, rule.Operator)
+		}
+	}
+
+	if r.MaxAgeDays > 0 && !doc.UpdatedAt.IsZero() &&
+		doc.UpdatedAt.Before(now.AddDate(0, 0, -r.MaxAgeDays)) {
+		return fmt.Sprintf("older than %d days", r.MaxAgeDays)
+	}
+
+	return ""
+}
+
+// matchAnyPath reports whether p matches any of the glob patterns.
+func matchAnyPath(patterns []string, p string) bool {
+	p = strings.Trim(p, "/")
+	for _, pattern := range patterns {
+		if matchPath(strings.Trim(pattern, "/"), p) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchPath matches p against a glob pattern. A pattern without "/" matches
+// any segment of p; otherwise it matches p itself or one of its parent
+// directories, with "**" matching any number of segments.
+func matchPath(pattern, p string) bool {
+	segments := strings.Split(p, "/")
+	if !strings.Contains(pattern, "/") && pattern != "**" {
+		for _, segment := range segments {
+			if ok, _ := path.Match(pattern, segment); ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	parts := strings.Split(pattern, "/")
+	for end := len(segments); end > 0; end-- {
+		if matchSegments(parts, segments[:end]) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchSegments matches path segments against pattern segments, where a
+// "**" pattern segment matches zero or more path segments.
+func matchSegments(parts, segments []string) bool {
+	if len(parts) == 0 {
+		return len(segments) == 0
+	}
+	if parts[0] == "**" {
+		for i := 0; i <= len(segments); i++ {
+			if matchSegments(parts[1:], segments[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(segments) == 0 {
+		return false
+	}
+	if ok, _ := path.Match(parts[0], segments[0]); !ok {
+		return false
+	}
+	return matchSegments(parts[1:], segments[1:])
+}
+
+// matchAnyMimeType reports whether mimeType, ignoring parameters, matches
+// any of the patterns ("text/plain" or "text/*").
+func matchAnyMimeType(patterns []string, mimeType string) bool {
+	mimeType = strings.ToLower(strings.TrimSpace(mimeType))
+	if idx := strings.Index(mimeType, ";"); idx != -1 {
+		mimeType = strings.TrimSpace(mimeType[:idx])
+	}
+	for _, pattern := range patterns {
+		pattern = strings.ToLower(strings.TrimSpace(pattern))
+		if prefix, ok := strings.CutSuffix(pattern, "/*"); ok {
+			if strings.HasPrefix(mimeType, prefix+"/") {
+				return true
+			}
+		} else if pattern == mimeType {
+			return true
+		}
+	}
+	return false
+}
+
+// RulesPreview reports how replacing a source's rules would change the
+// documents it indexes, from an enumeration of the source
+type RulesPreview struct {
+	SourceID string `json:"source_id"`
+	Scanned  int    `json:"scanned"`  // Documents enumerated
+	Included int    `json:"included"` // Documents the proposed rules index
+
+	// Added are documents the current rules exclude but the proposed rules
+	// index, and Removed the reverse. The lists are capped; the counts are
+	// the totals.
+	Added        []RulesPreviewDocument `json:"added"`
+	AddedCount   int                    `json:"added_count"`
+	Removed      []RulesPreviewDocument `json:"removed"`
+	RemovedCount int                    `json:"removed_count"`
+}
+
+// RulesPreviewDocument is a document whose indexing a rule change affects
+type RulesPreviewDocument struct {
+	ExternalID  string `json:"external_id"`
+	ContainerID string `json:"container_id,omitempty"`
+	Path        string `json:"path"`
+	Title       string `json:"title"`
+	Reason      string `json:"reason"` // Why the excluding rules exclude it
+}
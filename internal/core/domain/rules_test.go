@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRuleSet_Exclusion(t *testing.T) {
+	now := time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+	doc := &Document{
+		Path:      "https://github.com/acme/app/blob/main/docs/guide/intro.md",
+		Title:     "Intro",
+		MimeType:  "text/markdown; charset=utf-8",
+		Metadata:  map[string]string{"file_path": "docs/guide/intro.md", "size": "2048", "state": "open"},
+		UpdatedAt: now.AddDate(0, 0, -10),
+	}
+
+	tests := []struct {
+		name     string
+		rules    *SourceRules
+		excluded bool
+	}{
+		{"no rules", nil, false},
+		{"include glob", &SourceRules{IncludePaths: []string{"docs/**"}}, false},
+		{"include glob misses", &SourceRules{IncludePaths: []string{"src/**"}}, true},
+		{"include file pattern", &SourceRules{IncludePaths: []string{"docs/**/*.md"}}, false},
+		{"exclude directory name", &SourceRules{ExcludePaths: []string{"guide"}}, true},
+		{"exclude extension", &SourceRules{ExcludePaths: []string{"*.md"}}, true},
+		{"exclude wins over include", &SourceRules{IncludePaths: []string{"docs"}, ExcludePaths: []string{"docs/guide"}}, true},
+		{"include mime type family", &SourceRules{IncludeMimeTypes: []string{"text/*"}}, false},
+		{"include other mime type", &SourceRules{IncludeMimeTypes: []string{"application/pdf"}}, true},
+		{"exclude mime type", &SourceRules{ExcludeMimeTypes: []string{"text/markdown"}}, true},
+		{"within max size", &SourceRules{MaxSizeBytes: 4096}, false},
+		{"over max size", &SourceRules{MaxSizeBytes: 1024}, true},
+		{"title pattern", &SourceRules{TitlePattern: "^In"}, false},
+		{"title pattern misses", &SourceRules{TitlePattern: "^Draft"}, true},
+		{"exclude title pattern", &SourceRules{ExcludeTitlePattern: "(?i)intro"}, true},
+		{"metadata equals", &SourceRules{Metadata: []MetadataRule{{Key: "state", Operator: MetadataEquals, Value: "open"}}}, false},
+		{"metadata not equals", &SourceRules{Metadata: []MetadataRule{{Key: "state", Operator: MetadataNotEquals, Value: "open"}}}, true},
+		{"metadata exists", &SourceRules{Metadata: []MetadataRule{{Key: "author", Operator: MetadataExists}}}, true},
+		{"metadata not exists", &SourceRules{Metadata: []MetadataRule{{Key: "author", Operator: MetadataNotExists}}}, false},
+		{"metadata matches", &SourceRules{Metadata: []MetadataRule{{Key: "state", Operator: MetadataMatches, Value: "^(open|draft)$"}}}, false},
+		{"within max age", &SourceRules{MaxAgeDays: 30}, false},
+		{"over max age", &SourceRules{MaxAgeDays: 7}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set, err := CompileRules(tt.rules)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			reason := set.Exclusion(doc, 100, now)
+			if (reason != "") != tt.excluded {
+				t.Errorf("expected excluded=%v, got reason %q", tt.excluded, reason)
+			}
+		})
+	}
+}
+
+func TestRuleSet_Exclusion_ContentSize(t *testing.T) {
+	set, _ := CompileRules(&SourceRules{MaxSizeBytes: 10})
+	doc := &Document{Path: "notes.txt"}
+
+	if reason := set.Exclusion(doc, 5, time.Now()); reason != "" {
+		t.Errorf("expected small content to be included, got %q", reason)
+	}
+	if reason := set.Exclusion(doc, 50, time.Now()); reason == "" {
+		t.Error("expected content over the limit to be excluded")
+	}
+}
+
+func TestCompileRules_Invalid(t *testing.T) {
+	for name, rules := range map[string]*SourceRules{
+		"path pattern":     {ExcludePaths: []string{"[docs"}},
+		"title pattern":    {TitlePattern: "("},
+		"negative size":    {MaxSizeBytes: -1},
+		"metadata key":     {Metadata: []MetadataRule{{Operator: MetadataExists}}},
+		"metadata op":      {Metadata: []MetadataRule{{Key: "state", Operator: "contains"}}},
+		"metadata pattern": {Metadata: []MetadataRule{{Key: "state", Operator: MetadataMatches, Value: "["}}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := CompileRules(rules); !errors.Is(err, ErrInvalidInput) {
+				t.Errorf("expected ErrInvalidInput, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,277 @@
+package domain
+
+import (
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MinScheduleInterval is the shortest interval a sync schedule may use
+const MinScheduleInterval = time.Minute
+
+// Schedule determines when a source is synced automatically.
+// It is either a fixed interval or a cron expression.
+type Schedule struct {
+	interval time.Duration
+	cron     *cronSpec
+}
+
+// IntervalSchedule returns a schedule repeating every interval
+func IntervalSchedule(interval time.Duration) Schedule {
+	return Schedule{interval: interval}
+}
+
+// ParseSchedule parses a sync schedule expression, which is one of:
+//   - an interval as a duration ("5m", "24h") or "@every <duration>"
+//   - a five-field cron expression ("*/5 * * * *", "0 2 * * 1-5"),
+//     evaluated in UTC
+//   - a descriptor: @hourly, @daily, @midnight, @weekly, @monthly, @yearly, @annually
+func ParseSchedule(expr string) (Schedule, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return Schedule{}, fmt.Errorf("%w: empty schedule", ErrInvalidInput)
+	}
+
+	if every, ok := strings.CutPrefix(expr, "@every "); ok {
+		return parseInterval(strings.TrimSpace(every))
+	}
+	if descriptor, ok := cronDescriptors[expr]; ok {
+		expr = descriptor
+	}
+	if strings.HasPrefix(expr, "@") {
+		return Schedule{}, fmt.Errorf("%w: unknown schedule descriptor %q", ErrInvalidInput, expr)
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) == 1 {
+		return parseInterval(expr)
+	}
+	return parseCron(fields)
+}
+
+// IsZero reports whether the schedule is unset
+func (s Schedule) IsZero() bool {
+	return s.interval <= 0 && s.cron == nil
+}
+
+// Next returns the first scheduled time after t.
+// It returns the zero time for an unset schedule.
+func (s Schedule) Next(t time.Time) time.Time {
+	switch {
+	case s.cron != nil:
+		return s.cron.next(t)
+	case s.interval > 0:
+		return t.Add(s.interval)
+	default:
+		return time.Time{}
+	}
+}
+
+// parseInterval parses a duration schedule
+func parseInterval(expr string) (Schedule, error) {
+	interval, err := time.ParseDuration(expr)
+	if err != nil {
+		return Schedule{}, fmt.Errorf("%w: invalid schedule %q: expected a duration or cron expression", ErrInvalidInput, expr)
+	}
+	if interval < MinScheduleInterval {
+		return Schedule{}, fmt.Errorf("%w: schedule interval %s is shorter than %s", ErrInvalidInput, interval, MinScheduleInterval)
+	}
+	return IntervalSchedule(interval), nil
+}
+
+// cronDescriptors maps descriptors to their cron expressions
+var cronDescriptors = map[string]string{
+	"@hourly":   "0 * * * *",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@weekly":   "0 0 * * 0",
+	"@monthly":  "0 0 1 * *",
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+}
+
+// cronSpec holds the allowed values of each cron field as bitsets
+type cronSpec struct {
+	minute, hour, dom, month, dow uint64
+
+	// Standard cron semantics: when both day fields are restricted a day
+	// matches if either does
+	domAny, dowAny bool
+}
+
+// cronField describes the range and value names of a cron field
+type cronField struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	cronMinute = cronField{name: "minute", min: 0, max: 59}
+	cronHour   = cronField{name: "hour", min: 0, max: 23}
+	cronDom    = cronField{name: "day of month", min: 1, max: 31}
+	cronMonth  = cronField{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// 7 is accepted as Sunday and folded onto 0
+	cronDow = cronField{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+// cronSearchYears bounds the search for the next match. Leap days are at
+// most eight years apart, so any satisfiable expression matches within it.
+const cronSearchYears = 9
+
+// parseCron parses the five fields of a cron expression
+func parseCron(fields []string) (Schedule, error) {
+	if len(fields) != 5 {
+		return Schedule{}, fmt.Errorf("%w: cron expression needs 5 fields, got %d", ErrInvalidInput, len(fields))
+	}
+
+	spec := &cronSpec{
+		domAny: fields[2] == "*" || fields[2] == "?",
+		dowAny: fields[4] == "*" || fields[4] == "?",
+	}
+	var err error
+	for _, f := range []struct {
+		expr  string
+		field cronField
+		bits  *uint64
+	}{
+		{fields[0], cronMinute, &spec.minute},
+		{fields[1], cronHour, &spec.hour},
+		{fields[2], cronDom, &spec.dom},
+		{fields[3], cronMonth, &spec.month},
+		{fields[4], cronDow, &spec.dow},
+	} {
+		if *f.bits, err = parseCronField(f.expr, f.field); err != nil {
+			return Schedule{}, err
+		}
+	}
+	if spec.dow&(1<<7) != 0 {
+		spec.dow = spec.dow&^(1<<7) | 1
+	}
+
+	// Reject expressions such as "0 0 30 2 *" that never fire
+	from := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	if spec.next(from).IsZero() {
+		return Schedule{}, fmt.Errorf("%w: cron expression %q never matches", ErrInvalidInput, strings.Join(fields, " "))
+	}
+
+	return Schedule{cron: spec}, nil
+}
+
+// parseCronField parses a comma-separated list of values, ranges and steps
+func parseCronField(expr string, field cronField) (uint64, error) {
+	var set uint64
+	for _, item := range strings.Split(expr, ",") {
+		rangeExpr, stepExpr, hasStep := strings.Cut(item, "/")
+
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepExpr)
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("%w: invalid step %q in cron %s field", ErrInvalidInput, stepExpr, field.name)
+			}
+			step = n
+		}
+
+		lo, hi := field.min, field.max
+		switch {
+		case rangeExpr == "*" || rangeExpr == "?":
+		case strings.Contains(rangeExpr, "-"):
+			loExpr, hiExpr, _ := strings.Cut(rangeExpr, "-")
+			var err error
+			if lo, err = parseCronValue(loExpr, field); err != nil {
+				return 0, err
+			}
+			if hi, err = parseCronValue(hiExpr, field); err != nil {
+				return 0, err
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("%w: invalid range %q in cron %s field", ErrInvalidInput, rangeExpr, field.name)
+			}
+		default:
+			v, err := parseCronValue(rangeExpr, field)
+			if err != nil {
+				return 0, err
+			}
+			lo = v
+			if !hasStep {
+				hi = v // "5/15" means 5-max/15, "5" just 5
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			set |= 1 << uint(v)
+		}
+	}
+	return set, nil
+}
+
+// parseCronValue parses a single number or name within a field's range
+func parseCronValue(expr string, field cronField) (int, error) {
+	if v, ok := field.names[strings.ToLower(expr)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(expr)
+	if err != nil || v < field.min || v > field.max {
+		return 0, fmt.Errorf("%w: invalid value %q in cron %s field (%d-%d)", ErrInvalidInput, expr, field.name, field.min, field.max)
+	}
+	return v, nil
+}
+
+// next returns the first matching minute after t, in UTC, or the zero time
+// if there is none within cronSearchYears
+func (c *cronSpec) next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(cronSearchYears, 0, 0)
+
+	for t.Before(limit) {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !c.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			t = t.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+		if c.minute&(1<<uint(t.Minute())) == 0 {
+			// Jump straight to the next allowed minute in this hour, if any
+			rest := c.minute >> uint(t.Minute())
+			if rest == 0 {
+				t = t.Truncate(time.Hour).Add(time.Hour)
+			} else {
+				t = t.Add(time.Duration(bits.TrailingZeros64(rest)) * time.Minute)
+			}
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// dayMatches reports whether the day of t matches the day-of-month and
+// day-of-week fields
+func (c *cronSpec) dayMatches(t time.Time) bool {
+	dom := c.dom&(1<<uint(t.Day())) != 0
+	dow := c.dow&(1<<uint(t.Weekday())) != 0
+	switch {
+	case c.domAny && c.dowAny:
+		return true
+	case c.domAny:
+		return dow
+	case c.dowAny:
+		return dom
+	default:
+		return dom || dow
+	}
+}
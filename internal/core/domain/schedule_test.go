@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseSchedule_Next(t *testing.T) {
+	// Wednesday
+	from := time.Date(2026, 3, 4, 10, 7, 30, 0, time.UTC)
+
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"5m", from.Add(5 * time.Minute)},
+		{"@every 24h", from.Add(24 * time.Hour)},
+		{"*/15 * * * *", time.Date(2026, 3, 4, 10, 15, 0, 0, time.UTC)},
+		{"0 2 * * *", time.Date(2026, 3, 5, 2, 0, 0, 0, time.UTC)},
+		{"30 9 * * mon-fri", time.Date(2026, 3, 5, 9, 30, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)},
+		{"0 12 1,15 * *", time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)},
+		{"0 0 13 * fri", time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC)}, // Day fields are OR'd
+		{"5/20 10 * * *", time.Date(2026, 3, 4, 10, 25, 0, 0, time.UTC)},
+		{"0 0 29 feb *", time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 jan *", time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			schedule, err := ParseSchedule(tt.expr)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := schedule.Next(from); !got.Equal(tt.want) {
+				t.Errorf("expected next run %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestParseSchedule_Invalid(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"30s",
+		"soon",
+		"@often",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"0 0 0 * *",
+		"0 0 * 13 *",
+		"*/0 * * * *",
+		"10-5 * * * *",
+		"0 0 30 feb *",
+	} {
+		t.Run(expr, func(t *testing.T) {
+			if _, err := ParseSchedule(expr); !errors.Is(err, ErrInvalidInput) {
+				t.Errorf("expected ErrInvalidInput, got %v", err)
+			}
+		})
+	}
+}
+
+func TestSchedule_Zero(t *testing.T) {
+	var schedule Schedule
+	if !schedule.IsZero() || !schedule.Next(time.Now()).IsZero() {
+		t.Error("expected unset schedule to never run")
+	}
+	if IntervalSchedule(time.Hour).IsZero() {
+		t.Error("expected interval schedule to be set")
+	}
+}
+
+func TestSource_Schedule(t *testing.T) {
+	def := IntervalSchedule(time.Hour)
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	source := &Source{}
+	schedule, err := source.Schedule(def)
+	if err != nil || !schedule.Next(from).Equal(from.Add(time.Hour)) {
+		t.Errorf("expected the default schedule, got %v (%v)", schedule.Next(from), err)
+	}
+
+	source.SyncSchedule = "5m"
+	schedule, _ = source.Schedule(def)
+	if !schedule.Next(from).Equal(from.Add(5 * time.Minute)) {
+		t.Errorf("expected the source schedule, got %v", schedule.Next(from))
+	}
+
+	source.SyncSchedule = "bogus"
+	if _, err := source.Schedule(def); err == nil {
+		t.Error("expected error for invalid schedule")
+	}
+}
@@ -20,6 +20,20 @@ type Source struct {
 	// Empty means index all accessible containers
 	// Examples: ["owner/repo1", "owner/repo2"] for GitHub
 	SelectedContainers []string `json:"selected_containers,omitempty"`
+
+	// SyncSchedule is when the source is synced automatically, as an
+	// interval ("5m") or cron expression ("0 2 * * *"); see ParseSchedule.
+	// Empty uses the team's SyncIntervalMinutes setting.
+	SyncSchedule string `json:"sync_schedule,omitempty"`
+}
+
+// Schedule returns the source's sync schedule, or def if it has none.
+// An invalid schedule is reported as an error.
+func (s *Source) Schedule(def Schedule) (Schedule, error) {
+	if s.SyncSchedule == "" {
+		return def, nil
+	}
+	return ParseSchedule(s.SyncSchedule)
 }
 
 // SourceConfig holds provider-specific configuration
@@ -53,6 +67,9 @@ type SourceConfig struct {
 	// Generic
 	BaseURL string            `json:"base_url,omitempty"`
 	Extra   map[string]string `json:"extra,omitempty"`
+
+	// Rules decide which documents are indexed, whatever the provider
+	Rules *SourceRules `json:"rules,omitempty"`
 }
 
 // SourceSummary provides a summary of a source's state
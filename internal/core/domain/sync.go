@@ -1,6 +1,9 @@
 package domain
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 // SyncStatus represents the current state of a sync operation
 type SyncStatus string
@@ -10,6 +13,11 @@ const (
 	SyncStatusRunning   SyncStatus = "running"
 	SyncStatusCompleted SyncStatus = "completed"
 	SyncStatusFailed    SyncStatus = "failed"
+
+	// SyncStatusCancelling means cancellation was requested and the running
+	// sync will stop after the document it is processing
+	SyncStatusCancelling SyncStatus = "cancelling"
+	SyncStatusCancelled  SyncStatus = "cancelled"
 )
 
 // SyncState tracks the sync state for a source
@@ -23,15 +31,50 @@ type SyncState struct {
 	Error       string     `json:"error,omitempty"`
 	StartedAt   *time.Time `json:"started_at,omitempty"`
 	CompletedAt *time.Time `json:"completed_at,omitempty"`
+
+	// Checkpoint is the progress of an unfinished sync, which the next sync
+	// resumes from. Nil once a sync finishes.
+	Checkpoint *SyncCheckpoint `json:"checkpoint,omitempty"`
+}
+
+// SyncCheckpoint records the progress of a sync so that a sync interrupted
+// by a crash, shutdown or cancellation resumes where it stopped instead of
+// starting over
+type SyncCheckpoint struct {
+	Cursor              string            `json:"cursor,omitempty"` // Cursor the sync started from
+	Full                bool              `json:"full"`
+	CompletedContainers []string          `json:"completed_containers,omitempty"`
+	PageTokens          map[string]string `json:"page_tokens,omitempty"` // Next page of each container in progress
+	NextCursor          string            `json:"next_cursor,omitempty"` // Cursor returned by completed containers
+	Stats               SyncStats         `json:"stats"`                 // Stats up to the checkpoint
+	UpdatedAt           time.Time         `json:"updated_at"`
+
+	// Seen holds the external IDs enumerated so far by a sync without a
+	// cursor, needed to reconcile deletions once it finishes. It can be
+	// large, so it is only loaded with SyncStateStore.GetCheckpoint.
+	Seen []string `json:"-"`
+}
+
+// ChangePage is one page of changes from a PagedConnector
+type ChangePage struct {
+	Changes []*Change
+
+	// NextPageToken fetches the next page; empty on the last page
+	NextPageToken string
+
+	// Cursor is the sync cursor for the next sync, set on the last page
+	Cursor string
 }
 
 // SyncStats holds statistics for a sync operation
 type SyncStats struct {
-	DocumentsAdded   int `json:"documents_added"`
-	DocumentsUpdated int `json:"documents_updated"`
-	DocumentsDeleted int `json:"documents_deleted"`
-	ChunksIndexed    int `json:"chunks_indexed"`
-	Errors           int `json:"errors"`
+	DocumentsAdded    int `json:"documents_added"`
+	DocumentsUpdated  int `json:"documents_updated"`
+	DocumentsDeleted  int `json:"documents_deleted"`
+	DocumentsSkipped  int `json:"documents_skipped"`  // Unchanged since last indexed
+	DocumentsExcluded int `json:"documents_excluded"` // Excluded by the source's rules
+	ChunksIndexed     int `json:"chunks_indexed"`
+	Errors            int `json:"errors"`
 }
 
 // ChangeType indicates what happened to a document
@@ -45,11 +88,184 @@ const (
 
 // Change represents a document change from a connector
 type Change struct {
-	Type       ChangeType `json:"type"`
-	Document   *Document  `json:"document,omitempty"`   // For added/modified
-	Content    string     `json:"content,omitempty"`    // Raw content for added/modified
-	DeletedID  string     `json:"deleted_id,omitempty"` // For deleted
-	ExternalID string     `json:"external_id"`          // ID from source system
+	Type        ChangeType `json:"type"`
+	Document    *Document  `json:"document,omitempty"`     // For added/modified
+	Content     string     `json:"content,omitempty"`      // Raw content for added/modified
+	DeletedID   string     `json:"deleted_id,omitempty"`   // For deleted
+	ExternalID  string     `json:"external_id"`            // ID from source system
+	ContentHash string     `json:"content_hash,omitempty"` // Optional content version (e.g. blob SHA); hashed from Content when empty
+}
+
+// SyncTrigger identifies what started a sync
+type SyncTrigger string
+
+const (
+	SyncTriggerManual    SyncTrigger = "manual"
+	SyncTriggerScheduled SyncTrigger = "scheduled"
+	SyncTriggerWebhook   SyncTrigger = "webhook"
+	SyncTriggerRetry     SyncTrigger = "retry" // Retry of failed documents
+)
+
+// SyncOptions controls how a sync runs
+type SyncOptions struct {
+	// Full ignores the sync cursor and refetches every document, deleting
+	// documents the source no longer has
+	Full bool
+
+	// Trigger records what started the sync (default manual)
+	Trigger SyncTrigger
+}
+
+// MaxSyncRunErrors is how many per-document errors a SyncRun keeps
+const MaxSyncRunErrors = 50
+
+// SyncRun records one sync of a source, kept as sync history
+type SyncRun struct {
+	ID          string         `json:"id"`
+	SourceID    string         `json:"source_id"`
+	Trigger     SyncTrigger    `json:"trigger"`
+	Full        bool           `json:"full"`
+	Containers  []string       `json:"containers,omitempty"` // Empty when the whole source is synced
+	Status      SyncStatus     `json:"status"`               // completed, failed or cancelled
+	Stats       SyncStats      `json:"stats"`
+	Error       string         `json:"error,omitempty"`
+	Errors      []SyncRunError `json:"errors,omitempty"` // First MaxSyncRunErrors errors
+	StartedAt   time.Time      `json:"started_at"`
+	CompletedAt time.Time      `json:"completed_at"`
+	Duration    float64        `json:"duration_seconds"`
+}
+
+// AddError records an error on the run, keeping only the first MaxSyncRunErrors
+func (r *SyncRun) AddError(containerID, externalID string, err error) {
+	if len(r.Errors) >= MaxSyncRunErrors {
+		return
+	}
+	r.Errors = append(r.Errors, SyncRunError{
+		ContainerID: containerID,
+		ExternalID:  externalID,
+		Error:       err.Error(),
+	})
+}
+
+// SyncRunError records an error during a sync run
+type SyncRunError struct {
+	ContainerID string `json:"container_id,omitempty"`
+	ExternalID  string `json:"external_id,omitempty"` // Empty for errors not tied to a document
+	Error       string `json:"error"`
+}
+
+// DocumentStage identifies the processing step a document failed in
+type DocumentStage string
+
+const (
+	DocumentStageFetch   DocumentStage = "fetch"   // Fetching the document from the source
+	DocumentStageExtract DocumentStage = "extract" // Converting binary content to text
+	DocumentStageIndex   DocumentStage = "index"   // Chunking, embedding, storing and indexing
+	DocumentStageDelete  DocumentStage = "delete"  // Removing a deleted document
+)
+
+// DocumentError records a document that failed to sync, so it can be listed
+// and retried. It is cleared once the document syncs successfully.
+type DocumentError struct {
+	SourceID      string        `json:"source_id"`
+	ExternalID    string        `json:"external_id"`
+	ContainerID   string        `json:"container_id,omitempty"`
+	Stage         DocumentStage `json:"stage"`
+	Error         string        `json:"error"`
+	Attempts      int           `json:"attempts"` // Consecutive failed attempts
+	FirstFailedAt time.Time     `json:"first_failed_at"`
+	LastFailedAt  time.Time     `json:"last_failed_at"`
+}
+
+// MaxDryRunSamples is how many sample documents a dry run keeps
+const MaxDryRunSamples = 20
+
+// DryRun reports what a sync of a source would index, found by fetching,
+// normalising and chunking its documents without storing or indexing any.
+// Chunk and token counts are estimates: the indexing pipeline may chunk
+// differently, and tokens are approximated from the chunk lengths.
+type DryRun struct {
+	ID              string                          `json:"id"` // ID of the dry-run task
+	SourceID        string                          `json:"source_id"`
+	Status          SyncStatus                      `json:"status"` // running, completed or failed
+	Error           string                          `json:"error,omitempty"`
+	Documents       int                             `json:"documents"` // Documents that would be indexed
+	Excluded        int                             `json:"excluded"`  // Documents excluded by the source's rules
+	Failed          int                             `json:"failed"`    // Documents whose content could not be extracted
+	Chunks          int                             `json:"chunks"`
+	EmbeddingTokens int                             `json:"embedding_tokens"`
+	MimeTypes       map[string]*DryRunMimeTypeStats `json:"mime_types"`
+	Samples         []DryRunSample                  `json:"samples"`          // First MaxDryRunSamples documents
+	Errors          []SyncRunError                  `json:"errors,omitempty"` // First MaxSyncRunErrors errors
+	StartedAt       time.Time                       `json:"started_at"`
+	CompletedAt     *time.Time                      `json:"completed_at,omitempty"`
+	Duration        float64                         `json:"duration_seconds"`
+}
+
+// AddDocument counts a document the dry run would index, under its MIME type
+// without parameters, and keeps it as a sample while fewer than
+// MaxDryRunSamples are kept
+func (r *DryRun) AddDocument(doc DryRunSample) {
+	mimeType := strings.ToLower(strings.TrimSpace(doc.MimeType))
+	if idx := strings.Index(mimeType, ";"); idx != -1 {
+		mimeType = strings.TrimSpace(mimeType[:idx])
+	}
+	if mimeType == "" {
+		mimeType = "unknown"
+	}
+
+	if r.MimeTypes == nil {
+		r.MimeTypes = make(map[string]*DryRunMimeTypeStats)
+	}
+	stats := r.MimeTypes[mimeType]
+	if stats == nil {
+		stats = &DryRunMimeTypeStats{}
+		r.MimeTypes[mimeType] = stats
+	}
+	stats.Documents++
+	stats.Chunks += doc.Chunks
+	stats.EmbeddingTokens += doc.EmbeddingTokens
+
+	r.Documents++
+	r.Chunks += doc.Chunks
+	r.EmbeddingTokens += doc.EmbeddingTokens
+
+	if len(r.Samples) < MaxDryRunSamples {
+		r.Samples = append(r.Samples, doc)
+	}
+}
+
+// AddError records a failed document, keeping only the first MaxSyncRunErrors
+// errors
+func (r *DryRun) AddError(containerID, externalID string, err error) {
+	r.Failed++
+	if len(r.Errors) >= MaxSyncRunErrors {
+		return
+	}
+	r.Errors = append(r.Errors, SyncRunError{
+		ContainerID: containerID,
+		ExternalID:  externalID,
+		Error:       err.Error(),
+	})
+}
+
+// DryRunMimeTypeStats counts the documents of one MIME type in a dry run
+type DryRunMimeTypeStats struct {
+	Documents       int `json:"documents"`
+	Chunks          int `json:"chunks"`
+	EmbeddingTokens int `json:"embedding_tokens"`
+}
+
+// DryRunSample is a document a dry run would index
+type DryRunSample struct {
+	ExternalID      string `json:"external_id"`
+	ContainerID     string `json:"container_id,omitempty"`
+	Path            string `json:"path"`
+	Title           string `json:"title"`
+	MimeType        string `json:"mime_type"`
+	Chunks          int    `json:"chunks"`
+	EmbeddingTokens int    `json:"embedding_tokens"`
+	Excerpt         string `json:"excerpt"` // Start of the normalised content
 }
 
 // SyncResult represents the outcome of a sync operation
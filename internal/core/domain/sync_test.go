@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 )
@@ -179,3 +181,40 @@ func TestSyncResult(t *testing.T) {
 		t.Errorf("expected Error 'connection timeout', got %s", failedResult.Error)
 	}
 }
+
+func TestSyncRun_AddError(t *testing.T) {
+	run := &SyncRun{}
+	for i := 0; i < MaxSyncRunErrors+5; i++ {
+		run.AddError("", fmt.Sprintf("doc-%d", i), errors.New("failed"))
+	}
+	if len(run.Errors) != MaxSyncRunErrors {
+		t.Errorf("expected %d errors, got %d", MaxSyncRunErrors, len(run.Errors))
+	}
+	if run.Errors[0].ExternalID != "doc-0" || run.Errors[0].Error != "failed" {
+		t.Errorf("expected the first error to be kept, got %+v", run.Errors[0])
+	}
+}
+
+func TestDryRun_AddDocument(t *testing.T) {
+	run := &DryRun{}
+	run.AddDocument(DryRunSample{ExternalID: "a", MimeType: "text/markdown; charset=utf-8", Chunks: 2, EmbeddingTokens: 100})
+	run.AddDocument(DryRunSample{ExternalID: "b", MimeType: "text/markdown", Chunks: 1, EmbeddingTokens: 50})
+	run.AddDocument(DryRunSample{ExternalID: "c", Chunks: 1, EmbeddingTokens: 10})
+
+	if run.Documents != 3 || run.Chunks != 4 || run.EmbeddingTokens != 160 {
+		t.Errorf("unexpected totals: documents=%d chunks=%d tokens=%d", run.Documents, run.Chunks, run.EmbeddingTokens)
+	}
+	if stats := run.MimeTypes["text/markdown"]; stats == nil || stats.Documents != 2 || stats.Chunks != 3 {
+		t.Errorf("expected markdown documents grouped without parameters, got %+v", stats)
+	}
+	if stats := run.MimeTypes["unknown"]; stats == nil || stats.Documents != 1 {
+		t.Errorf("expected a document without MIME type counted as unknown, got %+v", stats)
+	}
+
+	for i := 0; i < MaxDryRunSamples; i++ {
+		run.AddDocument(DryRunSample{MimeType: "text/plain"})
+	}
+	if len(run.Samples) != MaxDryRunSamples {
+		t.Errorf("expected %d samples, got %d", MaxDryRunSamples, len(run.Samples))
+	}
+}
@@ -21,6 +21,10 @@ const (
 	TaskTypeSyncSource TaskType = "sync_source"
 	// TaskTypeSyncAll syncs all sources for a team
 	TaskTypeSyncAll TaskType = "sync_all"
+	// TaskTypeRetryFailedDocuments refetches the failed documents of a source
+	TaskTypeRetryFailedDocuments TaskType = "retry_failed_documents"
+	// TaskTypeDryRunSync reports what a sync of a source would index, without indexing
+	TaskTypeDryRunSync TaskType = "dry_run_sync"
 )
 
 // TaskStatus represents the current state of a task
@@ -31,6 +35,7 @@ const (
 	TaskStatusProcessing TaskStatus = "processing"
 	TaskStatusCompleted  TaskStatus = "completed"
 	TaskStatusFailed     TaskStatus = "failed"
+	TaskStatusCancelled  TaskStatus = "cancelled"
 )
 
 // Task represents a background job to be processed by workers
@@ -45,7 +50,7 @@ type Task struct {
 	TeamID string `json:"team_id"`
 
 	// Payload contains task-specific data
-	// For sync_source: {"source_id": "src-123"}
+	// For sync_source: {"source_id": "src-123"}, plus "full": "true" for a full sync
 	// For sync_all: {} (empty)
 	Payload map[string]string `json:"payload"`
 
@@ -106,12 +111,40 @@ func NewSyncSourceTask(teamID, sourceID string) *Task {
 	})
 }
 
+// NewSyncSourceTaskWithOptions creates a task to sync a specific source
+// with the given sync options
+func NewSyncSourceTaskWithOptions(teamID, sourceID string, opts SyncOptions) *Task {
+	task := NewSyncSourceTask(teamID, sourceID)
+	if opts.Full {
+		task.Payload["full"] = "true"
+	}
+	if opts.Trigger != "" {
+		task.Payload["trigger"] = string(opts.Trigger)
+	}
+	return task
+}
+
+// NewRetryFailedDocumentsTask creates a task to retry the failed documents of a source
+func NewRetryFailedDocumentsTask(teamID, sourceID string) *Task {
+	return NewTask(TaskTypeRetryFailedDocuments, teamID, map[string]string{
+		"source_id": sourceID,
+	})
+}
+
+// NewDryRunSyncTask creates a task to dry-run a sync of a specific source
+func NewDryRunSyncTask(teamID, sourceID string) *Task {
+	return NewTask(TaskTypeDryRunSync, teamID, map[string]string{
+		"source_id": sourceID,
+	})
+}
+
 // NewSyncAllTask creates a task to sync all sources for a team
 func NewSyncAllTask(teamID string) *Task {
 	return NewTask(TaskTypeSyncAll, teamID, nil)
 }
 
-// SourceID extracts the source_id from the payload (for sync_source tasks)
+// SourceID extracts the source_id from the payload (for sync_source,
+// retry_failed_documents and dry_run_sync tasks)
 func (t *Task) SourceID() string {
 	if t.Payload == nil {
 		return ""
@@ -119,6 +152,19 @@ func (t *Task) SourceID() string {
 	return t.Payload["source_id"]
 }
 
+// SyncOptions extracts the sync options from the payload (for sync_source tasks)
+func (t *Task) SyncOptions() SyncOptions {
+	opts := SyncOptions{Trigger: SyncTriggerManual}
+	if t.Payload == nil {
+		return opts
+	}
+	opts.Full = t.Payload["full"] == "true"
+	if trigger := t.Payload["trigger"]; trigger != "" {
+		opts.Trigger = SyncTrigger(trigger)
+	}
+	return opts
+}
+
 // CanRetry returns true if the task can be retried
 func (t *Task) CanRetry() bool {
 	return t.Attempts < t.MaxAttempts
@@ -155,6 +201,20 @@ func (t *Task) MarkFailed(err string) {
 	t.Error = err
 }
 
+// MarkCancelled updates the task to cancelled state
+func (t *Task) MarkCancelled(reason string) {
+	now := time.Now()
+	t.Status = TaskStatusCancelled
+	t.CompletedAt = &now
+	t.UpdatedAt = now
+	t.Error = reason
+}
+
+// IsFinished returns true if the task reached a terminal state
+func (t *Task) IsFinished() bool {
+	return t.Status == TaskStatusCompleted || t.Status == TaskStatusFailed || t.Status == TaskStatusCancelled
+}
+
 // Retry resets the task for retry with exponential backoff
 func (t *Task) Retry(err string) {
 	now := time.Now()
@@ -170,6 +230,18 @@ func (t *Task) Retry(err string) {
 	t.ScheduledFor = now.Add(backoff)
 }
 
+// Defer returns a task that could not start yet to pending, to run at runAt.
+// The attempt is not counted against the task's retries.
+func (t *Task) Defer(reason string, runAt time.Time) {
+	t.Status = TaskStatusPending
+	t.UpdatedAt = time.Now()
+	t.Error = reason
+	t.ScheduledFor = runAt
+	if t.Attempts > 0 {
+		t.Attempts--
+	}
+}
+
 // TaskResult represents the outcome of processing a task
 type TaskResult struct {
 	TaskID      string        `json:"task_id"`
@@ -235,15 +307,9 @@ func (s *ScheduledTask) UpdateNextRun() {
 	s.NextRun = now.Add(s.Interval)
 }
 
-// DefaultSchedulerConfig returns the default scheduled tasks
+// DefaultSchedulerConfig returns the default scheduled tasks.
+// Source syncs are not among them: the scheduler enqueues them per source
+// from each source's sync schedule (see Source.SyncSchedule).
 func DefaultSchedulerConfig(teamID string) []*ScheduledTask {
-	return []*ScheduledTask{
-		NewScheduledTask(
-			"document-sync",
-			"Document Sync",
-			TaskTypeSyncAll,
-			teamID,
-			60*time.Minute, // Sync all sources every hour
-		),
-	}
+	return nil
 }
@@ -79,6 +79,43 @@ func TestNewSyncSourceTask(t *testing.T) {
 	}
 }
 
+func TestNewSyncSourceTaskWithOptions(t *testing.T) {
+	task := NewSyncSourceTask("team-123", "src-456")
+	if opts := task.SyncOptions(); opts.Full || opts.Trigger != SyncTriggerManual {
+		t.Errorf("expected manual incremental sync by default, got %+v", opts)
+	}
+
+	task = NewSyncSourceTaskWithOptions("team-123", "src-456", SyncOptions{Full: true, Trigger: SyncTriggerScheduled})
+	if task.SourceID() != "src-456" {
+		t.Errorf("expected source ID src-456, got %s", task.SourceID())
+	}
+	if opts := task.SyncOptions(); !opts.Full || opts.Trigger != SyncTriggerScheduled {
+		t.Errorf("expected scheduled full sync, got %+v", opts)
+	}
+}
+
+func TestNewRetryFailedDocumentsTask(t *testing.T) {
+	task := NewRetryFailedDocumentsTask("team-123", "src-456")
+
+	if task.Type != TaskTypeRetryFailedDocuments {
+		t.Errorf("expected type %s, got %s", TaskTypeRetryFailedDocuments, task.Type)
+	}
+	if task.SourceID() != "src-456" {
+		t.Errorf("expected source ID src-456, got %s", task.SourceID())
+	}
+}
+
+func TestNewDryRunSyncTask(t *testing.T) {
+	task := NewDryRunSyncTask("team-123", "src-456")
+
+	if task.Type != TaskTypeDryRunSync {
+		t.Errorf("expected type %s, got %s", TaskTypeDryRunSync, task.Type)
+	}
+	if task.SourceID() != "src-456" {
+		t.Errorf("expected source ID src-456, got %s", task.SourceID())
+	}
+}
+
 func TestNewSyncAllTask(t *testing.T) {
 	teamID := "team-123"
 
@@ -249,6 +286,27 @@ func TestTask_Retry(t *testing.T) {
 	}
 }
 
+func TestTask_Defer(t *testing.T) {
+	task := NewTask(TaskTypeSyncSource, "team-123", nil)
+	task.MarkProcessing()
+	runAt := time.Now().Add(time.Minute)
+
+	task.Defer("busy", runAt)
+
+	if task.Status != TaskStatusPending {
+		t.Errorf("expected status %s, got %s", TaskStatusPending, task.Status)
+	}
+	if task.Error != "busy" {
+		t.Errorf("expected error busy, got %s", task.Error)
+	}
+	if !task.ScheduledFor.Equal(runAt) {
+		t.Errorf("expected ScheduledFor %v, got %v", runAt, task.ScheduledFor)
+	}
+	if task.Attempts != 0 {
+		t.Errorf("expected the attempt not to be counted, got %d attempts", task.Attempts)
+	}
+}
+
 func TestTask_Retry_ExponentialBackoff(t *testing.T) {
 	tests := []struct {
 		attempts        int
@@ -363,32 +421,12 @@ func TestNewScheduledTask(t *testing.T) {
 }
 
 func TestDefaultSchedulerConfig(t *testing.T) {
-	teamID := "team-123"
-	configs := DefaultSchedulerConfig(teamID)
-
-	if len(configs) == 0 {
-		t.Error("expected at least one default config")
-	}
-
-	// Check the document sync config
-	found := false
-	for _, config := range configs {
-		if config.ID == "document-sync" {
-			found = true
-			if config.Type != TaskTypeSyncAll {
-				t.Errorf("expected type %s, got %s", TaskTypeSyncAll, config.Type)
-			}
-			if config.TeamID != teamID {
-				t.Errorf("expected team ID %s, got %s", teamID, config.TeamID)
-			}
-			if config.Interval != 60*time.Minute {
-				t.Errorf("expected interval 60m, got %v", config.Interval)
-			}
+	// Source syncs are scheduled per source, not by a global sync_all
+	for _, config := range DefaultSchedulerConfig("team-123") {
+		if config.Type == TaskTypeSyncAll {
+			t.Errorf("expected no default sync_all schedule, got %s", config.ID)
 		}
 	}
-	if !found {
-		t.Error("expected to find document-sync config")
-	}
 }
 
 func TestTaskResult(t *testing.T) {
@@ -0,0 +1,13 @@
+package domain
+
+// TruncateRunes cuts s to at most n runes, never splitting a character
+func TruncateRunes(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n])
+}
@@ -20,14 +20,30 @@ type Connector interface {
 	// The cursor enables incremental sync - pass empty string for full sync.
 	FetchChanges(ctx context.Context, source *domain.Source, cursor string) ([]*domain.Change, string, error)
 
-	// FetchDocument fetches a single document by external ID.
-	// Returns the document, content hash, and error.
-	FetchDocument(ctx context.Context, source *domain.Source, externalID string) (*domain.Document, string, error)
+	// FetchDocument fetches the current version of a single document by
+	// external ID, as an added or modified change carrying its content.
+	// Returns domain.ErrNotFound if the document no longer exists.
+	FetchDocument(ctx context.Context, source *domain.Source, externalID string) (*domain.Change, error)
 
 	// TestConnection tests the connection to the source.
 	TestConnection(ctx context.Context, source *domain.Source) error
 }
 
+// PagedConnector is a Connector that fetches changes a page at a time.
+// Page tokens let the sync orchestrator checkpoint a long sync and resume it
+// after a crash instead of starting over. Connectors that only implement
+// FetchChanges are fetched in one call per container, and checkpointed
+// once the container is synced.
+type PagedConnector interface {
+	Connector
+
+	// FetchChangesPage fetches one page of the changes since cursor (all
+	// documents for an empty cursor). pageToken is empty for the first page
+	// and otherwise a NextPageToken from a previous page of the same sync;
+	// it must stay valid across process restarts.
+	FetchChangesPage(ctx context.Context, source *domain.Source, cursor, pageToken string) (*domain.ChangePage, error)
+}
+
 // ConnectorBuilder creates connector instances for a specific provider type.
 // Each provider has its own builder registered with the ConnectorFactory.
 type ConnectorBuilder interface {
@@ -53,6 +69,16 @@ type ConnectorBuilder interface {
 	SupportsContainerSelection() bool
 }
 
+// CursorMerger is a ConnectorBuilder whose connectors keep a position per
+// container within their cursor. A source stores one cursor, so the sync
+// orchestrator merges the cursors its containers return instead of keeping
+// the last one. Builders without it get the last non-empty cursor.
+type CursorMerger interface {
+	// MergeCursors combines the cursors of two containers of one sync.
+	// Either cursor may be empty.
+	MergeCursors(cursor, other string) string
+}
+
 // OAuthConfig contains OAuth settings for a provider.
 type OAuthConfig struct {
 	// AuthURL is the authorization endpoint
@@ -18,6 +18,16 @@ type LLMService interface {
 	// Returns the rewritten query
 	RewriteQuery(ctx context.Context, query string) (string, error)
 
+	// Complete runs a single completion with a caller-built prompt
+	// Used for grounded answer generation
+	Complete(ctx context.Context, req CompletionRequest) (string, error)
+
+	// StreamComplete runs a completion and calls onToken with each text
+	// fragment as it is generated, returning the full text at the end.
+	// Cancelling ctx aborts the upstream request; an error from onToken
+	// stops the stream and is returned.
+	StreamComplete(ctx context.Context, req CompletionRequest, onToken func(token string) error) (string, error)
+
 	// Model returns the model name being used
 	Model() string
 
@@ -27,3 +37,15 @@ type LLMService interface {
 	// Close releases resources held by the LLM service
 	Close() error
 }
+
+// CompletionRequest is a provider-neutral completion request
+type CompletionRequest struct {
+	// System holds instructions for the model (may be empty)
+	System string
+
+	// Prompt is the user message
+	Prompt string
+
+	// MaxTokens caps the output length (0 uses the provider default)
+	MaxTokens int
+}
@@ -12,7 +12,7 @@ type MockConnector struct {
 	ValidateConfigFn func(config domain.SourceConfig) error
 	TestConnectionFn func(ctx context.Context, source *domain.Source) error
 	FetchChangesFn   func(ctx context.Context, source *domain.Source, cursor string) ([]*domain.Change, string, error)
-	FetchDocumentFn  func(ctx context.Context, source *domain.Source, externalID string) (*domain.Document, string, error)
+	FetchDocumentFn  func(ctx context.Context, source *domain.Source, externalID string) (*domain.Change, error)
 }
 
 func NewMockConnector() *MockConnector {
@@ -47,11 +47,11 @@ func (m *MockConnector) FetchChanges(ctx context.Context, source *domain.Source,
 	return nil, "", nil
 }
 
-func (m *MockConnector) FetchDocument(ctx context.Context, source *domain.Source, externalID string) (*domain.Document, string, error) {
+func (m *MockConnector) FetchDocument(ctx context.Context, source *domain.Source, externalID string) (*domain.Change, error) {
 	if m.FetchDocumentFn != nil {
 		return m.FetchDocumentFn(ctx, source, externalID)
 	}
-	return nil, "", nil
+	return nil, domain.ErrNotFound
 }
 
 // MockConnectorFactory is a mock implementation of ConnectorFactory for testing
@@ -0,0 +1,76 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+)
+
+// MockDocumentErrorStore is a mock implementation of DocumentErrorStore for testing
+type MockDocumentErrorStore struct {
+	mu     sync.RWMutex
+	errors map[string]map[string]*domain.DocumentError // by source ID, then external ID
+}
+
+// NewMockDocumentErrorStore creates a new MockDocumentErrorStore
+func NewMockDocumentErrorStore() *MockDocumentErrorStore {
+	return &MockDocumentErrorStore{
+		errors: make(map[string]map[string]*domain.DocumentError),
+	}
+}
+
+func (m *MockDocumentErrorStore) Save(ctx context.Context, docErr *domain.DocumentError) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	bySource, ok := m.errors[docErr.SourceID]
+	if !ok {
+		bySource = make(map[string]*domain.DocumentError)
+		m.errors[docErr.SourceID] = bySource
+	}
+	saved := *docErr
+	if existing, ok := bySource[docErr.ExternalID]; ok {
+		saved.Attempts = existing.Attempts + 1
+		saved.FirstFailedAt = existing.FirstFailedAt
+	}
+	bySource[docErr.ExternalID] = &saved
+	return nil
+}
+
+func (m *MockDocumentErrorStore) Delete(ctx context.Context, sourceID, externalID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.errors[sourceID], externalID)
+	return nil
+}
+
+func (m *MockDocumentErrorStore) List(ctx context.Context, sourceID string, limit, offset int) ([]*domain.DocumentError, int, error) {
+	all, _ := m.ListAll(ctx, sourceID)
+	total := len(all)
+	if offset >= total {
+		return []*domain.DocumentError{}, total, nil
+	}
+	end := offset + limit
+	if end > total {
+		end = total
+	}
+	return all[offset:end], total, nil
+}
+
+func (m *MockDocumentErrorStore) ListAll(ctx context.Context, sourceID string) ([]*domain.DocumentError, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	result := make([]*domain.DocumentError, 0, len(m.errors[sourceID]))
+	for _, docErr := range m.errors[sourceID] {
+		copied := *docErr
+		result = append(result, &copied)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].LastFailedAt.Equal(result[j].LastFailedAt) {
+			return result[i].LastFailedAt.After(result[j].LastFailedAt)
+		}
+		return result[i].ExternalID < result[j].ExternalID
+	})
+	return result, nil
+}
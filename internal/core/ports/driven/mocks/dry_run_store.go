@@ -0,0 +1,40 @@
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+)
+
+// MockDryRunStore is a mock implementation of DryRunStore for testing
+type MockDryRunStore struct {
+	mu   sync.RWMutex
+	runs map[string]*domain.DryRun
+}
+
+// NewMockDryRunStore creates a new MockDryRunStore
+func NewMockDryRunStore() *MockDryRunStore {
+	return &MockDryRunStore{
+		runs: make(map[string]*domain.DryRun),
+	}
+}
+
+func (m *MockDryRunStore) Save(ctx context.Context, run *domain.DryRun) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	saved := *run
+	m.runs[run.ID] = &saved
+	return nil
+}
+
+func (m *MockDryRunStore) Get(ctx context.Context, id string) (*domain.DryRun, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	run, ok := m.runs[id]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	saved := *run
+	return &saved, nil
+}
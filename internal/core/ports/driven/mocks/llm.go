@@ -0,0 +1,162 @@
+package mocks
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// MockLLMService is a mock implementation of LLMService for testing
+type MockLLMService struct {
+	mu         sync.Mutex
+	model      string
+	expansions map[string][]string
+	summary    string
+	completion string
+	lastPrompt driven.CompletionRequest
+	err        error
+	calls      int
+}
+
+// NewMockLLMService creates a new MockLLMService
+func NewMockLLMService() *MockLLMService {
+	return &MockLLMService{
+		model:      "mock-llm-model",
+		expansions: make(map[string][]string),
+	}
+}
+
+func (m *MockLLMService) ExpandQuery(ctx context.Context, query string) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls++
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.expansions[query], nil
+}
+
+func (m *MockLLMService) Summarise(ctx context.Context, content string, maxLen int) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls++
+	if m.err != nil {
+		return "", m.err
+	}
+	if m.summary != "" {
+		return m.summary, nil
+	}
+	if maxLen > 0 && len(content) > maxLen {
+		return content[:maxLen], nil
+	}
+	return content, nil
+}
+
+func (m *MockLLMService) RewriteQuery(ctx context.Context, query string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls++
+	if m.err != nil {
+		return "", m.err
+	}
+	return strings.TrimSpace(query), nil
+}
+
+func (m *MockLLMService) Complete(ctx context.Context, req driven.CompletionRequest) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls++
+	m.lastPrompt = req
+	if m.err != nil {
+		return "", m.err
+	}
+	return m.completion, nil
+}
+
+// StreamComplete emits the fixed completion word by word, stopping early
+// if ctx is cancelled or onToken fails
+func (m *MockLLMService) StreamComplete(ctx context.Context, req driven.CompletionRequest, onToken func(string) error) (string, error) {
+	completion, err := m.Complete(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	for _, token := range strings.SplitAfter(completion, " ") {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		if token == "" {
+			continue
+		}
+		if err := onToken(token); err != nil {
+			return "", err
+		}
+	}
+	return completion, nil
+}
+
+func (m *MockLLMService) Model() string {
+	return m.model
+}
+
+func (m *MockLLMService) Ping(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.err
+}
+
+func (m *MockLLMService) Close() error {
+	return nil
+}
+
+// Helper methods for testing
+
+// SetExpansions sets the terms returned by ExpandQuery for a query
+func (m *MockLLMService) SetExpansions(query string, terms []string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expansions[query] = terms
+}
+
+// SetSummary sets a fixed response for Summarise
+func (m *MockLLMService) SetSummary(summary string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.summary = summary
+}
+
+// SetCompletion sets a fixed response for Complete
+func (m *MockLLMService) SetCompletion(completion string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.completion = completion
+}
+
+// LastCompletionRequest returns the most recent request passed to Complete
+func (m *MockLLMService) LastCompletionRequest() driven.CompletionRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.lastPrompt
+}
+
+// SetError makes every call fail with err (nil clears it)
+func (m *MockLLMService) SetError(err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.err = err
+}
+
+// SetUnavailable makes every call fail as if the provider were down
+func (m *MockLLMService) SetUnavailable() {
+	m.SetError(errors.New("mock llm unavailable"))
+}
+
+// Calls returns how many LLM operations were invoked
+func (m *MockLLMService) Calls() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.calls
+}
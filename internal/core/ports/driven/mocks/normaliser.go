@@ -1,6 +1,8 @@
 package mocks
 
 import (
+	"context"
+
 	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
 )
 
@@ -120,3 +122,27 @@ func (m *MockPostProcessorPipeline) List() []string {
 	}
 	return []string{"mock-processor"}
 }
+
+// MockContentExtractor is a mock implementation of ContentExtractor for testing
+type MockContentExtractor struct {
+	SupportedTypesFn func() []string
+	ExtractFn        func(ctx context.Context, data []byte, mimeType string) (string, error)
+}
+
+func NewMockContentExtractor() *MockContentExtractor {
+	return &MockContentExtractor{}
+}
+
+func (m *MockContentExtractor) Extract(ctx context.Context, data []byte, mimeType string) (string, error) {
+	if m.ExtractFn != nil {
+		return m.ExtractFn(ctx, data, mimeType)
+	}
+	return string(data), nil
+}
+
+func (m *MockContentExtractor) SupportedTypes() []string {
+	if m.SupportedTypesFn != nil {
+		return m.SupportedTypesFn()
+	}
+	return []string{"application/pdf"}
+}
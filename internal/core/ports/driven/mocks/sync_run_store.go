@@ -0,0 +1,59 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+)
+
+// MockSyncRunStore is a mock implementation of SyncRunStore for testing
+type MockSyncRunStore struct {
+	mu   sync.RWMutex
+	runs map[string][]*domain.SyncRun // by source ID, newest first
+}
+
+// NewMockSyncRunStore creates a new MockSyncRunStore
+func NewMockSyncRunStore() *MockSyncRunStore {
+	return &MockSyncRunStore{
+		runs: make(map[string][]*domain.SyncRun),
+	}
+}
+
+func (m *MockSyncRunStore) Save(ctx context.Context, run *domain.SyncRun) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	runs := append(m.runs[run.SourceID], run)
+	sort.SliceStable(runs, func(i, j int) bool {
+		return runs[i].StartedAt.After(runs[j].StartedAt)
+	})
+	m.runs[run.SourceID] = runs
+	return nil
+}
+
+func (m *MockSyncRunStore) List(ctx context.Context, sourceID string, limit, offset int) ([]*domain.SyncRun, int, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	runs := m.runs[sourceID]
+	total := len(runs)
+	if offset >= total {
+		return []*domain.SyncRun{}, total, nil
+	}
+	end := offset + limit
+	if end > total {
+		end = total
+	}
+	return append([]*domain.SyncRun(nil), runs[offset:end]...), total, nil
+}
+
+func (m *MockSyncRunStore) Prune(ctx context.Context, sourceID string, keep int) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	runs := m.runs[sourceID]
+	if len(runs) <= keep {
+		return 0, nil
+	}
+	m.runs[sourceID] = runs[:keep]
+	return len(runs) - keep, nil
+}
@@ -2,6 +2,7 @@ package mocks
 
 import (
 	"context"
+	"slices"
 	"sync"
 
 	"github.com/custodia-labs/sercha-core/internal/core/domain"
@@ -9,14 +10,16 @@ import (
 
 // MockSyncStateStore is a mock implementation of SyncStateStore for testing
 type MockSyncStateStore struct {
-	mu     sync.RWMutex
-	states map[string]*domain.SyncState
+	mu          sync.RWMutex
+	states      map[string]*domain.SyncState
+	checkpoints map[string]*domain.SyncCheckpoint
 }
 
 // NewMockSyncStateStore creates a new MockSyncStateStore
 func NewMockSyncStateStore() *MockSyncStateStore {
 	return &MockSyncStateStore{
-		states: make(map[string]*domain.SyncState),
+		states:      make(map[string]*domain.SyncState),
+		checkpoints: make(map[string]*domain.SyncCheckpoint),
 	}
 }
 
@@ -28,12 +31,18 @@ func (m *MockSyncStateStore) Save(ctx context.Context, state *domain.SyncState)
 }
 
 func (m *MockSyncStateStore) Get(ctx context.Context, sourceID string) (*domain.SyncState, error) {
-	m.mu.RLock()
-	defer m.mu.RUnlock()
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	state, ok := m.states[sourceID]
 	if !ok {
 		return nil, domain.ErrNotFound
 	}
+	state.Checkpoint = nil
+	if checkpoint, ok := m.checkpoints[sourceID]; ok {
+		copied := *checkpoint
+		copied.Seen = nil
+		state.Checkpoint = &copied
+	}
 	return state, nil
 }
 
@@ -65,6 +74,17 @@ func (m *MockSyncStateStore) UpdateStatus(ctx context.Context, sourceID string,
 	return nil
 }
 
+func (m *MockSyncStateStore) CompareAndSetStatus(ctx context.Context, sourceID string, from, status domain.SyncStatus) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	state, ok := m.states[sourceID]
+	if !ok || state.Status != from {
+		return false, nil
+	}
+	state.Status = status
+	return true, nil
+}
+
 func (m *MockSyncStateStore) UpdateCursor(ctx context.Context, sourceID string, cursor string) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -76,12 +96,40 @@ func (m *MockSyncStateStore) UpdateCursor(ctx context.Context, sourceID string,
 	return nil
 }
 
+func (m *MockSyncStateStore) SaveCheckpoint(ctx context.Context, sourceID string, checkpoint *domain.SyncCheckpoint) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if checkpoint == nil {
+		delete(m.checkpoints, sourceID)
+		return nil
+	}
+	copied := *checkpoint
+	copied.Seen = slices.Clone(checkpoint.Seen)
+	if existing, ok := m.checkpoints[sourceID]; ok {
+		copied.Seen = append(slices.Clone(existing.Seen), copied.Seen...)
+	}
+	m.checkpoints[sourceID] = &copied
+	return nil
+}
+
+func (m *MockSyncStateStore) GetCheckpoint(ctx context.Context, sourceID string) (*domain.SyncCheckpoint, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	checkpoint, ok := m.checkpoints[sourceID]
+	if !ok {
+		return nil, nil
+	}
+	copied := *checkpoint
+	return &copied, nil
+}
+
 // Helper methods for testing
 
 func (m *MockSyncStateStore) Reset() {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	m.states = make(map[string]*domain.SyncState)
+	m.checkpoints = make(map[string]*domain.SyncCheckpoint)
 }
 
 func (m *MockSyncStateStore) Count() int {
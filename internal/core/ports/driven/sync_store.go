@@ -8,10 +8,12 @@ import (
 
 // SyncStateStore handles sync state persistence (PostgreSQL)
 type SyncStateStore interface {
-	// Save creates or updates sync state
+	// Save creates or updates sync state. The checkpoint is not written;
+	// use SaveCheckpoint.
 	Save(ctx context.Context, state *domain.SyncState) error
 
-	// Get retrieves sync state for a source
+	// Get retrieves sync state for a source, including its checkpoint
+	// without the seen external IDs
 	Get(ctx context.Context, sourceID string) (*domain.SyncState, error)
 
 	// List retrieves sync states for all sources
@@ -23,6 +25,61 @@ type SyncStateStore interface {
 	// UpdateStatus updates only the status field
 	UpdateStatus(ctx context.Context, sourceID string, status domain.SyncStatus) error
 
+	// CompareAndSetStatus updates the status to status only while it is
+	// from. Returns false if the status was not from or there is no state.
+	CompareAndSetStatus(ctx context.Context, sourceID string, from, status domain.SyncStatus) (bool, error)
+
 	// UpdateCursor updates the sync cursor
 	UpdateCursor(ctx context.Context, sourceID string, cursor string) error
+
+	// SaveCheckpoint replaces the checkpoint of a source's sync, leaving the
+	// rest of the sync state alone. The checkpoint's seen external IDs are
+	// added to those saved with earlier checkpoints. A nil checkpoint clears
+	// the checkpoint and its seen external IDs.
+	SaveCheckpoint(ctx context.Context, sourceID string, checkpoint *domain.SyncCheckpoint) error
+
+	// GetCheckpoint retrieves the checkpoint of a source's sync including
+	// the seen external IDs. Returns nil if there is none.
+	GetCheckpoint(ctx context.Context, sourceID string) (*domain.SyncCheckpoint, error)
+}
+
+// SyncRunStore handles sync run history persistence (PostgreSQL)
+type SyncRunStore interface {
+	// Save records a finished sync run
+	Save(ctx context.Context, run *domain.SyncRun) error
+
+	// List retrieves a page of a source's sync runs, newest first,
+	// and the total number of runs
+	List(ctx context.Context, sourceID string, limit, offset int) ([]*domain.SyncRun, int, error)
+
+	// Prune deletes all but the newest keep runs of a source.
+	// Returns the number of runs deleted.
+	Prune(ctx context.Context, sourceID string, keep int) (int, error)
+}
+
+// DocumentErrorStore handles persistence of failed documents (PostgreSQL)
+type DocumentErrorStore interface {
+	// Save records a failed document. If the document already failed, its
+	// attempts are incremented and FirstFailedAt is kept.
+	Save(ctx context.Context, docErr *domain.DocumentError) error
+
+	// Delete removes a document's error once it syncs successfully
+	Delete(ctx context.Context, sourceID, externalID string) error
+
+	// List retrieves a page of a source's failed documents, most recently
+	// failed first, and the total number of failed documents
+	List(ctx context.Context, sourceID string, limit, offset int) ([]*domain.DocumentError, int, error)
+
+	// ListAll retrieves all failed documents of a source
+	ListAll(ctx context.Context, sourceID string) ([]*domain.DocumentError, error)
+}
+
+// DryRunStore handles persistence of dry-run results (PostgreSQL)
+type DryRunStore interface {
+	// Save creates or replaces a dry run
+	Save(ctx context.Context, run *domain.DryRun) error
+
+	// Get retrieves a dry run by ID (the ID of its task).
+	// Returns domain.ErrNotFound if there is none.
+	Get(ctx context.Context, id string) (*domain.DryRun, error)
 }
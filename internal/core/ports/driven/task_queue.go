@@ -2,6 +2,7 @@ package driven
 
 import (
 	"context"
+	"time"
 
 	"github.com/custodia-labs/sercha-core/internal/core/domain"
 )
@@ -36,6 +37,14 @@ type TaskQueue interface {
 	// If max retries exceeded, task is moved to failed state.
 	Nack(ctx context.Context, taskID string, reason string) error
 
+	// AckCancelled acknowledges a task whose processing stopped because it was
+	// cancelled. The task is removed from the queue as cancelled, without retry.
+	AckCancelled(ctx context.Context, taskID string, reason string) error
+
+	// Defer returns a processing task that could not start yet to the queue,
+	// to run again at runAt. Unlike Nack, the attempt is not counted.
+	Defer(ctx context.Context, taskID string, reason string, runAt time.Time) error
+
 	// GetTask retrieves a task by ID (for status checking).
 	GetTask(ctx context.Context, taskID string) (*domain.Task, error)
 
@@ -46,7 +55,7 @@ type TaskQueue interface {
 	// Returns error if task is already processing or completed.
 	CancelTask(ctx context.Context, taskID string) error
 
-	// PurgeTasks removes completed/failed tasks older than the specified age.
+	// PurgeTasks removes completed/failed/cancelled tasks older than the specified age.
 	// This is used for cleanup.
 	PurgeTasks(ctx context.Context, olderThan int) (int, error)
 
@@ -92,6 +101,9 @@ type QueueStats struct {
 	// FailedCount is the number of tasks that failed after all retries
 	FailedCount int64 `json:"failed_count"`
 
+	// CancelledCount is the number of tasks cancelled before or during processing
+	CancelledCount int64 `json:"cancelled_count"`
+
 	// OldestPendingAge is the age of the oldest pending task in seconds
 	OldestPendingAge int64 `json:"oldest_pending_age"`
 }
@@ -0,0 +1,20 @@
+package driving
+
+import (
+	"context"
+
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+)
+
+// AnswerService generates answers to questions from indexed documents
+type AnswerService interface {
+	// Answer searches for relevant chunks and generates a cited answer.
+	// Returns domain.ErrServiceUnavailable when no LLM is configured.
+	Answer(ctx context.Context, query string, opts domain.AnswerOptions) (*domain.Answer, error)
+
+	// StreamSummary searches and streams the hits followed by an LLM summary.
+	// emit receives a results event, zero or more token events, then a
+	// summary or error event. Search errors are returned before anything is
+	// emitted; an error from emit stops the stream and is returned.
+	StreamSummary(ctx context.Context, query string, opts domain.SearchOptions, emit func(*domain.SummaryEvent) error) error
+}
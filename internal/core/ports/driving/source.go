@@ -13,6 +13,7 @@ type CreateSourceRequest struct {
 	Config             domain.SourceConfig `json:"config"`
 	InstallationID     string              `json:"installation_id,omitempty"`
 	SelectedContainers []string            `json:"selected_containers,omitempty"`
+	SyncSchedule       string              `json:"sync_schedule,omitempty"`
 }
 
 // UpdateSourceRequest represents a request to update a source
@@ -20,6 +21,9 @@ type UpdateSourceRequest struct {
 	Name    *string              `json:"name,omitempty"`
 	Config  *domain.SourceConfig `json:"config,omitempty"`
 	Enabled *bool                `json:"enabled,omitempty"`
+
+	// SyncSchedule replaces the sync schedule; an empty string reverts to the team default
+	SyncSchedule *string `json:"sync_schedule,omitempty"`
 }
 
 // SourceService manages data sources (admin operations)
@@ -11,6 +11,15 @@ type SyncOrchestrator interface {
 	// SyncSource triggers a sync for a specific source
 	SyncSource(ctx context.Context, sourceID string) (*domain.SyncResult, error)
 
+	// SyncWithOptions triggers a sync for a specific source with the given
+	// options; a full sync ignores the cursor and deletes documents the
+	// source no longer has
+	SyncWithOptions(ctx context.Context, sourceID string, opts domain.SyncOptions) (*domain.SyncResult, error)
+
+	// RetryFailedDocuments refetches and reprocesses only the documents of a
+	// source that failed to sync
+	RetryFailedDocuments(ctx context.Context, sourceID string) (*domain.SyncResult, error)
+
 	// SyncAll triggers a sync for all enabled sources
 	SyncAll(ctx context.Context) ([]*domain.SyncResult, error)
 
@@ -20,7 +29,27 @@ type SyncOrchestrator interface {
 	// ListSyncStates retrieves sync states for all sources
 	ListSyncStates(ctx context.Context) ([]*domain.SyncState, error)
 
-	// CancelSync cancels an ongoing sync for a source
+	// ListSyncRuns retrieves a page of a source's sync history, newest
+	// first, and the total number of runs
+	ListSyncRuns(ctx context.Context, sourceID string, limit, offset int) ([]*domain.SyncRun, int, error)
+
+	// ListDocumentErrors retrieves a page of a source's failed documents,
+	// most recently failed first, and the total number of failed documents
+	ListDocumentErrors(ctx context.Context, sourceID string, limit, offset int) ([]*domain.DocumentError, int, error)
+
+	// PreviewRules reports which documents of a source would be added or
+	// removed by replacing its rules; the source is enumerated, not synced
+	PreviewRules(ctx context.Context, sourceID string, rules *domain.SourceRules) (*domain.RulesPreview, error)
+
+	// DryRunSync reports what a full sync of a source would index, saving
+	// the result under runID; nothing is stored or indexed
+	DryRunSync(ctx context.Context, sourceID, runID string) (*domain.DryRun, error)
+
+	// GetDryRun retrieves a dry run of a source
+	GetDryRun(ctx context.Context, sourceID, runID string) (*domain.DryRun, error)
+
+	// CancelSync requests cancellation of an ongoing sync for a source.
+	// The sync stops after the document in flight; it is a no-op if no sync is running.
 	CancelSync(ctx context.Context, sourceID string) error
 }
 
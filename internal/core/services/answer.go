@@ -0,0 +1,324 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driving"
+	"github.com/custodia-labs/sercha-core/internal/runtime"
+)
+
+// Ensure answerService implements AnswerService
+var _ driving.AnswerService = (*answerService)(nil)
+
+// Answer generation limits
+const (
+	// maxAnswerChunks caps how many chunks can be packed into one prompt
+	maxAnswerChunks = 20
+
+	// answerContextChars bounds the total context sent to the LLM (~6k tokens)
+	answerContextChars = 24000
+
+	// answerChunkChars bounds a single chunk so one long chunk cannot crowd out the rest
+	answerChunkChars = 4000
+
+	// answerSnippetChars is the length of the snippet returned with each citation
+	answerSnippetChars = 240
+
+	// answerMaxTokens is the output budget for the generated answer
+	answerMaxTokens = 1024
+)
+
+const answerSystemPrompt = `You answer questions using only the numbered context passages provided.
+Cite every statement with the number of the passage it comes from in square brackets, for example [1] or [2][3].
+Only cite passages you actually used. If the passages do not contain the answer, say that the indexed documents do not answer the question.
+Answer concisely in plain text.`
+
+const summarySystemPrompt = `You summarise search results for an enterprise search engine.
+Using only the numbered passages provided, write a short summary of what they say about the user's query.
+Cite passages with their number in square brackets, for example [1] or [2][3]. Only cite passages you actually used.
+Keep the summary to a few sentences of plain text.`
+
+// noContextAnswer is returned when retrieval finds nothing to ground an answer on
+const noContextAnswer = "No indexed documents matched this question."
+
+// citationPattern matches inline citation markers such as [1] or [2, 3]
+var citationPattern = regexp.MustCompile(`\[(\d+(?:\s*,\s*\d+)*)\]`)
+
+// answerService implements the AnswerService interface
+type answerService struct {
+	searchService driving.SearchService
+	chunkStore    driven.ChunkStore // Optional, used to recover full chunk content and offsets
+	services      *runtime.Services // Dynamic AI services
+}
+
+// NewAnswerService creates a new AnswerService
+// The LLM is accessed dynamically via runtime.Services
+func NewAnswerService(
+	searchService driving.SearchService,
+	chunkStore driven.ChunkStore,
+	services *runtime.Services,
+) driving.AnswerService {
+	return &answerService{
+		searchService: searchService,
+		chunkStore:    chunkStore,
+		services:      services,
+	}
+}
+
+// Answer searches for relevant chunks and generates a cited answer
+func (s *answerService) Answer(ctx context.Context, query string, opts domain.AnswerOptions) (*domain.Answer, error) {
+	start := time.Now()
+
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, fmt.Errorf("%w: query is required", domain.ErrInvalidInput)
+	}
+
+	llm := s.services.LLMService()
+	if llm == nil || !s.services.Config().CanDoLLMAssisted() {
+		return nil, fmt.Errorf("%w: answer generation requires an LLM provider", domain.ErrServiceUnavailable)
+	}
+
+	if opts.MaxChunks <= 0 {
+		opts.MaxChunks = domain.DefaultAnswerOptions().MaxChunks
+	}
+	if opts.MaxChunks > maxAnswerChunks {
+		opts.MaxChunks = maxAnswerChunks
+	}
+
+	result, err := s.searchService.Search(ctx, query, domain.SearchOptions{
+		Mode:      opts.Mode,
+		Limit:     opts.MaxChunks,
+		SourceIDs: opts.SourceIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("search: %w", err)
+	}
+
+	passages := s.buildPassages(ctx, result.Results)
+	if len(passages) == 0 {
+		return &domain.Answer{
+			Query:     query,
+			Answer:    noContextAnswer,
+			Citations: []*domain.Citation{},
+			Took:      time.Since(start),
+		}, nil
+	}
+
+	text, err := llm.Complete(ctx, driven.CompletionRequest{
+		System:    answerSystemPrompt,
+		Prompt:    buildPassagePrompt(passages, "Question", query),
+		MaxTokens: answerMaxTokens,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("generate answer: %w", err)
+	}
+
+	return &domain.Answer{
+		Query:     query,
+		Answer:    text,
+		Citations: citedPassages(text, passages),
+		Model:     llm.Model(),
+		Took:      time.Since(start),
+	}, nil
+}
+
+// StreamSummary searches and streams the hits followed by an LLM summary
+func (s *answerService) StreamSummary(
+	ctx context.Context,
+	query string,
+	opts domain.SearchOptions,
+	emit func(*domain.SummaryEvent) error,
+) error {
+	start := time.Now()
+
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return fmt.Errorf("%w: query is required", domain.ErrInvalidInput)
+	}
+
+	result, err := s.searchService.Search(ctx, query, opts)
+	if err != nil {
+		return fmt.Errorf("search: %w", err)
+	}
+
+	// Hits go out first so clients can render them while the summary is generated
+	if err := emit(&domain.SummaryEvent{Type: domain.SummaryEventResults, Results: result}); err != nil {
+		return err
+	}
+
+	llm := s.services.LLMService()
+	if llm == nil || !s.services.Config().CanDoLLMAssisted() {
+		return emit(&domain.SummaryEvent{
+			Type:  domain.SummaryEventError,
+			Error: "summary unavailable: no LLM provider configured",
+		})
+	}
+
+	top := result.Results
+	if limit := domain.DefaultAnswerOptions().MaxChunks; len(top) > limit {
+		top = top[:limit]
+	}
+
+	passages := s.buildPassages(ctx, top)
+	if len(passages) == 0 {
+		return emit(&domain.SummaryEvent{
+			Type: domain.SummaryEventSummary,
+			Summary: &domain.Answer{
+				Query:     query,
+				Answer:    noContextAnswer,
+				Citations: []*domain.Citation{},
+				Took:      time.Since(start),
+			},
+		})
+	}
+
+	// Remember emit failures so they are not reported as LLM failures
+	var emitErr error
+	text, err := llm.StreamComplete(ctx, driven.CompletionRequest{
+		System:    summarySystemPrompt,
+		Prompt:    buildPassagePrompt(passages, "Query", query),
+		MaxTokens: answerMaxTokens,
+	}, func(token string) error {
+		emitErr = emit(&domain.SummaryEvent{Type: domain.SummaryEventToken, Token: token})
+		return emitErr
+	})
+	if err != nil {
+		if emitErr != nil {
+			return emitErr
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		return emit(&domain.SummaryEvent{Type: domain.SummaryEventError, Error: "summary generation failed"})
+	}
+
+	return emit(&domain.SummaryEvent{
+		Type: domain.SummaryEventSummary,
+		Summary: &domain.Answer{
+			Query:     query,
+			Answer:    text,
+			Citations: citedPassages(text, passages),
+			Model:     llm.Model(),
+			Took:      time.Since(start),
+		},
+	})
+}
+
+// answerPassage is a numbered chunk packed into the answer prompt
+type answerPassage struct {
+	citation *domain.Citation
+	content  string
+}
+
+// buildPassages numbers the ranked chunks and trims them to the context budget.
+// Search results may only carry a snippet, so the full chunk is loaded from the
+// chunk store when available to get complete content and document offsets.
+func (s *answerService) buildPassages(ctx context.Context, results []*domain.RankedChunk) []*answerPassage {
+	byDocument := make(map[string][]*domain.Chunk)
+	passages := make([]*answerPassage, 0, len(results))
+	budget := answerContextChars
+
+	for _, rc := range results {
+		if rc == nil || rc.Chunk == nil || budget <= 0 {
+			continue
+		}
+		chunk := s.fullChunk(ctx, rc.Chunk, byDocument)
+
+		content := domain.TruncateRunes(strings.TrimSpace(chunk.Content), min(answerChunkChars, budget))
+		if content == "" {
+			continue
+		}
+		budget -= utf8.RuneCountInString(content)
+
+		citation := &domain.Citation{
+			Index:      len(passages) + 1,
+			DocumentID: chunk.DocumentID,
+			ChunkID:    chunk.ID,
+			SourceID:   chunk.SourceID,
+			StartChar:  chunk.StartChar,
+			EndChar:    chunk.EndChar,
+			Snippet:    domain.TruncateRunes(content, answerSnippetChars),
+		}
+		if rc.Document != nil {
+			citation.Title = rc.Document.Title
+			citation.Path = rc.Document.Path
+		}
+
+		passages = append(passages, &answerPassage{citation: citation, content: content})
+	}
+
+	return passages
+}
+
+// fullChunk returns the stored version of chunk, falling back to chunk itself.
+// Chunks are cached per document since several hits often share one.
+func (s *answerService) fullChunk(ctx context.Context, chunk *domain.Chunk, cache map[string][]*domain.Chunk) *domain.Chunk {
+	if s.chunkStore == nil || chunk.DocumentID == "" {
+		return chunk
+	}
+
+	stored, ok := cache[chunk.DocumentID]
+	if !ok {
+		stored, _ = s.chunkStore.GetByDocument(ctx, chunk.DocumentID)
+		cache[chunk.DocumentID] = stored
+	}
+
+	for _, c := range stored {
+		if c.ID == chunk.ID {
+			return c
+		}
+	}
+	return chunk
+}
+
+// buildPassagePrompt formats the numbered passages followed by a labelled line
+// holding the user's question or query
+func buildPassagePrompt(passages []*answerPassage, label, query string) string {
+	var b strings.Builder
+	b.WriteString("Context passages:\n\n")
+	for _, p := range passages {
+		fmt.Fprintf(&b, "[%d]", p.citation.Index)
+		if p.citation.Title != "" {
+			fmt.Fprintf(&b, " %s", p.citation.Title)
+		}
+		if p.citation.Path != "" {
+			fmt.Fprintf(&b, " (%s)", p.citation.Path)
+		}
+		b.WriteString("\n")
+		b.WriteString(p.content)
+		b.WriteString("\n\n")
+	}
+	fmt.Fprintf(&b, "%s: %s", label, query)
+	return b.String()
+}
+
+// citedPassages returns the citations for the passage numbers referenced in
+// text, in index order. Numbers that do not match a passage are ignored.
+func citedPassages(text string, passages []*answerPassage) []*domain.Citation {
+	cited := make(map[int]bool)
+	for _, match := range citationPattern.FindAllStringSubmatch(text, -1) {
+		for _, part := range strings.Split(match[1], ",") {
+			n, err := strconv.Atoi(strings.TrimSpace(part))
+			if err == nil && n >= 1 && n <= len(passages) {
+				cited[n] = true
+			}
+		}
+	}
+
+	citations := make([]*domain.Citation, 0, len(cited))
+	for n := range cited {
+		citations = append(citations, passages[n-1].citation)
+	}
+	sort.Slice(citations, func(i, j int) bool { return citations[i].Index < citations[j].Index })
+	return citations
+}
@@ -0,0 +1,234 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven/mocks"
+	"github.com/custodia-labs/sercha-core/internal/runtime"
+)
+
+// answerFixture indexes two documents and returns a service wired to an LLM mock
+func answerFixture(t *testing.T, llm *mocks.MockLLMService) *answerService {
+	t.Helper()
+	ctx := context.Background()
+
+	searchEngine := mocks.NewMockSearchEngine()
+	documentStore := mocks.NewMockDocumentStore()
+	chunkStore := mocks.NewMockChunkStore()
+
+	_ = documentStore.Save(ctx, &domain.Document{ID: "doc-1", SourceID: "src-1", Title: "Key Rotation", Path: "runbooks/keys.md"})
+	_ = documentStore.Save(ctx, &domain.Document{ID: "doc-2", SourceID: "src-1", Title: "Onboarding", Path: "hr/onboarding.md"})
+
+	chunks := []*domain.Chunk{
+		{ID: "chunk-1", DocumentID: "doc-1", SourceID: "src-1", Content: "Signing keys are rotated from the admin console.", StartChar: 0, EndChar: 48},
+		{ID: "chunk-2", DocumentID: "doc-2", SourceID: "src-1", Content: "New hires receive signing keys on day one.", StartChar: 300, EndChar: 342},
+	}
+	_ = searchEngine.Index(ctx, chunks)
+	_ = chunkStore.SaveBatch(ctx, chunks)
+
+	config := domain.NewRuntimeConfig("postgres")
+	services := runtime.NewServices(config)
+	if llm != nil {
+		services.SetLLMService(llm)
+	}
+
+	searchService := NewSearchService(searchEngine, documentStore, services, nil, nil)
+	return NewAnswerService(searchService, chunkStore, services).(*answerService)
+}
+
+func TestAnswerService_Answer(t *testing.T) {
+	llm := mocks.NewMockLLMService()
+	llm.SetCompletion("Keys are rotated from the admin console [1]. See also [7].")
+	svc := answerFixture(t, llm)
+
+	answer, err := svc.Answer(context.Background(), "signing keys", domain.AnswerOptions{Mode: domain.SearchModeTextOnly})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if answer.Model != "mock-llm-model" {
+		t.Errorf("expected model to be reported, got %q", answer.Model)
+	}
+	if len(answer.Citations) != 1 {
+		t.Fatalf("expected only in-range citations, got %+v", answer.Citations)
+	}
+
+	citation := answer.Citations[0]
+	if citation.Index != 1 || citation.Title == "" || citation.Path == "" {
+		t.Errorf("expected citation to carry document title and path, got %+v", citation)
+	}
+	if citation.EndChar <= citation.StartChar {
+		t.Errorf("expected chunk offsets on citation, got %d-%d", citation.StartChar, citation.EndChar)
+	}
+
+	req := llm.LastCompletionRequest()
+	if !strings.Contains(req.Prompt, "[1]") || !strings.Contains(req.Prompt, "[2]") {
+		t.Errorf("expected numbered passages in prompt, got %q", req.Prompt)
+	}
+	if !strings.HasSuffix(req.Prompt, "Question: signing keys") {
+		t.Errorf("expected question at end of prompt, got %q", req.Prompt)
+	}
+}
+
+func TestAnswerService_RefusesWithoutLLM(t *testing.T) {
+	svc := answerFixture(t, nil)
+
+	_, err := svc.Answer(context.Background(), "signing keys", domain.AnswerOptions{})
+	if !errors.Is(err, domain.ErrServiceUnavailable) {
+		t.Errorf("expected ErrServiceUnavailable, got %v", err)
+	}
+}
+
+func TestAnswerService_NoResults(t *testing.T) {
+	llm := mocks.NewMockLLMService()
+	svc := answerFixture(t, llm)
+
+	answer, err := svc.Answer(context.Background(), "kubernetes", domain.AnswerOptions{Mode: domain.SearchModeTextOnly})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if answer.Answer != noContextAnswer || len(answer.Citations) != 0 {
+		t.Errorf("expected no-context answer, got %+v", answer)
+	}
+	if llm.Calls() != 0 {
+		t.Error("expected LLM not to be called without context")
+	}
+}
+
+func TestAnswerService_EmptyQuery(t *testing.T) {
+	svc := answerFixture(t, mocks.NewMockLLMService())
+
+	_, err := svc.Answer(context.Background(), "   ", domain.AnswerOptions{})
+	if !errors.Is(err, domain.ErrInvalidInput) {
+		t.Errorf("expected ErrInvalidInput, got %v", err)
+	}
+}
+
+func TestAnswerService_LLMError(t *testing.T) {
+	llm := mocks.NewMockLLMService()
+	svc := answerFixture(t, llm)
+	llm.SetUnavailable()
+
+	if _, err := svc.Answer(context.Background(), "signing keys", domain.AnswerOptions{Mode: domain.SearchModeTextOnly}); err == nil {
+		t.Error("expected error when LLM fails")
+	}
+}
+
+func TestCitedPassages(t *testing.T) {
+	passages := []*answerPassage{
+		{citation: &domain.Citation{Index: 1}},
+		{citation: &domain.Citation{Index: 2}},
+		{citation: &domain.Citation{Index: 3}},
+	}
+
+	got := citedPassages("First [3], then [1, 2] and again [3]. Ignore [0] and [4].", passages)
+	if len(got) != 3 {
+		t.Fatalf("expected 3 citations, got %d", len(got))
+	}
+	for i, c := range got {
+		if c.Index != i+1 {
+			t.Errorf("expected citations in index order, got %d at %d", c.Index, i)
+		}
+	}
+}
+
+func TestBuildPassages_BudgetsRunes(t *testing.T) {
+	svc := answerFixture(t, nil)
+
+	// Two-byte runes must count once against the rune budget
+	var results []*domain.RankedChunk
+	for i := range 8 {
+		id := "wide-" + strconv.Itoa(i)
+		results = append(results, &domain.RankedChunk{Chunk: &domain.Chunk{ID: id, DocumentID: id, Content: strings.Repeat("é", answerChunkChars)}})
+	}
+
+	passages := svc.buildPassages(context.Background(), results)
+	if want := answerContextChars / answerChunkChars; len(passages) != want {
+		t.Errorf("expected %d passages within the context budget, got %d", want, len(passages))
+	}
+}
+
+// collectEvents returns an emit func that records events
+func collectEvents(events *[]*domain.SummaryEvent) func(*domain.SummaryEvent) error {
+	return func(e *domain.SummaryEvent) error {
+		*events = append(*events, e)
+		return nil
+	}
+}
+
+func TestAnswerService_StreamSummary(t *testing.T) {
+	llm := mocks.NewMockLLMService()
+	llm.SetCompletion("Keys are rotated from the admin console [1].")
+	svc := answerFixture(t, llm)
+
+	var events []*domain.SummaryEvent
+	err := svc.StreamSummary(context.Background(), "signing keys", domain.SearchOptions{Mode: domain.SearchModeTextOnly}, collectEvents(&events))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(events) < 3 {
+		t.Fatalf("expected results, tokens and summary, got %d events", len(events))
+	}
+	if events[0].Type != domain.SummaryEventResults || len(events[0].Results.Results) != 2 {
+		t.Errorf("expected results event with 2 hits first, got %+v", events[0])
+	}
+
+	var streamed strings.Builder
+	for _, e := range events[1 : len(events)-1] {
+		if e.Type != domain.SummaryEventToken {
+			t.Errorf("expected token event, got %s", e.Type)
+		}
+		streamed.WriteString(e.Token)
+	}
+	if streamed.String() != "Keys are rotated from the admin console [1]." {
+		t.Errorf("expected tokens to reassemble the summary, got %q", streamed.String())
+	}
+
+	final := events[len(events)-1]
+	if final.Type != domain.SummaryEventSummary || len(final.Summary.Citations) != 1 {
+		t.Errorf("expected final summary with one citation, got %+v", final)
+	}
+}
+
+func TestAnswerService_StreamSummary_WithoutLLM(t *testing.T) {
+	svc := answerFixture(t, nil)
+
+	var events []*domain.SummaryEvent
+	err := svc.StreamSummary(context.Background(), "signing keys", domain.SearchOptions{Mode: domain.SearchModeTextOnly}, collectEvents(&events))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(events) != 2 || events[0].Type != domain.SummaryEventResults || events[1].Type != domain.SummaryEventError {
+		t.Errorf("expected results followed by an error event, got %+v", events)
+	}
+}
+
+func TestAnswerService_StreamSummary_EmitErrorStops(t *testing.T) {
+	llm := mocks.NewMockLLMService()
+	llm.SetCompletion("one two three four")
+	svc := answerFixture(t, llm)
+
+	gone := errors.New("client gone")
+	tokens := 0
+	err := svc.StreamSummary(context.Background(), "signing keys", domain.SearchOptions{Mode: domain.SearchModeTextOnly}, func(e *domain.SummaryEvent) error {
+		if e.Type == domain.SummaryEventToken {
+			tokens++
+			return gone
+		}
+		return nil
+	})
+
+	if !errors.Is(err, gone) {
+		t.Errorf("expected emit error to be returned, got %v", err)
+	}
+	if tokens != 1 {
+		t.Errorf("expected stream to stop after the first token, got %d", tokens)
+	}
+}
@@ -0,0 +1,131 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+)
+
+// defaultRateLimitPause is how long work pauses after a rate limit that did
+// not say how long to wait
+const defaultRateLimitPause = 5 * time.Second
+
+// maxRateLimitRetries is how often a rate-limited operation is retried
+const maxRateLimitRetries = 3
+
+// maxRateLimitWait is the longest requested delay a rate-limited operation
+// is retried after; beyond it the operation fails
+const maxRateLimitWait = 5 * time.Minute
+
+// adaptiveLimiter bounds the number of concurrent operations and adapts the
+// bound to rate limiting. A rate-limited operation halves the limit and
+// pauses new operations for the provider's requested delay; the limit then
+// grows back by one for every limit operations completing without a rate
+// limit (additive increase, multiplicative decrease).
+type adaptiveLimiter struct {
+	mu          sync.Mutex
+	max         int
+	limit       int
+	active      int
+	successes   int
+	pausedUntil time.Time
+	changed     chan struct{} // Closed and replaced when an operation may start
+}
+
+// newAdaptiveLimiter creates a limiter allowing up to max concurrent operations.
+func newAdaptiveLimiter(max int) *adaptiveLimiter {
+	if max < 1 {
+		max = 1
+	}
+	return &adaptiveLimiter{
+		max:     max,
+		limit:   max,
+		changed: make(chan struct{}),
+	}
+}
+
+// acquire blocks until an operation may start or ctx is done.
+func (l *adaptiveLimiter) acquire(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		wait := time.Until(l.pausedUntil)
+		if wait <= 0 && l.active < l.limit {
+			l.active++
+			l.mu.Unlock()
+			return nil
+		}
+		changed := l.changed
+		l.mu.Unlock()
+
+		var timer *time.Timer
+		var resume <-chan time.Time
+		if wait > 0 {
+			timer = time.NewTimer(wait)
+			resume = timer.C
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-changed:
+		case <-resume:
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
+
+// release ends an operation started with acquire, adapting the limit to
+// its outcome.
+func (l *adaptiveLimiter) release(err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.active--
+
+	var rateLimitErr *domain.RateLimitError
+	switch {
+	case errors.As(err, &rateLimitErr):
+		l.limit = max(1, l.limit/2)
+		l.successes = 0
+		pause := rateLimitErr.RetryAfter
+		if pause <= 0 {
+			pause = defaultRateLimitPause
+		}
+		if until := time.Now().Add(pause); until.After(l.pausedUntil) {
+			l.pausedUntil = until
+		}
+	case err == nil && l.limit < l.max:
+		l.successes++
+		if l.successes >= l.limit {
+			l.limit++
+			l.successes = 0
+		}
+	}
+
+	close(l.changed)
+	l.changed = make(chan struct{})
+}
+
+// currentLimit returns the current concurrency limit.
+func (l *adaptiveLimiter) currentLimit() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.limit
+}
+
+// retryRateLimited reports whether an operation that failed with err on the
+// given attempt (counting from 0) should be retried: it was rate limited
+// with a delay short enough to wait out, and retries remain.
+func retryRateLimited(err error, attempt int) bool {
+	var rateLimitErr *domain.RateLimitError
+	return errors.As(err, &rateLimitErr) &&
+		attempt < maxRateLimitRetries &&
+		rateLimitErr.RetryAfter <= maxRateLimitWait
+}
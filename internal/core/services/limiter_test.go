@@ -0,0 +1,78 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/custodia-labs/sercha-core/internal/core/domain"
+)
+
+func TestAdaptiveLimiter_BoundsConcurrency(t *testing.T) {
+	limiter := newAdaptiveLimiter(2)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if err := limiter.acquire(ctx); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	blocked, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	if err := limiter.acquire(blocked); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected acquire beyond the limit to block, got %v", err)
+	}
+
+	acquired := make(chan struct{})
+	go func() {
+		_ = limiter.acquire(ctx)
+		close(acquired)
+	}()
+	limiter.release(nil)
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal("expected release to unblock a waiting acquire")
+	}
+}
+
+func TestAdaptiveLimiter_RateLimited(t *testing.T) {
+	limiter := newAdaptiveLimiter(4)
+	ctx := context.Background()
+
+	_ = limiter.acquire(ctx)
+	limiter.release(&domain.RateLimitError{RetryAfter: 50 * time.Millisecond})
+	if limiter.currentLimit() != 2 {
+		t.Errorf("expected limit to halve to 2, got %d", limiter.currentLimit())
+	}
+
+	// New operations wait out the pause
+	start := time.Now()
+	_ = limiter.acquire(ctx)
+	if waited := time.Since(start); waited < 40*time.Millisecond {
+		t.Errorf("expected acquire to wait for the rate limit, waited %s", waited)
+	}
+	limiter.release(errors.New("not a rate limit"))
+	if limiter.currentLimit() != 2 {
+		t.Errorf("expected other errors to keep the limit, got %d", limiter.currentLimit())
+	}
+
+	// The limit grows back by one per limit successes, up to the maximum
+	for i := 0; i < 10; i++ {
+		_ = limiter.acquire(ctx)
+		limiter.release(nil)
+	}
+	if limiter.currentLimit() != 4 {
+		t.Errorf("expected limit to recover to 4, got %d", limiter.currentLimit())
+	}
+
+	for i := 0; i < 5; i++ {
+		_ = limiter.acquire(ctx)
+		limiter.release(&domain.RateLimitError{RetryAfter: time.Millisecond})
+	}
+	if limiter.currentLimit() != 1 {
+		t.Errorf("expected limit to stay at least 1, got %d", limiter.currentLimit())
+	}
+}
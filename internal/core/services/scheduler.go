@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"sync"
 	"time"
@@ -13,6 +14,10 @@ import (
 // Scheduler manages periodic task scheduling.
 // It runs on worker nodes and enqueues tasks based on schedules.
 //
+// When source and sync state stores are configured, it also enqueues a
+// sync_source task for each enabled source whose sync schedule is due.
+// Sources without a schedule use the team's SyncIntervalMinutes setting.
+//
 // For multi-worker deployments, configure a DistributedLock to prevent
 // duplicate task enqueuing across instances.
 type Scheduler struct {
@@ -21,6 +26,12 @@ type Scheduler struct {
 	lock      driven.DistributedLock
 	logger    *slog.Logger
 
+	// Per-source scheduling
+	sources    driven.SourceStore
+	syncStates driven.SyncStateStore
+	settings   driven.SettingsStore
+	teamID     string
+
 	// Internal state
 	mu       sync.RWMutex
 	running  bool
@@ -42,6 +53,12 @@ type SchedulerConfig struct {
 	PollInterval time.Duration // How often to check for due tasks (default: 30s)
 	LockTTL      time.Duration // TTL for the distributed lock (default: 60s)
 	LockRequired bool          // If true, skip scheduling when lock cannot be acquired (default: true)
+
+	// Per-source scheduling (optional, enabled when Sources and SyncStates are set)
+	Sources    driven.SourceStore
+	SyncStates driven.SyncStateStore
+	Settings   driven.SettingsStore // Optional: team sync settings, defaults if nil
+	TeamID     string               // Team for source sync tasks (default: "default")
 }
 
 // NewScheduler creates a new scheduler.
@@ -69,6 +86,11 @@ func NewScheduler(cfg SchedulerConfig) *Scheduler {
 		lockRequired = true
 	}
 
+	teamID := cfg.TeamID
+	if teamID == "" {
+		teamID = "default"
+	}
+
 	return &Scheduler{
 		store:        cfg.Store,
 		taskQueue:    cfg.TaskQueue,
@@ -77,6 +99,10 @@ func NewScheduler(cfg SchedulerConfig) *Scheduler {
 		interval:     interval,
 		lockTTL:      lockTTL,
 		lockRequired: lockRequired,
+		sources:      cfg.Sources,
+		syncStates:   cfg.SyncStates,
+		settings:     cfg.Settings,
+		teamID:       teamID,
 	}
 }
 
@@ -169,6 +195,8 @@ func (s *Scheduler) checkAndEnqueue(ctx context.Context) {
 		}
 	}
 
+	s.scheduleSources(ctx)
+
 	tasks, err := s.store.GetDueScheduledTasks(ctx)
 	if err != nil {
 		s.logger.Error("failed to get due scheduled tasks", "error", err)
@@ -210,6 +238,116 @@ func (s *Scheduler) checkAndEnqueue(ctx context.Context) {
 	}
 }
 
+// scheduleSources enqueues a sync_source task for each enabled source whose
+// schedule is due. A source's next sync is scheduled from when its last sync
+// started; sources that have never synced are due immediately. Sources that
+// are syncing or already have a queued sync are skipped.
+func (s *Scheduler) scheduleSources(ctx context.Context) {
+	if s.sources == nil || s.syncStates == nil {
+		return
+	}
+
+	settings := domain.DefaultSettings(s.teamID)
+	if s.settings != nil {
+		if stored, err := s.settings.GetSettings(ctx, s.teamID); err == nil {
+			settings = stored
+		} else if !errors.Is(err, domain.ErrNotFound) {
+			s.logger.Warn("failed to get sync settings, using defaults", "error", err)
+		}
+	}
+	if !settings.SyncEnabled {
+		return
+	}
+	var defaultSchedule domain.Schedule
+	if settings.SyncIntervalMinutes > 0 {
+		defaultSchedule = domain.IntervalSchedule(time.Duration(settings.SyncIntervalMinutes) * time.Minute)
+	}
+
+	queued, err := s.queuedSourceSyncs(ctx)
+	if err != nil {
+		s.logger.Error("failed to list queued sync tasks", "error", err)
+		return
+	}
+
+	sources, err := s.sources.List(ctx)
+	if err != nil {
+		s.logger.Error("failed to list sources", "error", err)
+		return
+	}
+
+	now := time.Now()
+	for _, source := range sources {
+		if !source.Enabled || queued[source.ID] {
+			continue
+		}
+
+		schedule, err := source.Schedule(defaultSchedule)
+		if err != nil {
+			s.logger.Warn("invalid source sync schedule",
+				"source_id", source.ID,
+				"schedule", source.SyncSchedule,
+				"error", err,
+			)
+			continue
+		}
+		if schedule.IsZero() {
+			continue
+		}
+
+		state, err := s.syncStates.Get(ctx, source.ID)
+		if err != nil && !errors.Is(err, domain.ErrNotFound) {
+			s.logger.Warn("failed to get sync state", "source_id", source.ID, "error", err)
+			continue
+		}
+		if state != nil {
+			if state.Status == domain.SyncStatusRunning {
+				continue
+			}
+			// A cancellation request whose sync died before acting on it
+			// would otherwise keep the source from syncing again
+			if state.Status == domain.SyncStatusCancelling && s.syncLockHeld(ctx, source.ID) {
+				continue
+			}
+			if state.StartedAt != nil && schedule.Next(*state.StartedAt).After(now) {
+				continue
+			}
+		}
+
+		task := domain.NewSyncSourceTaskWithOptions(s.teamID, source.ID, domain.SyncOptions{Trigger: domain.SyncTriggerScheduled})
+		if err := s.taskQueue.Enqueue(ctx, task); err != nil {
+			s.logger.Error("failed to enqueue source sync",
+				"source_id", source.ID,
+				"error", err,
+			)
+			continue
+		}
+
+		s.logger.Info("enqueued scheduled source sync",
+			"source_id", source.ID,
+			"task_id", task.ID,
+		)
+	}
+}
+
+// queuedSourceSyncs returns the IDs of sources with a pending or running sync task.
+func (s *Scheduler) queuedSourceSyncs(ctx context.Context) (map[string]bool, error) {
+	queued := make(map[string]bool)
+	for _, status := range []domain.TaskStatus{domain.TaskStatusPending, domain.TaskStatusProcessing} {
+		tasks, err := s.taskQueue.ListTasks(ctx, driven.TaskFilter{
+			TeamID: s.teamID,
+			Status: status,
+			Type:   domain.TaskTypeSyncSource,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, task := range tasks {
+			queued[task.SourceID()] = true
+		}
+	}
+	return queued, nil
+}
+
 // createTask creates a queue task from a scheduled task.
 func (s *Scheduler) createTask(scheduled *domain.ScheduledTask) *domain.Task {
 	task := domain.NewTask(scheduled.Type, scheduled.TeamID, nil)
@@ -286,3 +424,26 @@ func (s *Scheduler) TriggerNow(ctx context.Context, id string) (*domain.Task, er
 
 	return task, nil
 }
+
+// syncLockHeld reports whether a sync of the source holds its sync lock.
+// The lock is probed by acquiring and releasing it. Without a configured
+// lock, or if the probe fails, the lock is assumed held.
+func (s *Scheduler) syncLockHeld(ctx context.Context, sourceID string) bool {
+	if s.lock == nil {
+		return true
+	}
+
+	name := syncLockName(sourceID)
+	acquired, err := s.lock.Acquire(ctx, name, s.lockTTL)
+	if err != nil {
+		s.logger.Warn("failed to probe sync lock", "source_id", sourceID, "error", err)
+		return true
+	}
+	if !acquired {
+		return true
+	}
+	if err := s.lock.Release(ctx, name); err != nil {
+		s.logger.Warn("failed to release probed sync lock", "source_id", sourceID, "error", err)
+	}
+	return false
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/custodia-labs/sercha-core/internal/core/domain"
 	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven/mocks"
 )
 
 // mockSchedulerStore implements driven.SchedulerStore for testing
@@ -151,12 +152,28 @@ func (m *mockSchedulerTaskQueue) Nack(ctx context.Context, taskID string, reason
 	return nil
 }
 
+func (m *mockSchedulerTaskQueue) AckCancelled(ctx context.Context, taskID string, reason string) error {
+	return nil
+}
+
+func (m *mockSchedulerTaskQueue) Defer(ctx context.Context, taskID string, reason string, runAt time.Time) error {
+	return nil
+}
+
 func (m *mockSchedulerTaskQueue) GetTask(ctx context.Context, taskID string) (*domain.Task, error) {
 	return nil, domain.ErrNotFound
 }
 
 func (m *mockSchedulerTaskQueue) ListTasks(ctx context.Context, filter driven.TaskFilter) ([]*domain.Task, error) {
-	return m.tasks, nil
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var result []*domain.Task
+	for _, task := range m.tasks {
+		if (filter.Status == "" || task.Status == filter.Status) && (filter.Type == "" || task.Type == filter.Type) {
+			result = append(result, task)
+		}
+	}
+	return result, nil
 }
 
 func (m *mockSchedulerTaskQueue) CancelTask(ctx context.Context, taskID string) error {
@@ -903,3 +920,135 @@ func TestScheduler_CheckAndEnqueue_NoLock(t *testing.T) {
 func TestMockDistributedLockInterface(t *testing.T) {
 	var _ driven.DistributedLock = (*mockDistributedLock)(nil)
 }
+
+// newSourceScheduler creates a scheduler with per-source scheduling enabled
+func newSourceScheduler(settings *domain.Settings) (*Scheduler, *mocks.MockSourceStore, *mocks.MockSyncStateStore, *mockSchedulerTaskQueue) {
+	sources := mocks.NewMockSourceStore()
+	syncStates := mocks.NewMockSyncStateStore()
+	queue := newMockSchedulerTaskQueue()
+
+	s := NewScheduler(SchedulerConfig{
+		Store:      newMockSchedulerStore(),
+		TaskQueue:  queue,
+		Sources:    sources,
+		SyncStates: syncStates,
+		Settings:   &mockSettingsStore{settings: settings},
+	})
+	return s, sources, syncStates, queue
+}
+
+func enqueuedSourceIDs(queue *mockSchedulerTaskQueue) map[string]bool {
+	ids := make(map[string]bool)
+	for _, task := range queue.getEnqueuedTasks() {
+		if task.Type == domain.TaskTypeSyncSource {
+			ids[task.SourceID()] = true
+		}
+	}
+	return ids
+}
+
+func TestScheduler_ScheduleSources(t *testing.T) {
+	s, sources, syncStates, queue := newSourceScheduler(domain.DefaultSettings("default"))
+	ctx := context.Background()
+
+	recent := time.Now().Add(-10 * time.Minute)
+	longAgo := time.Now().Add(-2 * time.Hour)
+
+	// Default hourly schedule
+	_ = sources.Save(ctx, &domain.Source{ID: "never-synced", Enabled: true})
+	_ = sources.Save(ctx, &domain.Source{ID: "default-due", Enabled: true})
+	_ = syncStates.Save(ctx, &domain.SyncState{SourceID: "default-due", StartedAt: &longAgo})
+	_ = sources.Save(ctx, &domain.Source{ID: "default-not-due", Enabled: true})
+	_ = syncStates.Save(ctx, &domain.SyncState{SourceID: "default-not-due", StartedAt: &recent})
+
+	// Per-source schedules
+	_ = sources.Save(ctx, &domain.Source{ID: "every-5m", Enabled: true, SyncSchedule: "5m"})
+	_ = syncStates.Save(ctx, &domain.SyncState{SourceID: "every-5m", StartedAt: &recent})
+	_ = sources.Save(ctx, &domain.Source{ID: "yearly", Enabled: true, SyncSchedule: "@yearly"})
+	_ = syncStates.Save(ctx, &domain.SyncState{SourceID: "yearly", StartedAt: &recent})
+
+	// Skipped
+	_ = sources.Save(ctx, &domain.Source{ID: "disabled", Enabled: false})
+	_ = sources.Save(ctx, &domain.Source{ID: "invalid", Enabled: true, SyncSchedule: "whenever"})
+	_ = sources.Save(ctx, &domain.Source{ID: "running", Enabled: true})
+	_ = syncStates.Save(ctx, &domain.SyncState{SourceID: "running", Status: domain.SyncStatusRunning, StartedAt: &longAgo})
+
+	s.checkAndEnqueue(ctx)
+
+	got := enqueuedSourceIDs(queue)
+	want := map[string]bool{"never-synced": true, "default-due": true, "every-5m": true}
+	if len(got) != len(want) {
+		t.Errorf("expected syncs for %v, got %v", want, got)
+	}
+	for id := range want {
+		if !got[id] {
+			t.Errorf("expected sync for %s to be enqueued, got %v", id, got)
+		}
+	}
+	for _, task := range queue.getEnqueuedTasks() {
+		if task.SyncOptions().Trigger != domain.SyncTriggerScheduled {
+			t.Errorf("expected scheduled trigger, got %s", task.SyncOptions().Trigger)
+		}
+	}
+
+	// Sources with a queued sync are not enqueued again
+	s.checkAndEnqueue(ctx)
+	if n := len(queue.getEnqueuedTasks()); n != len(want) {
+		t.Errorf("expected queued syncs not to be duplicated, got %d tasks", n)
+	}
+}
+
+func TestScheduler_ScheduleSources_SyncDisabled(t *testing.T) {
+	settings := domain.DefaultSettings("default")
+	settings.SyncEnabled = false
+	s, sources, _, queue := newSourceScheduler(settings)
+	ctx := context.Background()
+
+	_ = sources.Save(ctx, &domain.Source{ID: "source-1", Enabled: true, SyncSchedule: "5m"})
+
+	s.checkAndEnqueue(ctx)
+
+	if n := len(queue.getEnqueuedTasks()); n != 0 {
+		t.Errorf("expected no syncs while sync is disabled, got %d", n)
+	}
+}
+
+func TestScheduler_ScheduleSources_NoDefaultInterval(t *testing.T) {
+	settings := domain.DefaultSettings("default")
+	settings.SyncIntervalMinutes = 0
+	s, sources, _, queue := newSourceScheduler(settings)
+	ctx := context.Background()
+
+	_ = sources.Save(ctx, &domain.Source{ID: "unscheduled", Enabled: true})
+	_ = sources.Save(ctx, &domain.Source{ID: "scheduled", Enabled: true, SyncSchedule: "0 2 * * *"})
+
+	s.checkAndEnqueue(ctx)
+
+	got := enqueuedSourceIDs(queue)
+	if len(got) != 1 || !got["scheduled"] {
+		t.Errorf("expected only the source with its own schedule to sync, got %v", got)
+	}
+}
+
+func TestScheduler_ScheduleSources_StaleCancellation(t *testing.T) {
+	s, sources, syncStates, queue := newSourceScheduler(domain.DefaultSettings("default"))
+	s.lock = &mockDistributedLock{
+		acquireFn: func(name string, ttl time.Duration) (bool, error) {
+			return name != syncLockName("cancelling-held"), nil
+		},
+	}
+	ctx := context.Background()
+
+	longAgo := time.Now().Add(-2 * time.Hour)
+	for _, id := range []string{"cancelling-held", "cancelling-stale"} {
+		_ = sources.Save(ctx, &domain.Source{ID: id, Enabled: true})
+		_ = syncStates.Save(ctx, &domain.SyncState{SourceID: id, Status: domain.SyncStatusCancelling, StartedAt: &longAgo})
+	}
+
+	s.checkAndEnqueue(ctx)
+
+	got := enqueuedSourceIDs(queue)
+	if len(got) != 1 || !got["cancelling-stale"] {
+		t.Errorf("expected only the cancellation without a running sync to be treated as idle, got %v", got)
+	}
+}
@@ -87,6 +87,13 @@ func (s *searchService) searchWithPipeline(
 	if len(opts.SourceIDs) > 0 {
 		pipelineInput.Filters.Sources = opts.SourceIDs
 	}
+	pipelineInput.Filters.ContentTypes = opts.Filters.MimeTypes
+	if opts.Filters.DateAfter != nil || opts.Filters.DateBefore != nil {
+		pipelineInput.Filters.DateRange = &pipeline.DateRange{
+			From: opts.Filters.DateAfter,
+			To:   opts.Filters.DateBefore,
+		}
+	}
 
 	// Build pipeline context
 	pipelineContext := &pipeline.SearchContext{
@@ -121,6 +121,14 @@ func (m *mockLLMService) RewriteQuery(ctx context.Context, query string) (string
 	return "", nil
 }
 
+func (m *mockLLMService) Complete(ctx context.Context, req driven.CompletionRequest) (string, error) {
+	return "", nil
+}
+
+func (m *mockLLMService) StreamComplete(ctx context.Context, req driven.CompletionRequest, onToken func(string) error) (string, error) {
+	return "", nil
+}
+
 func (m *mockLLMService) Model() string {
 	return "test-llm"
 }
@@ -42,6 +42,15 @@ func (s *sourceService) Create(ctx context.Context, creatorID string, req drivin
 	if req.Name == "" {
 		return nil, domain.ErrInvalidInput
 	}
+	schedule := strings.TrimSpace(req.SyncSchedule)
+	if schedule != "" {
+		if _, err := domain.ParseSchedule(schedule); err != nil {
+			return nil, domain.ErrInvalidInput
+		}
+	}
+	if _, err := domain.CompileRules(req.Config.Rules); err != nil {
+		return nil, err
+	}
 
 	// Check if name already exists
 	existing, _ := s.sourceStore.GetByName(ctx, req.Name)
@@ -57,6 +66,7 @@ func (s *sourceService) Create(ctx context.Context, creatorID string, req drivin
 		Config:             req.Config,
 		InstallationID:     req.InstallationID,
 		SelectedContainers: req.SelectedContainers,
+		SyncSchedule:       schedule,
 		Enabled:            true,
 		CreatedAt:          now,
 		UpdatedAt:          now,
@@ -136,6 +146,9 @@ func (s *sourceService) Update(ctx context.Context, id string, req driving.Updat
 	}
 
 	if req.Config != nil {
+		if _, err := domain.CompileRules(req.Config.Rules); err != nil {
+			return nil, err
+		}
 		source.Config = *req.Config
 	}
 
@@ -143,6 +156,16 @@ func (s *sourceService) Update(ctx context.Context, id string, req driving.Updat
 		source.Enabled = *req.Enabled
 	}
 
+	if req.SyncSchedule != nil {
+		schedule := strings.TrimSpace(*req.SyncSchedule)
+		if schedule != "" {
+			if _, err := domain.ParseSchedule(schedule); err != nil {
+				return nil, domain.ErrInvalidInput
+			}
+		}
+		source.SyncSchedule = schedule
+	}
+
 	source.UpdatedAt = time.Now()
 
 	if err := s.sourceStore.Save(ctx, source); err != nil {
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -301,6 +302,76 @@ func TestSourceService_Update_ConflictingName(t *testing.T) {
 	}
 }
 
+func TestSourceService_SyncSchedule(t *testing.T) {
+	sourceStore := mocks.NewMockSourceStore()
+	documentStore := mocks.NewMockDocumentStore()
+	syncStore := mocks.NewMockSyncStateStore()
+	searchEngine := mocks.NewMockSearchEngine()
+	svc := NewSourceService(sourceStore, documentStore, syncStore, searchEngine)
+	ctx := context.Background()
+
+	_, err := svc.Create(ctx, "user-1", driving.CreateSourceRequest{Name: "Bad", SyncSchedule: "every so often"})
+	if err != domain.ErrInvalidInput {
+		t.Errorf("expected ErrInvalidInput for invalid schedule, got %v", err)
+	}
+
+	source, err := svc.Create(ctx, "user-1", driving.CreateSourceRequest{Name: "Slack", SyncSchedule: " */5 * * * * "})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if source.SyncSchedule != "*/5 * * * *" {
+		t.Errorf("expected trimmed schedule, got %q", source.SyncSchedule)
+	}
+
+	schedule := "25h"
+	if _, err := svc.Update(ctx, source.ID, driving.UpdateSourceRequest{SyncSchedule: &schedule}); err != nil {
+		t.Errorf("expected long interval to be valid, got %v", err)
+	}
+	schedule = "0 25 * * *"
+	if _, err := svc.Update(ctx, source.ID, driving.UpdateSourceRequest{SyncSchedule: &schedule}); err != domain.ErrInvalidInput {
+		t.Errorf("expected ErrInvalidInput, got %v", err)
+	}
+
+	// An empty schedule reverts to the team default
+	empty := ""
+	updated, err := svc.Update(ctx, source.ID, driving.UpdateSourceRequest{SyncSchedule: &empty})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated.SyncSchedule != "" {
+		t.Errorf("expected schedule to be cleared, got %q", updated.SyncSchedule)
+	}
+}
+
+func TestSourceService_Rules(t *testing.T) {
+	sourceStore := mocks.NewMockSourceStore()
+	documentStore := mocks.NewMockDocumentStore()
+	syncStore := mocks.NewMockSyncStateStore()
+	searchEngine := mocks.NewMockSearchEngine()
+	svc := NewSourceService(sourceStore, documentStore, syncStore, searchEngine)
+	ctx := context.Background()
+
+	invalid := domain.SourceConfig{Rules: &domain.SourceRules{ExcludeTitlePattern: "[draft"}}
+	_, err := svc.Create(ctx, "user-1", driving.CreateSourceRequest{Name: "Bad", Config: invalid})
+	if !errors.Is(err, domain.ErrInvalidInput) {
+		t.Errorf("expected ErrInvalidInput for invalid rules, got %v", err)
+	}
+
+	valid := domain.SourceConfig{Rules: &domain.SourceRules{ExcludePaths: []string{"vendor/**"}}}
+	source, err := svc.Create(ctx, "user-1", driving.CreateSourceRequest{Name: "Repo", Config: valid})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := svc.Update(ctx, source.ID, driving.UpdateSourceRequest{Config: &invalid}); !errors.Is(err, domain.ErrInvalidInput) {
+		t.Errorf("expected ErrInvalidInput for invalid rules, got %v", err)
+	}
+	stored, _ := sourceStore.Get(ctx, source.ID)
+	if stored.Config.Rules == nil || stored.Config.Rules.ExcludeTitlePattern != "" {
+		t.Errorf("expected the valid rules to be kept, got %+v", stored.Config.Rules)
+	}
+}
+
 func TestSourceService_Delete(t *testing.T) {
 	sourceStore := mocks.NewMockSourceStore()
 	documentStore := mocks.NewMockDocumentStore()
@@ -2,9 +2,16 @@ package services
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
+	"strings"
+	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/custodia-labs/sercha-core/internal/core/domain"
 	"github.com/custodia-labs/sercha-core/internal/core/domain/pipeline"
@@ -13,31 +20,85 @@ import (
 	"github.com/custodia-labs/sercha-core/internal/runtime"
 )
 
+// defaultCancelPollInterval is how often a running sync checks for a cancellation request
+const defaultCancelPollInterval = 2 * time.Second
+
+// defaultReconcileMaxDeleteFraction is the largest fraction of a source's
+// documents a full sync may delete as orphans
+const defaultReconcileMaxDeleteFraction = 0.5
+
+// defaultSyncRunRetention is how many sync runs are kept per source
+const defaultSyncRunRetention = 100
+
+// defaultCheckpointInterval is how often a running sync saves its progress
+const defaultCheckpointInterval = 30 * time.Second
+
+// maxRulesPreviewDocuments caps the documents a rules preview lists as
+// added and as removed
+const maxRulesPreviewDocuments = 100
+
+// approxCharsPerToken is the characters per token assumed when estimating
+// embedding tokens
+const approxCharsPerToken = 4
+
+// dryRunExcerptLength is the length of the content excerpts of dry-run samples
+const dryRunExcerptLength = 280
+
+// defaultSyncLockTTL is how long a source's sync lock outlives its last extension
+const defaultSyncLockTTL = 2 * time.Minute
+
+// errSyncLockLost stops a sync whose source lock could not be extended
+var errSyncLockLost = errors.New("sync lock lost")
+
 // We need a ChunkStore for saving chunks separately
 // The SyncOrchestrator needs both DocumentStore and ChunkStore
 
 // SyncOrchestrator coordinates the document sync pipeline.
-// It implements the 7-step sync flow:
+// It implements the sync flow:
 //  1. Get source config
 //  2. Create connector
 //  3. Validate connector
 //  4. Get sync state (cursor for incremental sync)
 //  5. Fetch documents
-//  6. Process each document (normalise → chunk → embed → store → index)
-//  7. Update sync cursor
+//  6. Process each document (extract → normalise → chunk → embed → store → index),
+//     skipping documents whose content hash is unchanged and recording
+//     failed documents for retry
+//  7. On full syncs, delete documents the connector no longer returns
+//  8. Update sync cursor
+//  9. Record the run in the sync history
+//
+// Progress is checkpointed in the sync state while the sync runs, so a sync
+// interrupted by a crash, shutdown or cancellation resumes where it stopped.
+// Containers, and documents within a container, can be processed in
+// parallel; the concurrency backs off while the provider rate limits.
+// With a distributed lock configured, a source is synced by one sync at a
+// time; a sync started while another holds the source's lock fails with
+// domain.ErrSyncInProgress.
 type SyncOrchestrator struct {
-	sourceStore      driven.SourceStore
-	documentStore    driven.DocumentStore
-	chunkStore       driven.ChunkStore
-	syncStore        driven.SyncStateStore
-	searchEngine     driven.SearchEngine
-	connectorFactory driven.ConnectorFactory
-	normaliserReg    driven.NormaliserRegistry
-	legacyPipeline   driven.PostProcessorPipeline
-	services         *runtime.Services
-	logger           *slog.Logger
-	indexingExecutor pipelineport.IndexingExecutor // Optional pipeline executor
-	capabilitySet    *pipeline.CapabilitySet       // Capabilities for pipeline
+	sourceStore          driven.SourceStore
+	documentStore        driven.DocumentStore
+	chunkStore           driven.ChunkStore
+	syncStore            driven.SyncStateStore
+	runStore             driven.SyncRunStore       // Optional, records sync history
+	errorStore           driven.DocumentErrorStore // Optional, records failed documents for retry
+	dryRunStore          driven.DryRunStore        // Optional, keeps dry-run results
+	searchEngine         driven.SearchEngine
+	lock                 driven.DistributedLock // Optional, serialises the syncs of a source
+	connectorFactory     driven.ConnectorFactory
+	normaliserReg        driven.NormaliserRegistry
+	contentExtractor     driven.ContentExtractor // Optional, converts binary formats to text
+	legacyPipeline       driven.PostProcessorPipeline
+	services             *runtime.Services
+	logger               *slog.Logger
+	indexingExecutor     pipelineport.IndexingExecutor // Optional pipeline executor
+	capabilitySet        *pipeline.CapabilitySet       // Capabilities for pipeline
+	cancelPoll           time.Duration
+	maxDeleteRatio       float64
+	runRetention         int
+	checkpointEvery      time.Duration
+	containerConcurrency int
+	documentConcurrency  int
+	lockTTL              time.Duration
 }
 
 // SyncOrchestratorConfig holds dependencies for SyncOrchestrator.
@@ -46,14 +107,52 @@ type SyncOrchestratorConfig struct {
 	DocumentStore    driven.DocumentStore
 	ChunkStore       driven.ChunkStore
 	SyncStore        driven.SyncStateStore
+	SyncRunStore     driven.SyncRunStore       // Optional, records sync history
+	DocumentErrors   driven.DocumentErrorStore // Optional, records failed documents for retry
+	DryRuns          driven.DryRunStore        // Optional, keeps dry-run results
 	SearchEngine     driven.SearchEngine
+	Lock             driven.DistributedLock // Optional, serialises the syncs of a source
 	ConnectorFactory driven.ConnectorFactory
 	NormaliserReg    driven.NormaliserRegistry
+	ContentExtractor driven.ContentExtractor // Optional, converts binary formats to text
 	LegacyPipeline   driven.PostProcessorPipeline
 	Services         *runtime.Services
 	Logger           *slog.Logger
 	IndexingExecutor pipelineport.IndexingExecutor // Optional pipeline executor
 	CapabilitySet    *pipeline.CapabilitySet       // Capabilities for pipeline
+
+	// CancelPollInterval is how often a running sync checks the sync state
+	// for a cancellation request (default 2s)
+	CancelPollInterval time.Duration
+
+	// ReconcileMaxDeleteFraction is the largest fraction of a source's
+	// documents a full sync may delete because the connector no longer
+	// enumerates them (default 0.5). Above it deletion is skipped, guarding
+	// against a misconfigured or temporarily empty source wiping the index.
+	// Values of 1 or more disable the check.
+	ReconcileMaxDeleteFraction float64
+
+	// SyncRunRetention is how many sync runs are kept per source (default 100)
+	SyncRunRetention int
+
+	// CheckpointInterval is how often a running sync saves its progress for
+	// resuming after an interruption (default 30s). Completed containers are
+	// always checkpointed.
+	CheckpointInterval time.Duration
+
+	// ContainerConcurrency is how many containers of a source are synced in
+	// parallel (default 1). Their connector requests share a limit that is
+	// lowered while the provider rate limits.
+	ContainerConcurrency int
+
+	// DocumentConcurrency is how many documents of a container are processed
+	// in parallel (default 1)
+	DocumentConcurrency int
+
+	// LockTTL is how long a source's sync lock is held without being
+	// extended (default 2m). A running sync extends it every third of the
+	// TTL, so the lock of a crashed worker frees up within the TTL.
+	LockTTL time.Duration
 }
 
 // NewSyncOrchestrator creates a new sync orchestrator.
@@ -63,29 +162,94 @@ func NewSyncOrchestrator(cfg SyncOrchestratorConfig) *SyncOrchestrator {
 		logger = slog.Default()
 	}
 
+	cancelPoll := cfg.CancelPollInterval
+	if cancelPoll <= 0 {
+		cancelPoll = defaultCancelPollInterval
+	}
+
+	maxDeleteRatio := cfg.ReconcileMaxDeleteFraction
+	if maxDeleteRatio <= 0 {
+		maxDeleteRatio = defaultReconcileMaxDeleteFraction
+	}
+
+	runRetention := cfg.SyncRunRetention
+	if runRetention <= 0 {
+		runRetention = defaultSyncRunRetention
+	}
+
+	containerConcurrency := max(cfg.ContainerConcurrency, 1)
+	documentConcurrency := max(cfg.DocumentConcurrency, 1)
+
+	checkpointEvery := cfg.CheckpointInterval
+	if checkpointEvery <= 0 {
+		checkpointEvery = defaultCheckpointInterval
+	}
+
+	lockTTL := cfg.LockTTL
+	if lockTTL <= 0 {
+		lockTTL = defaultSyncLockTTL
+	}
+
 	return &SyncOrchestrator{
-		sourceStore:      cfg.SourceStore,
-		documentStore:    cfg.DocumentStore,
-		chunkStore:       cfg.ChunkStore,
-		syncStore:        cfg.SyncStore,
-		searchEngine:     cfg.SearchEngine,
-		connectorFactory: cfg.ConnectorFactory,
-		normaliserReg:    cfg.NormaliserReg,
-		legacyPipeline:   cfg.LegacyPipeline,
-		services:         cfg.Services,
-		logger:           logger,
-		indexingExecutor: cfg.IndexingExecutor,
-		capabilitySet:    cfg.CapabilitySet,
+		sourceStore:          cfg.SourceStore,
+		documentStore:        cfg.DocumentStore,
+		chunkStore:           cfg.ChunkStore,
+		syncStore:            cfg.SyncStore,
+		runStore:             cfg.SyncRunStore,
+		errorStore:           cfg.DocumentErrors,
+		dryRunStore:          cfg.DryRuns,
+		searchEngine:         cfg.SearchEngine,
+		lock:                 cfg.Lock,
+		connectorFactory:     cfg.ConnectorFactory,
+		normaliserReg:        cfg.NormaliserReg,
+		contentExtractor:     cfg.ContentExtractor,
+		legacyPipeline:       cfg.LegacyPipeline,
+		services:             cfg.Services,
+		logger:               logger,
+		indexingExecutor:     cfg.IndexingExecutor,
+		capabilitySet:        cfg.CapabilitySet,
+		cancelPoll:           cancelPoll,
+		maxDeleteRatio:       maxDeleteRatio,
+		runRetention:         runRetention,
+		checkpointEvery:      checkpointEvery,
+		containerConcurrency: containerConcurrency,
+		documentConcurrency:  documentConcurrency,
+		lockTTL:              lockTTL,
 	}
 }
 
 // SyncSource synchronizes a single source.
 // This is the main entry point for the sync pipeline.
 // For sources with container selection, it syncs each selected container.
+// The sync is incremental from the stored cursor; a source without a cursor
+// is fully synced. The sync is recorded as manually triggered.
 func (o *SyncOrchestrator) SyncSource(ctx context.Context, sourceID string) (*domain.SyncResult, error) {
+	return o.SyncWithOptions(ctx, sourceID, domain.SyncOptions{})
+}
+
+// SyncWithOptions synchronizes a single source with the given options.
+// A full sync ignores the stored cursor; because the connector then
+// enumerates every document, documents it no longer returns are deleted
+// (see reconcileDeletes).
+// If an earlier sync of the source was interrupted, the sync resumes from
+// its checkpoint, unless a full sync is requested and the interrupted sync
+// was incremental.
+// If another sync holds the source's lock, the sync does not start and
+// domain.ErrSyncInProgress is returned, leaving the sync state untouched.
+func (o *SyncOrchestrator) SyncWithOptions(ctx context.Context, sourceID string, opts domain.SyncOptions) (*domain.SyncResult, error) {
+	if opts.Trigger == "" {
+		opts.Trigger = domain.SyncTriggerManual
+	}
 	startTime := time.Now()
 
-	o.logger.Info("starting sync", "source_id", sourceID)
+	ctx, unlock, err := o.lockSource(ctx, sourceID)
+	if err != nil {
+		o.logger.Info("sync not started", "source_id", sourceID, "trigger", opts.Trigger, "reason", err)
+		return &domain.SyncResult{SourceID: sourceID, Success: false, Error: err.Error()}, err
+	}
+	defer unlock()
+
+	o.logger.Info("starting sync", "source_id", sourceID, "trigger", opts.Trigger, "full", opts.Full)
 
 	// Step 1: Get source config
 	source, err := o.sourceStore.Get(ctx, sourceID)
@@ -96,6 +260,9 @@ func (o *SyncOrchestrator) SyncSource(ctx context.Context, sourceID string) (*do
 	if !source.Enabled {
 		return o.failSync(ctx, sourceID, startTime, fmt.Errorf("source is disabled"))
 	}
+	if _, err := domain.CompileRules(source.Config.Rules); err != nil {
+		return o.failSync(ctx, sourceID, startTime, fmt.Errorf("invalid source rules: %w", err))
+	}
 
 	// Step 2: Get sync state
 	syncState, err := o.syncStore.Get(ctx, sourceID)
@@ -108,6 +275,32 @@ func (o *SyncOrchestrator) SyncSource(ctx context.Context, sourceID string) (*do
 		}
 	}
 
+	// Resume an interrupted sync from its checkpoint
+	checkpoint, err := o.syncStore.GetCheckpoint(ctx, sourceID)
+	if err != nil {
+		o.logger.Warn("failed to get sync checkpoint", "source_id", sourceID, "error", err)
+		checkpoint = nil
+	}
+	if checkpoint != nil && opts.Full && !checkpoint.Full {
+		checkpoint = nil
+	}
+	if checkpoint == nil {
+		// Checkpoints add to the seen external IDs saved before, so drop
+		// those of any checkpoint not resumed
+		if err := o.syncStore.SaveCheckpoint(ctx, sourceID, nil); err != nil {
+			o.logger.Warn("failed to clear sync checkpoint", "source_id", sourceID, "error", err)
+		}
+	}
+	if checkpoint != nil {
+		opts.Full = checkpoint.Full
+		o.logger.Info("resuming sync from checkpoint",
+			"source_id", sourceID,
+			"completed_containers", len(checkpoint.CompletedContainers),
+			"containers_in_progress", len(checkpoint.PageTokens),
+			"checkpointed_at", checkpoint.UpdatedAt,
+		)
+	}
+
 	// Mark as running
 	now := time.Now()
 	syncState.Status = domain.SyncStatusRunning
@@ -117,6 +310,15 @@ func (o *SyncOrchestrator) SyncSource(ctx context.Context, sourceID string) (*do
 		o.logger.Warn("failed to update sync state to running", "error", err)
 	}
 
+	run := &domain.SyncRun{
+		ID:         domain.GenerateID(),
+		SourceID:   sourceID,
+		Trigger:    opts.Trigger,
+		Full:       opts.Full,
+		Containers: source.SelectedContainers,
+		StartedAt:  now,
+	}
+
 	// Determine containers to sync
 	// If selected containers are specified, sync each one
 	// Otherwise, sync with empty containerID (provider indexes all content)
@@ -125,38 +327,153 @@ func (o *SyncOrchestrator) SyncSource(ctx context.Context, sourceID string) (*do
 		containers = []string{""} // Empty string means sync all accessible content
 	}
 
+	// Cancellation requests arrive through the shared sync state, so a
+	// request made on any node stops the sync on the node running it
+	syncCtx, cancelSync := context.WithCancelCause(ctx)
+	watchDone := make(chan struct{})
+	go func() {
+		defer close(watchDone)
+		o.watchCancellation(syncCtx, sourceID, cancelSync)
+	}()
+	stopWatching := func() {
+		cancelSync(nil)
+		<-watchDone
+	}
+	defer stopWatching()
+
+	tracker := &syncTracker{
+		run:            run,
+		failed:         o.failedDocuments(ctx, sourceID),
+		progress:       make(map[string]*containerProgress),
+		checkpointedAt: now,
+	}
+
+	// A sync without a cursor enumerates every document, so the external
+	// IDs it sees can be reconciled against the stored documents
+	startCursor := syncState.Cursor
+	if opts.Full {
+		startCursor = ""
+	}
+
 	// Aggregate stats across all containers
 	aggregatedStats := domain.SyncStats{}
 	var lastCursor string
 	var syncErrors []string
 
-	// Step 3: Sync each container
+	completed := make(map[string]bool)
+	if checkpoint != nil {
+		startCursor = checkpoint.Cursor
+		aggregatedStats = checkpoint.Stats
+		lastCursor = checkpoint.NextCursor
+		for _, containerID := range checkpoint.CompletedContainers {
+			completed[containerID] = true
+		}
+	}
+	if startCursor == "" {
+		tracker.seen = make(map[string]struct{})
+		if checkpoint != nil {
+			for _, externalID := range checkpoint.Seen {
+				tracker.seen[externalID] = struct{}{}
+			}
+		}
+	}
+	tracker.checkpoint = &domain.SyncCheckpoint{
+		Cursor:     startCursor,
+		Full:       opts.Full,
+		NextCursor: lastCursor,
+		Stats:      aggregatedStats,
+	}
+	if checkpoint != nil {
+		tracker.checkpoint.CompletedContainers = checkpoint.CompletedContainers
+	}
+
+	// Step 3: Sync the containers, up to the container concurrency in
+	// parallel. Their connector requests share a limiter that backs off
+	// when the provider rate limits.
+	fetchLimiter := newAdaptiveLimiter(o.containerConcurrency)
+	slots := make(chan struct{}, o.containerConcurrency)
+	var mu sync.Mutex // Guards the aggregated results
+	var wg sync.WaitGroup
+
+dispatch:
 	for _, containerID := range containers {
-		containerStats, cursor, err := o.syncContainer(ctx, source, syncState, containerID)
-		if err != nil {
-			o.logger.Error("container sync failed",
-				"source_id", sourceID,
-				"container_id", containerID,
-				"error", err,
-			)
-			syncErrors = append(syncErrors, fmt.Sprintf("%s: %s", containerID, err.Error()))
-			aggregatedStats.Errors++
+		if completed[containerID] {
 			continue
 		}
+		select {
+		case slots <- struct{}{}:
+		case <-syncCtx.Done():
+			break dispatch
+		}
+		var pageToken string
+		if checkpoint != nil {
+			pageToken = checkpoint.PageTokens[containerID]
+		}
 
-		// Aggregate stats
-		aggregatedStats.DocumentsAdded += containerStats.DocumentsAdded
-		aggregatedStats.DocumentsUpdated += containerStats.DocumentsUpdated
-		aggregatedStats.DocumentsDeleted += containerStats.DocumentsDeleted
-		aggregatedStats.ChunksIndexed += containerStats.ChunksIndexed
-		aggregatedStats.Errors += containerStats.Errors
+		wg.Add(1)
+		go func(containerID, pageToken string) {
+			defer wg.Done()
+			defer func() { <-slots }()
 
-		if cursor != "" {
-			lastCursor = cursor // Use last non-empty cursor
+			containerStats, cursor, err := o.syncContainer(syncCtx, source, startCursor, pageToken, containerID, tracker, fetchLimiter)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			// Interrupted: the checkpoint holds the container's progress
+			if syncCtx.Err() != nil {
+				if containerStats != nil {
+					addSyncStats(&aggregatedStats, containerStats)
+				}
+				return
+			}
+			if err != nil {
+				o.logger.Error("container sync failed",
+					"source_id", sourceID,
+					"container_id", containerID,
+					"error", err,
+				)
+				syncErrors = append(syncErrors, fmt.Sprintf("%s: %s", containerID, err.Error()))
+				tracker.addError(containerID, "", err)
+				tracker.failContainer(containerID)
+				aggregatedStats.Errors++
+				return
+			}
+
+			// Aggregate stats
+			addSyncStats(&aggregatedStats, containerStats)
+
+			lastCursor = o.mergeCursors(source, lastCursor, cursor)
+
+			tracker.completeContainer(containerID, lastCursor, aggregatedStats)
+			o.saveCheckpoint(ctx, sourceID, tracker, true)
+		}(containerID, pageToken)
+	}
+	wg.Wait()
+
+	if isSyncCancelled(syncCtx) {
+		stopWatching()
+		return o.cancelledSync(ctx, syncState, run, aggregatedStats, startTime)
+	}
+	if ctx.Err() != nil {
+		stopWatching()
+		return o.interruptedSync(context.WithoutCancel(ctx), syncState, run, aggregatedStats, startTime, context.Cause(ctx))
+	}
+
+	stopWatching()
+
+	// Step 4: Delete documents a full sync no longer sees. Skipped if any
+	// container failed, since its documents were not all enumerated.
+	var reconcileErr error
+	if tracker.seen != nil && len(syncErrors) == 0 {
+		o.clearUnseenFailures(ctx, sourceID, tracker)
+		reconcileErr = o.reconcileDeletes(ctx, sourceID, tracker, &aggregatedStats)
+		if reconcileErr != nil {
+			o.logger.Warn("deletion reconciliation skipped", "source_id", sourceID, "error", reconcileErr)
 		}
 	}
 
-	// Step 4: Update final sync state
+	// Step 5: Update final sync state
 	completedAt := time.Now()
 	if len(syncErrors) > 0 && len(syncErrors) == len(containers) {
 		// All containers failed
@@ -166,6 +483,10 @@ func (o *SyncOrchestrator) SyncSource(ctx context.Context, sourceID string) (*do
 		// Partial failure
 		syncState.Status = domain.SyncStatusCompleted // Still mark as completed
 		syncState.Error = fmt.Sprintf("partial failure: %v", syncErrors)
+	} else if reconcileErr != nil {
+		// Reported on the sync state, but the sync itself succeeded
+		syncState.Status = domain.SyncStatusCompleted
+		syncState.Error = reconcileErr.Error()
 	} else {
 		syncState.Status = domain.SyncStatusCompleted
 		syncState.Error = ""
@@ -179,6 +500,10 @@ func (o *SyncOrchestrator) SyncSource(ctx context.Context, sourceID string) (*do
 	if err := o.syncStore.Save(ctx, syncState); err != nil {
 		o.logger.Warn("failed to update sync state", "error", err)
 	}
+	if err := o.syncStore.SaveCheckpoint(ctx, sourceID, nil); err != nil {
+		o.logger.Warn("failed to clear sync checkpoint", "source_id", sourceID, "error", err)
+	}
+	o.recordRun(ctx, run, syncState)
 
 	duration := time.Since(startTime).Seconds()
 
@@ -189,11 +514,13 @@ func (o *SyncOrchestrator) SyncSource(ctx context.Context, sourceID string) (*do
 		"documents_added", aggregatedStats.DocumentsAdded,
 		"documents_updated", aggregatedStats.DocumentsUpdated,
 		"documents_deleted", aggregatedStats.DocumentsDeleted,
+		"documents_skipped", aggregatedStats.DocumentsSkipped,
+		"documents_excluded", aggregatedStats.DocumentsExcluded,
 		"chunks_indexed", aggregatedStats.ChunksIndexed,
 		"errors", aggregatedStats.Errors,
 	)
 
-	success := syncState.Status == domain.SyncStatusCompleted && syncState.Error == ""
+	success := len(syncErrors) == 0
 	return &domain.SyncResult{
 		SourceID: sourceID,
 		Success:  success,
@@ -204,13 +531,531 @@ func (o *SyncOrchestrator) SyncSource(ctx context.Context, sourceID string) (*do
 	}, nil
 }
 
-// syncContainer syncs a single container within a source.
+// addSyncStats adds the counters of src to dst.
+func addSyncStats(dst, src *domain.SyncStats) {
+	dst.DocumentsAdded += src.DocumentsAdded
+	dst.DocumentsUpdated += src.DocumentsUpdated
+	dst.DocumentsDeleted += src.DocumentsDeleted
+	dst.DocumentsSkipped += src.DocumentsSkipped
+	dst.DocumentsExcluded += src.DocumentsExcluded
+	dst.ChunksIndexed += src.ChunksIndexed
+	dst.Errors += src.Errors
+}
+
+// watchCancellation polls the sync state until ctx is done and cancels the
+// sync with domain.ErrSyncCancelled once cancellation has been requested.
+func (o *SyncOrchestrator) watchCancellation(ctx context.Context, sourceID string, cancel context.CancelCauseFunc) {
+	ticker := time.NewTicker(o.cancelPoll)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		state, err := o.syncStore.Get(ctx, sourceID)
+		if err != nil {
+			continue // Transient; the next poll retries
+		}
+		if state.Status == domain.SyncStatusCancelling {
+			o.logger.Info("sync cancellation requested", "source_id", sourceID)
+			cancel(domain.ErrSyncCancelled)
+			return
+		}
+	}
+}
+
+// isSyncCancelled reports whether ctx was cancelled by a cancellation request.
+func isSyncCancelled(ctx context.Context) bool {
+	return errors.Is(context.Cause(ctx), domain.ErrSyncCancelled)
+}
+
+// cancelledSync records a sync stopped by a cancellation request.
+// Documents processed before the request stay indexed and the checkpoint is
+// kept, so the next sync resumes from it (documents processed again since
+// the checkpoint are skipped by content hash).
+func (o *SyncOrchestrator) cancelledSync(
+	ctx context.Context,
+	syncState *domain.SyncState,
+	run *domain.SyncRun,
+	stats domain.SyncStats,
+	startTime time.Time,
+) (*domain.SyncResult, error) {
+	duration := time.Since(startTime).Seconds()
+
+	completedAt := time.Now()
+	syncState.Status = domain.SyncStatusCancelled
+	syncState.Error = "cancelled by user"
+	syncState.CompletedAt = &completedAt
+	syncState.Stats = stats
+
+	if err := o.syncStore.Save(ctx, syncState); err != nil {
+		o.logger.Warn("failed to update sync state", "error", err)
+	}
+	o.recordRun(ctx, run, syncState)
+
+	o.logger.Info("sync cancelled",
+		"source_id", syncState.SourceID,
+		"duration_seconds", duration,
+		"documents_added", stats.DocumentsAdded,
+		"documents_updated", stats.DocumentsUpdated,
+		"documents_deleted", stats.DocumentsDeleted,
+	)
+
+	return &domain.SyncResult{
+		SourceID: syncState.SourceID,
+		Success:  false,
+		Stats:    stats,
+		Duration: duration,
+		Cursor:   syncState.Cursor,
+		Error:    syncState.Error,
+	}, domain.ErrSyncCancelled
+}
+
+// interruptedSync records a sync stopped by its context, typically because
+// the worker is shutting down or the source's lock was lost. The sync is marked failed but its checkpoint
+// is kept, and the returned error leaves the task to be retried, resuming
+// from the checkpoint.
+func (o *SyncOrchestrator) interruptedSync(
+	ctx context.Context,
+	syncState *domain.SyncState,
+	run *domain.SyncRun,
+	stats domain.SyncStats,
+	startTime time.Time,
+	cause error,
+) (*domain.SyncResult, error) {
+	duration := time.Since(startTime).Seconds()
+
+	completedAt := time.Now()
+	syncState.Status = domain.SyncStatusFailed
+	syncState.Error = fmt.Sprintf("sync interrupted, resumes from checkpoint: %v", cause)
+	syncState.CompletedAt = &completedAt
+	syncState.Stats = stats
+
+	if err := o.syncStore.Save(ctx, syncState); err != nil {
+		o.logger.Warn("failed to update sync state", "error", err)
+	}
+	o.recordRun(ctx, run, syncState)
+
+	o.logger.Warn("sync interrupted",
+		"source_id", syncState.SourceID,
+		"duration_seconds", duration,
+		"error", cause,
+	)
+
+	return &domain.SyncResult{
+		SourceID: syncState.SourceID,
+		Success:  false,
+		Stats:    stats,
+		Duration: duration,
+		Cursor:   syncState.Cursor,
+		Error:    syncState.Error,
+	}, fmt.Errorf("sync interrupted: %w", cause)
+}
+
+// syncLockName returns the name of a source's sync lock.
+func syncLockName(sourceID string) string {
+	return "sync:" + sourceID
+}
+
+// lockSource acquires the sync lock of a source, failing with
+// domain.ErrSyncInProgress while another sync holds it. Until unlock is
+// called the lock is extended in the background; if it cannot be extended
+// before it expires, the returned context is cancelled with errSyncLockLost
+// so the sync stops before another one takes over the source.
+// Without a configured lock, ctx is returned unchanged.
+func (o *SyncOrchestrator) lockSource(ctx context.Context, sourceID string) (context.Context, func(), error) {
+	if o.lock == nil {
+		return ctx, func() {}, nil
+	}
+
+	name := syncLockName(sourceID)
+	acquired, err := o.lock.Acquire(ctx, name, o.lockTTL)
+	if err != nil {
+		return ctx, nil, fmt.Errorf("failed to acquire sync lock: %w", err)
+	}
+	if !acquired {
+		return ctx, nil, domain.ErrSyncInProgress
+	}
+
+	lockCtx, cancel := context.WithCancelCause(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		o.extendLock(lockCtx, sourceID, name, cancel)
+	}()
+
+	unlock := func() {
+		cancel(nil)
+		<-done
+		if err := o.lock.Release(context.WithoutCancel(ctx), name); err != nil {
+			o.logger.Warn("failed to release sync lock", "source_id", sourceID, "error", err)
+		}
+	}
+	return lockCtx, unlock, nil
+}
+
+// extendLock extends a held sync lock every third of its TTL until ctx is
+// done. A failed extension is retried while the lock has not yet expired;
+// after that the sync is cancelled with errSyncLockLost.
+func (o *SyncOrchestrator) extendLock(ctx context.Context, sourceID, name string, cancel context.CancelCauseFunc) {
+	interval := o.lockTTL / 3
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	extendedAt := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		err := o.lock.Extend(ctx, name, o.lockTTL)
+		if err == nil {
+			extendedAt = time.Now()
+			continue
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		if time.Since(extendedAt)+interval < o.lockTTL {
+			o.logger.Warn("failed to extend sync lock, retrying", "source_id", sourceID, "error", err)
+			continue
+		}
+
+		o.logger.Error("sync lock lost", "source_id", sourceID, "error", err)
+		cancel(fmt.Errorf("%w: %v", errSyncLockLost, err))
+		return
+	}
+}
+
+// syncTracker carries the per-document bookkeeping of a sync in progress.
+// It is shared by the containers and documents processed in parallel.
+type syncTracker struct {
+	mu     sync.Mutex
+	run    *domain.SyncRun
+	seen   map[string]struct{} // External IDs enumerated, on syncs without a cursor
+	failed map[string]struct{} // External IDs with a recorded document error
+
+	// unsaved are the seen external IDs not yet saved with a checkpoint
+	unsaved []string
+
+	// checkpoint is the progress of the completed containers, and progress
+	// that of the containers in progress
+	checkpoint     *domain.SyncCheckpoint
+	progress       map[string]*containerProgress
+	checkpointedAt time.Time
+}
+
+// containerProgress is the progress of a container being synced.
+type containerProgress struct {
+	pageToken string // Next page to fetch
+	stats     domain.SyncStats
+}
+
+// markSeen records that a sync without a cursor enumerated externalID.
+func (t *syncTracker) markSeen(externalID string) {
+	if t.seen == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.seen[externalID]; !ok {
+		t.seen[externalID] = struct{}{}
+		t.unsaved = append(t.unsaved, externalID)
+	}
+}
+
+// addError adds an error to the sync run.
+func (t *syncTracker) addError(containerID, externalID string, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.run.AddError(containerID, externalID, err)
+}
+
+// setProgress records that containerID resumes at pageToken with stats so far.
+func (t *syncTracker) setProgress(containerID, pageToken string, stats domain.SyncStats) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.progress[containerID] = &containerProgress{pageToken: pageToken, stats: stats}
+}
+
+// completeContainer records a synced container, along with the cursor and
+// aggregated stats of the sync so far.
+func (t *syncTracker) completeContainer(containerID, cursor string, stats domain.SyncStats) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.progress, containerID)
+	t.checkpoint.CompletedContainers = append(t.checkpoint.CompletedContainers, containerID)
+	t.checkpoint.NextCursor = cursor
+	t.checkpoint.Stats = stats
+}
+
+// failContainer drops the progress of a failed container, which a resumed
+// sync then syncs from the start.
+func (t *syncTracker) failContainer(containerID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.progress, containerID)
+}
+
+// saveCheckpoint saves the progress of a sync: the completed containers and
+// the containers in progress. Unless forced, it saves at most once per
+// checkpoint interval.
+func (o *SyncOrchestrator) saveCheckpoint(ctx context.Context, sourceID string, tracker *syncTracker, force bool) {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	if !force && time.Since(tracker.checkpointedAt) < o.checkpointEvery {
+		return
+	}
+
+	checkpoint := *tracker.checkpoint
+	checkpoint.CompletedContainers = slices.Clone(tracker.checkpoint.CompletedContainers)
+	checkpoint.PageTokens = nil
+	for containerID, progress := range tracker.progress {
+		if progress.pageToken != "" {
+			if checkpoint.PageTokens == nil {
+				checkpoint.PageTokens = make(map[string]string)
+			}
+			checkpoint.PageTokens[containerID] = progress.pageToken
+		}
+		addSyncStats(&checkpoint.Stats, &progress.stats)
+	}
+	checkpoint.UpdatedAt = time.Now()
+	checkpoint.Seen = tracker.unsaved
+
+	if err := o.syncStore.SaveCheckpoint(ctx, sourceID, &checkpoint); err != nil {
+		o.logger.Warn("failed to save sync checkpoint", "source_id", sourceID, "error", err)
+		return
+	}
+	tracker.checkpointedAt = checkpoint.UpdatedAt
+	tracker.unsaved = nil
+}
+
+// fetchPage fetches a page of changes. Connectors that do not implement
+// driven.PagedConnector return every change in one page.
+func fetchPage(
+	ctx context.Context,
+	connector driven.Connector,
+	source *domain.Source,
+	cursor, pageToken string,
+) (*domain.ChangePage, error) {
+	if paged, ok := connector.(driven.PagedConnector); ok {
+		return paged.FetchChangesPage(ctx, source, cursor, pageToken)
+	}
+
+	changes, nextCursor, err := connector.FetchChanges(ctx, source, cursor)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.ChangePage{Changes: changes, Cursor: nextCursor}, nil
+}
+
+// stageError annotates a document processing error with the stage it
+// occurred in. Unannotated errors are attributed to indexing.
+type stageError struct {
+	stage domain.DocumentStage
+	err   error
+}
+
+func (e *stageError) Error() string { return e.err.Error() }
+func (e *stageError) Unwrap() error { return e.err }
+
+// documentStage returns the stage a document processing error occurred in.
+func documentStage(err error) domain.DocumentStage {
+	var se *stageError
+	if errors.As(err, &se) {
+		return se.stage
+	}
+	return domain.DocumentStageIndex
+}
+
+// failedDocuments returns the external IDs of a source's recorded document errors.
+func (o *SyncOrchestrator) failedDocuments(ctx context.Context, sourceID string) map[string]struct{} {
+	failed := make(map[string]struct{})
+	if o.errorStore == nil {
+		return failed
+	}
+
+	docErrs, err := o.errorStore.ListAll(ctx, sourceID)
+	if err != nil {
+		o.logger.Warn("failed to list document errors", "source_id", sourceID, "error", err)
+		return failed
+	}
+	for _, docErr := range docErrs {
+		failed[docErr.ExternalID] = struct{}{}
+	}
+	return failed
+}
+
+// trackDocument records the outcome of processing a document. A failure is
+// added to the run and stored as a document error for retry; a success
+// clears an earlier error.
+func (o *SyncOrchestrator) trackDocument(
+	ctx context.Context,
+	tracker *syncTracker,
+	sourceID, containerID, externalID string,
+	err error,
+) {
+	if err != nil {
+		tracker.addError(containerID, externalID, err)
+	}
+	if o.errorStore == nil {
+		return
+	}
+
+	if err != nil {
+		now := time.Now()
+		docErr := &domain.DocumentError{
+			SourceID:      sourceID,
+			ExternalID:    externalID,
+			ContainerID:   containerID,
+			Stage:         documentStage(err),
+			Error:         err.Error(),
+			Attempts:      1,
+			FirstFailedAt: now,
+			LastFailedAt:  now,
+		}
+		if saveErr := o.errorStore.Save(ctx, docErr); saveErr != nil {
+			o.logger.Warn("failed to save document error", "source_id", sourceID, "external_id", externalID, "error", saveErr)
+			return
+		}
+		tracker.mu.Lock()
+		tracker.failed[externalID] = struct{}{}
+		tracker.mu.Unlock()
+		return
+	}
+
+	tracker.mu.Lock()
+	_, failed := tracker.failed[externalID]
+	tracker.mu.Unlock()
+	if failed {
+		if delErr := o.errorStore.Delete(ctx, sourceID, externalID); delErr != nil {
+			o.logger.Warn("failed to clear document error", "source_id", sourceID, "external_id", externalID, "error", delErr)
+			return
+		}
+		tracker.mu.Lock()
+		delete(tracker.failed, externalID)
+		tracker.mu.Unlock()
+	}
+}
+
+// clearUnseenFailures clears the errors of failed documents a full sync no
+// longer sees, since they no longer exist in the source.
+func (o *SyncOrchestrator) clearUnseenFailures(ctx context.Context, sourceID string, tracker *syncTracker) {
+	for externalID := range tracker.failed {
+		if _, ok := tracker.seen[externalID]; ok {
+			continue
+		}
+		o.trackDocument(ctx, tracker, sourceID, "", externalID, nil)
+	}
+}
+
+// recordRun completes a sync run from the final sync state and saves it.
+func (o *SyncOrchestrator) recordRun(ctx context.Context, run *domain.SyncRun, syncState *domain.SyncState) {
+	run.Status = syncState.Status
+	run.Error = syncState.Error
+	run.Stats = syncState.Stats
+	o.saveRun(ctx, run)
+}
+
+// saveRun saves a finished run to the sync history and prunes runs beyond
+// the retention limit.
+func (o *SyncOrchestrator) saveRun(ctx context.Context, run *domain.SyncRun) {
+	if o.runStore == nil {
+		return
+	}
+
+	run.CompletedAt = time.Now()
+	run.Duration = run.CompletedAt.Sub(run.StartedAt).Seconds()
+
+	if err := o.runStore.Save(ctx, run); err != nil {
+		o.logger.Warn("failed to save sync run", "source_id", run.SourceID, "error", err)
+		return
+	}
+	if _, err := o.runStore.Prune(ctx, run.SourceID, o.runRetention); err != nil {
+		o.logger.Warn("failed to prune sync runs", "source_id", run.SourceID, "error", err)
+	}
+}
+
+// reconcileDeletes deletes the source's documents whose external IDs were
+// not seen by a full sync, for connectors that cannot report deletions.
+// It refuses to delete more than the configured fraction of the source.
+func (o *SyncOrchestrator) reconcileDeletes(
+	ctx context.Context,
+	sourceID string,
+	tracker *syncTracker,
+	stats *domain.SyncStats,
+) error {
+	existing, err := o.documentStore.ListExternalIDs(ctx, sourceID)
+	if err != nil {
+		return fmt.Errorf("failed to list documents: %w", err)
+	}
+
+	var orphans []string
+	for _, externalID := range existing {
+		if _, ok := tracker.seen[externalID]; !ok {
+			orphans = append(orphans, externalID)
+		}
+	}
+	if len(orphans) == 0 {
+		return nil
+	}
+
+	if o.maxDeleteRatio < 1 && float64(len(orphans)) > o.maxDeleteRatio*float64(len(existing)) {
+		return fmt.Errorf("deletion reconciliation skipped: %d of %d documents no longer found, above the %.0f%% safety threshold",
+			len(orphans), len(existing), o.maxDeleteRatio*100)
+	}
+
+	for _, externalID := range orphans {
+		change := &domain.Change{ExternalID: externalID, Type: domain.ChangeTypeDeleted}
+		err := o.processDelete(ctx, sourceID, change, stats)
+		o.trackDocument(ctx, tracker, sourceID, "", externalID, err)
+		if err != nil {
+			o.logger.Warn("failed to delete orphaned document",
+				"source_id", sourceID,
+				"external_id", externalID,
+				"error", err,
+			)
+			stats.Errors++
+		}
+	}
+
+	o.logger.Info("deleted orphaned documents", "source_id", sourceID, "count", len(orphans))
+	return nil
+}
+
+// mergeCursors combines the cursor aggregated so far with the cursor a
+// container returned. Providers whose builder is a driven.CursorMerger merge
+// them; for others the last non-empty cursor wins.
+func (o *SyncOrchestrator) mergeCursors(source *domain.Source, cursor, next string) string {
+	if next == "" {
+		return cursor
+	}
+	if builder, err := o.connectorFactory.GetBuilder(source.ProviderType); err == nil {
+		if merger, ok := builder.(driven.CursorMerger); ok {
+			return merger.MergeCursors(cursor, next)
+		}
+	}
+	return next
+}
+
+// syncContainer syncs a single container within a source, starting at
+// pageToken (the first page if empty). Pages are fetched within
+// fetchLimiter. Progress is checkpointed between pages and when interrupted.
 // Returns stats for this container, the cursor, and any error.
 func (o *SyncOrchestrator) syncContainer(
 	ctx context.Context,
 	source *domain.Source,
-	syncState *domain.SyncState,
+	cursor string,
+	pageToken string,
 	containerID string,
+	tracker *syncTracker,
+	fetchLimiter *adaptiveLimiter,
 ) (*domain.SyncStats, string, error) {
 	logFields := []any{"source_id", source.ID}
 	if containerID != "" {
@@ -229,47 +1074,46 @@ func (o *SyncOrchestrator) syncContainer(
 		return nil, "", fmt.Errorf("connection test failed: %w", err)
 	}
 
-	// Use container-specific cursor if available
-	cursor := syncState.Cursor
 	stats := &domain.SyncStats{}
 	var lastCursor string
+	documentLimiter := newAdaptiveLimiter(o.documentConcurrency)
+
+	// interrupted checkpoints the page in progress, which a resumed sync
+	// fetches again
+	interrupted := func(err error) (*domain.SyncStats, string, error) {
+		tracker.setProgress(containerID, pageToken, *stats)
+		o.saveCheckpoint(context.WithoutCancel(ctx), source.ID, tracker, true)
+		return stats, lastCursor, err
+	}
 
 	for {
-		select {
-		case <-ctx.Done():
-			return stats, lastCursor, ctx.Err()
-		default:
+		if err := ctx.Err(); err != nil {
+			return interrupted(err)
 		}
 
-		changes, nextCursor, err := connector.FetchChanges(ctx, source, cursor)
+		page, err := o.fetchPageLimited(ctx, fetchLimiter, connector, source, cursor, pageToken)
 		if err != nil {
+			if ctx.Err() != nil {
+				return interrupted(ctx.Err())
+			}
 			return stats, lastCursor, fmt.Errorf("failed to fetch changes: %w", err)
 		}
 
-		if len(changes) == 0 {
-			break
+		if page.Cursor != "" {
+			lastCursor = page.Cursor
 		}
 
-		// Process each document
-		for _, change := range changes {
-			if err := o.processChange(ctx, source, change, stats); err != nil {
-				o.logger.Warn("failed to process change",
-					"source_id", source.ID,
-					"container_id", containerID,
-					"external_id", change.ExternalID,
-					"error", err,
-				)
-				stats.Errors++
-			}
+		if err := o.processPage(ctx, source, containerID, page.Changes, tracker, documentLimiter, stats); err != nil {
+			return interrupted(err)
 		}
 
-		lastCursor = nextCursor
-
 		// No more pages
-		if nextCursor == "" || nextCursor == cursor {
+		if page.NextPageToken == "" {
 			break
 		}
-		cursor = nextCursor
+		pageToken = page.NextPageToken
+		tracker.setProgress(containerID, pageToken, *stats)
+		o.saveCheckpoint(ctx, source.ID, tracker, false)
 	}
 
 	o.logger.Info("container sync completed",
@@ -278,11 +1122,136 @@ func (o *SyncOrchestrator) syncContainer(
 		"documents_added", stats.DocumentsAdded,
 		"documents_updated", stats.DocumentsUpdated,
 		"documents_deleted", stats.DocumentsDeleted,
+		"documents_skipped", stats.DocumentsSkipped,
+		"documents_excluded", stats.DocumentsExcluded,
 	)
 
 	return stats, lastCursor, nil
 }
 
+// processPage processes a page of changes, up to limiter's limit in
+// parallel. Changes to the same document are processed in page order.
+// Cancellation is checked between documents: once ctx is done no further
+// documents are started, but documents in flight are finished so they are
+// never left half indexed, and the context error is returned.
+func (o *SyncOrchestrator) processPage(
+	ctx context.Context,
+	source *domain.Source,
+	containerID string,
+	changes []*domain.Change,
+	tracker *syncTracker,
+	limiter *adaptiveLimiter,
+	stats *domain.SyncStats,
+) error {
+	var mu sync.Mutex // Guards stats
+	var wg sync.WaitGroup
+	inFlight := make(map[string]chan struct{})
+
+	for _, change := range changes {
+		if done, ok := inFlight[change.ExternalID]; ok {
+			<-done
+		}
+		err := ctx.Err()
+		if err == nil {
+			err = limiter.acquire(ctx)
+		}
+		if err != nil {
+			wg.Wait()
+			return err
+		}
+
+		// Recorded before processing: a document that fails to process
+		// still exists upstream and must not be reconciled away
+		if change.Type != domain.ChangeTypeDeleted {
+			tracker.markSeen(change.ExternalID)
+		}
+
+		done := make(chan struct{})
+		inFlight[change.ExternalID] = done
+		wg.Add(1)
+		go func(change *domain.Change) {
+			defer wg.Done()
+			defer close(done)
+
+			changeStats := &domain.SyncStats{}
+			err := o.processLimited(ctx, limiter, source, change, changeStats)
+			o.trackDocument(context.WithoutCancel(ctx), tracker, source.ID, containerID, change.ExternalID, err)
+			if err != nil {
+				o.logger.Warn("failed to process change",
+					"source_id", source.ID,
+					"container_id", containerID,
+					"external_id", change.ExternalID,
+					"error", err,
+				)
+				changeStats.Errors++
+			}
+
+			mu.Lock()
+			addSyncStats(stats, changeStats)
+			mu.Unlock()
+		}(change)
+	}
+
+	wg.Wait()
+	return nil
+}
+
+// processLimited processes a change in a slot of limiter, which the caller
+// acquired. A rate-limited change lowers the limit and is retried once the
+// limiter allows.
+func (o *SyncOrchestrator) processLimited(
+	ctx context.Context,
+	limiter *adaptiveLimiter,
+	source *domain.Source,
+	change *domain.Change,
+	stats *domain.SyncStats,
+) error {
+	for attempt := 0; ; attempt++ {
+		err := o.processChange(context.WithoutCancel(ctx), source, change, stats)
+		limiter.release(err)
+		if !retryRateLimited(err, attempt) {
+			return err
+		}
+
+		o.logger.Warn("document processing rate limited, retrying",
+			"source_id", source.ID,
+			"external_id", change.ExternalID,
+			"concurrency", limiter.currentLimit(),
+			"error", err,
+		)
+		if acquireErr := limiter.acquire(ctx); acquireErr != nil {
+			return err
+		}
+	}
+}
+
+// fetchPageLimited fetches a page of changes within limiter. A rate-limited
+// fetch lowers the limit and is retried once the limiter allows.
+func (o *SyncOrchestrator) fetchPageLimited(
+	ctx context.Context,
+	limiter *adaptiveLimiter,
+	connector driven.Connector,
+	source *domain.Source,
+	cursor, pageToken string,
+) (*domain.ChangePage, error) {
+	for attempt := 0; ; attempt++ {
+		if err := limiter.acquire(ctx); err != nil {
+			return nil, err
+		}
+		page, err := fetchPage(ctx, connector, source, cursor, pageToken)
+		limiter.release(err)
+		if !retryRateLimited(err, attempt) {
+			return page, err
+		}
+
+		o.logger.Warn("connector rate limited, retrying",
+			"source_id", source.ID,
+			"concurrency", limiter.currentLimit(),
+			"error", err,
+		)
+	}
+}
+
 // SyncAll synchronizes all enabled sources for a team.
 func (o *SyncOrchestrator) SyncAll(ctx context.Context) ([]*domain.SyncResult, error) {
 	sources, err := o.sourceStore.List(ctx)
@@ -296,7 +1265,11 @@ func (o *SyncOrchestrator) SyncAll(ctx context.Context) ([]*domain.SyncResult, e
 			continue
 		}
 
-		result, err := o.SyncSource(ctx, source.ID)
+		result, err := o.SyncWithOptions(ctx, source.ID, domain.SyncOptions{Trigger: domain.SyncTriggerScheduled})
+		if errors.Is(err, domain.ErrSyncInProgress) {
+			results = append(results, result)
+			continue
+		}
 		if err != nil {
 			o.logger.Error("sync failed", "source_id", source.ID, "error", err)
 			results = append(results, &domain.SyncResult{
@@ -312,6 +1285,128 @@ func (o *SyncOrchestrator) SyncAll(ctx context.Context) ([]*domain.SyncResult, e
 	return results, nil
 }
 
+// RetryFailedDocuments refetches the documents of a source that failed to
+// sync and processes them again. Documents that fail again keep their error
+// with an incremented attempt count; documents no longer in the source are
+// deleted. The retry is recorded in the sync history but leaves the sync
+// state and cursor untouched. Like a sync, it holds the source's lock.
+func (o *SyncOrchestrator) RetryFailedDocuments(ctx context.Context, sourceID string) (*domain.SyncResult, error) {
+	startTime := time.Now()
+
+	ctx, unlock, err := o.lockSource(ctx, sourceID)
+	if err != nil {
+		return &domain.SyncResult{SourceID: sourceID, Success: false, Error: err.Error()}, err
+	}
+	defer unlock()
+
+	source, err := o.sourceStore.Get(ctx, sourceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source: %w", err)
+	}
+	if !source.Enabled {
+		return nil, fmt.Errorf("source is disabled")
+	}
+
+	var docErrs []*domain.DocumentError
+	if o.errorStore != nil {
+		if docErrs, err = o.errorStore.ListAll(ctx, sourceID); err != nil {
+			return nil, fmt.Errorf("failed to list document errors: %w", err)
+		}
+	}
+	if len(docErrs) == 0 {
+		return &domain.SyncResult{SourceID: sourceID, Success: true}, nil
+	}
+
+	o.logger.Info("retrying failed documents", "source_id", sourceID, "count", len(docErrs))
+
+	// Connectors are scoped to a container, so retry per container
+	var containers []string
+	byContainer := make(map[string][]string)
+	tracker := &syncTracker{
+		run: &domain.SyncRun{
+			ID:        domain.GenerateID(),
+			SourceID:  sourceID,
+			Trigger:   domain.SyncTriggerRetry,
+			StartedAt: startTime,
+		},
+		failed: make(map[string]struct{}),
+	}
+	for _, docErr := range docErrs {
+		if _, ok := byContainer[docErr.ContainerID]; !ok {
+			containers = append(containers, docErr.ContainerID)
+		}
+		byContainer[docErr.ContainerID] = append(byContainer[docErr.ContainerID], docErr.ExternalID)
+		tracker.failed[docErr.ExternalID] = struct{}{}
+	}
+
+	stats := domain.SyncStats{}
+	var containerErrors []string
+	for _, containerID := range containers {
+		connector, err := o.connectorFactory.Create(ctx, source, containerID)
+		if err != nil {
+			err = fmt.Errorf("failed to create connector: %w", err)
+			containerErrors = append(containerErrors, fmt.Sprintf("%s: %s", containerID, err.Error()))
+			tracker.run.AddError(containerID, "", err)
+			continue
+		}
+
+		for _, externalID := range byContainer[containerID] {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			change, err := connector.FetchDocument(ctx, source, externalID)
+			switch {
+			case errors.Is(err, domain.ErrNotFound):
+				change = &domain.Change{ExternalID: externalID, Type: domain.ChangeTypeDeleted}
+				err = o.processChange(ctx, source, change, &stats)
+			case err != nil:
+				err = &stageError{domain.DocumentStageFetch, fmt.Errorf("failed to fetch document: %w", err)}
+			default:
+				change.ExternalID = externalID
+				err = o.processChange(ctx, source, change, &stats)
+			}
+
+			o.trackDocument(ctx, tracker, sourceID, containerID, externalID, err)
+			if err != nil {
+				o.logger.Warn("failed to retry document",
+					"source_id", sourceID,
+					"container_id", containerID,
+					"external_id", externalID,
+					"error", err,
+				)
+				stats.Errors++
+			}
+		}
+	}
+
+	run := tracker.run
+	run.Status = domain.SyncStatusCompleted
+	run.Stats = stats
+	if len(containerErrors) > 0 {
+		run.Status = domain.SyncStatusFailed
+		run.Error = fmt.Sprintf("failed containers: %v", containerErrors)
+	}
+	o.saveRun(ctx, run)
+
+	duration := time.Since(startTime).Seconds()
+
+	o.logger.Info("retry of failed documents completed",
+		"source_id", sourceID,
+		"duration_seconds", duration,
+		"documents_retried", len(docErrs),
+		"still_failing", len(tracker.failed),
+	)
+
+	return &domain.SyncResult{
+		SourceID: sourceID,
+		Success:  len(containerErrors) == 0,
+		Stats:    stats,
+		Duration: duration,
+		Error:    run.Error,
+	}, nil
+}
+
 // processChange processes a single document change.
 func (o *SyncOrchestrator) processChange(
 	ctx context.Context,
@@ -325,7 +1420,7 @@ func (o *SyncOrchestrator) processChange(
 	case domain.ChangeTypeAdded, domain.ChangeTypeModified:
 		return o.processAddOrUpdate(ctx, source, change, stats)
 	default:
-		return fmt.Errorf("unknown change type: %s", change.Type)
+		return &stageError{domain.DocumentStageFetch, fmt.Errorf("unknown change type: %s", change.Type)}
 	}
 }
 
@@ -352,7 +1447,7 @@ func (o *SyncOrchestrator) processDelete(
 
 	// Delete from document store
 	if err := o.documentStore.Delete(ctx, doc.ID); err != nil {
-		return fmt.Errorf("failed to delete document: %w", err)
+		return &stageError{domain.DocumentStageDelete, fmt.Errorf("failed to delete document: %w", err)}
 	}
 
 	stats.DocumentsDeleted++
@@ -370,13 +1465,28 @@ func (o *SyncOrchestrator) processAddOrUpdate(
 	content := change.Content
 
 	if doc == nil {
-		return fmt.Errorf("document is nil for change type %s", change.Type)
+		return &stageError{domain.DocumentStageFetch, fmt.Errorf("document is nil for change type %s", change.Type)}
 	}
 
 	// Check if document exists (for update tracking)
 	existingDoc, _ := o.documentStore.GetByExternalID(ctx, source.ID, change.ExternalID)
 	isUpdate := existingDoc != nil
 
+	// Step 6a: Check exclusion rules, before the document's times are
+	// replaced. A document the rules exclude is removed if it was indexed.
+	rules, err := domain.CompileRules(source.Config.Rules)
+	if err != nil {
+		return &stageError{domain.DocumentStageIndex, fmt.Errorf("invalid source rules: %w", err)}
+	}
+	if reason := rules.Exclusion(doc, int64(len(content)), time.Now()); reason != "" {
+		o.logger.Debug("document excluded by rules", "source_id", source.ID, "external_id", change.ExternalID, "reason", reason)
+		stats.DocumentsExcluded++
+		if isUpdate {
+			return o.processDelete(ctx, source.ID, change, stats)
+		}
+		return nil
+	}
+
 	// Ensure document has required fields
 	if doc.ID == "" {
 		doc.ID = generateID()
@@ -393,9 +1503,28 @@ func (o *SyncOrchestrator) processAddOrUpdate(
 		doc.CreatedAt = existingDoc.CreatedAt
 	}
 
-	// Step 6a: Check exclusion rules (TODO: implement exclusion rules)
+	// Skip documents whose content is unchanged since they were last indexed.
+	// The document is still saved so metadata changes (e.g. PR state) apply.
+	doc.ContentHash = contentHash(doc, change)
+	if isUpdate && existingDoc.ContentHash != "" && existingDoc.ContentHash == doc.ContentHash {
+		doc.IndexedAt = existingDoc.IndexedAt
+		if err := o.documentStore.Save(ctx, doc); err != nil {
+			return fmt.Errorf("failed to save document: %w", err)
+		}
+		stats.DocumentsSkipped++
+		return nil
+	}
 
 	// Step 6b: Normalise content
+	// Binary formats (PDF, office documents) are first converted to text
+	if o.canExtract(doc.MimeType) {
+		text, err := o.contentExtractor.Extract(ctx, []byte(content), doc.MimeType)
+		if err != nil {
+			return &stageError{domain.DocumentStageExtract, fmt.Errorf("failed to extract content (%s): %w", doc.MimeType, err)}
+		}
+		content = text
+	}
+
 	normalizedContent := content
 	normaliser := o.normaliserReg.Get(doc.MimeType)
 	if normaliser != nil {
@@ -416,6 +1545,38 @@ func (o *SyncOrchestrator) processAddOrUpdate(
 	return o.processWithLegacy(ctx, doc, normalizedContent, isUpdate, stats, now)
 }
 
+// contentHash returns the hash identifying the indexed form of a document:
+// its content (or the connector's content version) plus the fields that are
+// indexed alongside it.
+func contentHash(doc *domain.Document, change *domain.Change) string {
+	h := sha256.New()
+	for _, part := range []string{doc.MimeType, doc.Title, doc.Path, change.ContentHash} {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	if change.ContentHash == "" {
+		h.Write([]byte(change.Content))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// canExtract reports whether the content extractor handles mimeType.
+func (o *SyncOrchestrator) canExtract(mimeType string) bool {
+	if o.contentExtractor == nil || mimeType == "" {
+		return false
+	}
+	mimeType = strings.ToLower(strings.TrimSpace(mimeType))
+	if idx := strings.Index(mimeType, ";"); idx != -1 {
+		mimeType = strings.TrimSpace(mimeType[:idx])
+	}
+	for _, t := range o.contentExtractor.SupportedTypes() {
+		if strings.EqualFold(t, mimeType) {
+			return true
+		}
+	}
+	return false
+}
+
 // processWithPipeline processes a document using the pipeline executor.
 func (o *SyncOrchestrator) processWithPipeline(
 	ctx context.Context,
@@ -506,6 +1667,7 @@ func (o *SyncOrchestrator) processWithLegacy(
 				embeddings, err := embeddingService.Embed(ctx, []string{chunk.Content})
 				if err != nil {
 					o.logger.Warn("failed to generate embedding", "chunk_id", domainChunk.ID, "error", err)
+					doc.ContentHash = "" // Reprocess on next sync
 				} else if len(embeddings) > 0 {
 					domainChunk.Embedding = embeddings[0]
 				}
@@ -515,16 +1677,23 @@ func (o *SyncOrchestrator) processWithLegacy(
 		domainChunks = append(domainChunks, domainChunk)
 	}
 
-	// Step 6e: Save to DocumentStore
+	// Step 6e: Save to DocumentStore. The chunks reference the document, so
+	// it is saved first, but without its content hash: the hash is saved
+	// only once the chunks are stored and indexed, so a document whose
+	// indexing fails or is interrupted is reprocessed on the next sync.
+	contentHash := doc.ContentHash
+	doc.ContentHash = ""
 	if err := o.documentStore.Save(ctx, doc); err != nil {
 		return fmt.Errorf("failed to save document: %w", err)
 	}
+	indexed := true
 
 	// Save chunks to ChunkStore
 	if o.chunkStore != nil {
 		for _, chunk := range domainChunks {
 			if err := o.chunkStore.Save(ctx, chunk); err != nil {
 				o.logger.Warn("failed to save chunk", "chunk_id", chunk.ID, "error", err)
+				indexed = false
 			}
 		}
 	}
@@ -533,6 +1702,14 @@ func (o *SyncOrchestrator) processWithLegacy(
 	if o.searchEngine != nil {
 		if err := o.searchEngine.Index(ctx, domainChunks); err != nil {
 			o.logger.Warn("failed to index chunks", "doc_id", doc.ID, "error", err)
+			indexed = false
+		}
+	}
+
+	if indexed && contentHash != "" {
+		doc.ContentHash = contentHash
+		if err := o.documentStore.Save(ctx, doc); err != nil {
+			o.logger.Warn("failed to save content hash", "doc_id", doc.ID, "error", err)
 		}
 	}
 
@@ -628,25 +1805,308 @@ func (o *SyncOrchestrator) ListSyncStates(ctx context.Context) ([]*domain.SyncSt
 	return states, nil
 }
 
-// CancelSync cancels an ongoing sync for a source.
-// Note: This is a placeholder - actual cancellation requires context propagation.
-func (o *SyncOrchestrator) CancelSync(ctx context.Context, sourceID string) error {
-	// Get current sync state
-	state, err := o.syncStore.Get(ctx, sourceID)
+// ListSyncRuns retrieves a page of a source's sync history, newest first,
+// and the total number of recorded runs.
+func (o *SyncOrchestrator) ListSyncRuns(ctx context.Context, sourceID string, limit, offset int) ([]*domain.SyncRun, int, error) {
+	if _, err := o.sourceStore.Get(ctx, sourceID); err != nil {
+		return nil, 0, err
+	}
+
+	if o.runStore == nil {
+		return []*domain.SyncRun{}, 0, nil
+	}
+	return o.runStore.List(ctx, sourceID, limit, offset)
+}
+
+// ListDocumentErrors retrieves a page of a source's failed documents, most
+// recently failed first, and the total number of failed documents.
+func (o *SyncOrchestrator) ListDocumentErrors(ctx context.Context, sourceID string, limit, offset int) ([]*domain.DocumentError, int, error) {
+	if _, err := o.sourceStore.Get(ctx, sourceID); err != nil {
+		return nil, 0, err
+	}
+
+	if o.errorStore == nil {
+		return []*domain.DocumentError{}, 0, nil
+	}
+	return o.errorStore.List(ctx, sourceID, limit, offset)
+}
+
+// PreviewRules reports which documents of a source would be added or
+// removed if its rules were replaced by rules. The source is enumerated as
+// in a full sync, but no document is processed, so the preview takes about
+// as long as fetching the source. Rule changes apply to a document when it
+// is next synced; a full sync applies them to every document.
+func (o *SyncOrchestrator) PreviewRules(ctx context.Context, sourceID string, rules *domain.SourceRules) (*domain.RulesPreview, error) {
+	source, err := o.sourceStore.Get(ctx, sourceID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	current, err := domain.CompileRules(source.Config.Rules)
+	if err != nil {
+		return nil, fmt.Errorf("invalid source rules: %w", err)
+	}
+	proposed, err := domain.CompileRules(rules)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &domain.RulesPreview{
+		SourceID: sourceID,
+		Added:    []domain.RulesPreviewDocument{},
+		Removed:  []domain.RulesPreviewDocument{},
 	}
+	now := time.Now()
+	err = o.enumerate(ctx, source, func(containerID string, change *domain.Change) error {
+		preview.Scanned++
+
+		size := int64(len(change.Content))
+		before := current.Exclusion(change.Document, size, now)
+		after := proposed.Exclusion(change.Document, size, now)
+		if after == "" {
+			preview.Included++
+		}
 
-	// Only running syncs can be cancelled
-	if state.Status != domain.SyncStatusRunning {
+		previewDoc := domain.RulesPreviewDocument{
+			ExternalID:  change.ExternalID,
+			ContainerID: containerID,
+			Path:        change.Document.Path,
+			Title:       change.Document.Title,
+		}
+		switch {
+		case before != "" && after == "":
+			preview.AddedCount++
+			if len(preview.Added) < maxRulesPreviewDocuments {
+				previewDoc.Reason = before
+				preview.Added = append(preview.Added, previewDoc)
+			}
+		case before == "" && after != "":
+			preview.RemovedCount++
+			if len(preview.Removed) < maxRulesPreviewDocuments {
+				previewDoc.Reason = after
+				preview.Removed = append(preview.Removed, previewDoc)
+			}
+		}
 		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return preview, nil
+}
+
+// CoveredByRunningSync reports whether the sync running on a source covers
+// a sync with opts requested at requestedAt, so the requested sync need not
+// run: the running sync started after the request and is at least as
+// thorough (only a full sync covers a full sync). A sync being cancelled
+// covers nothing.
+func (o *SyncOrchestrator) CoveredByRunningSync(ctx context.Context, sourceID string, opts domain.SyncOptions, requestedAt time.Time) (bool, error) {
+	state, err := o.syncStore.Get(ctx, sourceID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	if state.Status != domain.SyncStatusRunning || state.StartedAt == nil || !state.StartedAt.After(requestedAt) {
+		return false, nil
+	}
+
+	// Whether the running sync is full is known once it checkpoints
+	if opts.Full && (state.Checkpoint == nil || !state.Checkpoint.Full) {
+		return false, nil
+	}
+	return true, nil
+}
+
+// CancelSync requests cancellation of an ongoing sync for a source.
+// The request is recorded as SyncStatusCancelling in the shared sync state;
+// the node running the sync picks it up within the poll interval, finishes
+// the document in flight and ends the sync as SyncStatusCancelled.
+// It is a no-op if no sync is running.
+func (o *SyncOrchestrator) CancelSync(ctx context.Context, sourceID string) error {
+	// Only running syncs can be cancelled. The status is compared and set in
+	// one update, so a sync finishing meanwhile is not marked cancelling and
+	// concurrent progress is not overwritten.
+	_, err := o.syncStore.CompareAndSetStatus(ctx, sourceID, domain.SyncStatusRunning, domain.SyncStatusCancelling)
+	return err
+}
+
+// enumerate calls fn with every document of a source, fetched from its
+// selected containers as in a full sync. Deletions are skipped.
+func (o *SyncOrchestrator) enumerate(ctx context.Context, source *domain.Source, fn func(containerID string, change *domain.Change) error) error {
+	containers := source.SelectedContainers
+	if len(containers) == 0 {
+		containers = []string{""}
+	}
+
+	limiter := newAdaptiveLimiter(1)
+	for _, containerID := range containers {
+		connector, err := o.connectorFactory.Create(ctx, source, containerID)
+		if err != nil {
+			return fmt.Errorf("failed to create connector: %w", err)
+		}
+
+		var pageToken string
+		for {
+			page, err := o.fetchPageLimited(ctx, limiter, connector, source, "", pageToken)
+			if err != nil {
+				return fmt.Errorf("failed to fetch changes: %w", err)
+			}
+
+			for _, change := range page.Changes {
+				if change.Type == domain.ChangeTypeDeleted || change.Document == nil {
+					continue
+				}
+				if err := fn(containerID, change); err != nil {
+					return err
+				}
+			}
+
+			if page.NextPageToken == "" {
+				break
+			}
+			pageToken = page.NextPageToken
+		}
+	}
+	return nil
+}
+
+// DryRunSync reports what a full sync of a source would index. The source
+// is fetched, and its documents filtered by its rules, extracted,
+// normalised and chunked as in a sync, but nothing is written to the
+// document store, chunk store or search engine, and the sync state is left
+// untouched. Disabled sources can be dry-run. The run is saved under runID
+// as it starts and when it finishes, if a dry-run store is configured.
+func (o *SyncOrchestrator) DryRunSync(ctx context.Context, sourceID, runID string) (*domain.DryRun, error) {
+	source, err := o.sourceStore.Get(ctx, sourceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source: %w", err)
+	}
+
+	run := &domain.DryRun{
+		ID:        runID,
+		SourceID:  sourceID,
+		Status:    domain.SyncStatusRunning,
+		MimeTypes: map[string]*domain.DryRunMimeTypeStats{},
+		Samples:   []domain.DryRunSample{},
+		StartedAt: time.Now(),
+	}
+	o.saveDryRun(ctx, run)
+
+	err = o.dryRun(ctx, source, run)
+
+	completedAt := time.Now()
+	run.CompletedAt = &completedAt
+	run.Duration = completedAt.Sub(run.StartedAt).Seconds()
+	run.Status = domain.SyncStatusCompleted
+	if err != nil {
+		run.Status = domain.SyncStatusFailed
+		run.Error = err.Error()
+	}
+	// Saved even if ctx was cancelled, so the run does not stay running
+	o.saveDryRun(context.WithoutCancel(ctx), run)
+
+	o.logger.Info("dry run completed",
+		"source_id", sourceID,
+		"status", run.Status,
+		"documents", run.Documents,
+		"excluded", run.Excluded,
+		"failed", run.Failed,
+		"chunks", run.Chunks,
+		"embedding_tokens", run.EmbeddingTokens,
+	)
+
+	return run, err
+}
+
+// dryRun fills run with the documents of source.
+func (o *SyncOrchestrator) dryRun(ctx context.Context, source *domain.Source, run *domain.DryRun) error {
+	rules, err := domain.CompileRules(source.Config.Rules)
+	if err != nil {
+		return fmt.Errorf("invalid source rules: %w", err)
 	}
 
-	// Mark as failed/cancelled
 	now := time.Now()
-	state.Status = domain.SyncStatusFailed
-	state.CompletedAt = &now
-	state.Error = "cancelled by user"
+	return o.enumerate(ctx, source, func(containerID string, change *domain.Change) error {
+		doc := change.Document
+		content := change.Content
+
+		if reason := rules.Exclusion(doc, int64(len(content)), now); reason != "" {
+			run.Excluded++
+			return nil
+		}
+
+		if o.canExtract(doc.MimeType) {
+			text, err := o.contentExtractor.Extract(ctx, []byte(content), doc.MimeType)
+			if err != nil {
+				run.AddError(containerID, change.ExternalID, fmt.Errorf("failed to extract content (%s): %w", doc.MimeType, err))
+				return nil
+			}
+			content = text
+		}
+		if normaliser := o.normaliserReg.Get(doc.MimeType); normaliser != nil {
+			content = normaliser.Normalise(content, doc.MimeType)
+		}
+
+		sample := domain.DryRunSample{
+			ExternalID:  change.ExternalID,
+			ContainerID: containerID,
+			Path:        doc.Path,
+			Title:       doc.Title,
+			MimeType:    doc.MimeType,
+			Excerpt:     excerpt(content, dryRunExcerptLength),
+		}
+		if o.legacyPipeline != nil {
+			for _, chunk := range o.legacyPipeline.Process(content) {
+				sample.Chunks++
+				sample.EmbeddingTokens += estimateTokens(chunk.Content)
+			}
+		}
+		run.AddDocument(sample)
+		return nil
+	})
+}
+
+// saveDryRun saves a dry run if a dry-run store is configured. Failures are
+// logged, as the run itself is unaffected.
+func (o *SyncOrchestrator) saveDryRun(ctx context.Context, run *domain.DryRun) {
+	if o.dryRunStore == nil {
+		return
+	}
+	if err := o.dryRunStore.Save(ctx, run); err != nil {
+		o.logger.Warn("failed to save dry run", "source_id", run.SourceID, "run_id", run.ID, "error", err)
+	}
+}
+
+// GetDryRun retrieves a dry run of a source. Returns domain.ErrNotFound if
+// the source has no dry run with that ID, or no dry-run store is configured.
+func (o *SyncOrchestrator) GetDryRun(ctx context.Context, sourceID, runID string) (*domain.DryRun, error) {
+	if o.dryRunStore == nil {
+		return nil, domain.ErrNotFound
+	}
+	run, err := o.dryRunStore.Get(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+	if run.SourceID != sourceID {
+		return nil, domain.ErrNotFound
+	}
+	return run, nil
+}
+
+// estimateTokens approximates the embedding tokens of text, at
+// approxCharsPerToken characters per token.
+func estimateTokens(text string) int {
+	n := utf8.RuneCountInString(text)
+	return (n + approxCharsPerToken - 1) / approxCharsPerToken
+}
 
-	return o.syncStore.Save(ctx, state)
+// excerpt returns the first n characters of text, cut at a character boundary.
+func excerpt(text string, n int) string {
+	text = strings.TrimSpace(text)
+	if utf8.RuneCountInString(text) <= n {
+		return text
+	}
+	return string([]rune(text)[:n]) + "…"
 }
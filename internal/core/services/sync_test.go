@@ -3,6 +3,11 @@ package services
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -55,6 +60,8 @@ func createTestSyncOrchestrator(t *testing.T) (
 type mockConnectorFactory struct {
 	connector *mocks.MockConnector
 	createErr error
+	createFn  func(containerID string) driven.Connector // Overrides connector when set
+	builder   driven.ConnectorBuilder                   // Returned by GetBuilder when set
 }
 
 func newMockConnectorFactory() *mockConnectorFactory {
@@ -69,6 +76,9 @@ func (m *mockConnectorFactory) Create(ctx context.Context, source *domain.Source
 	if m.createErr != nil {
 		return nil, m.createErr
 	}
+	if m.createFn != nil {
+		return m.createFn(containerID), nil
+	}
 	return m.connector, nil
 }
 
@@ -77,7 +87,49 @@ func (m *mockConnectorFactory) SupportedTypes() []domain.ProviderType {
 }
 
 func (m *mockConnectorFactory) GetBuilder(providerType domain.ProviderType) (driven.ConnectorBuilder, error) {
-	return nil, nil
+	return m.builder, nil
+}
+
+// cursorPagedConnector pages a connector's changes by the cursors its
+// FetchChanges returns, until a page is empty or the cursor stops moving
+type cursorPagedConnector struct {
+	*mocks.MockConnector
+}
+
+// pageByCursor makes the factory's connector a paged one
+func (m *mockConnectorFactory) pageByCursor() {
+	m.createFn = func(string) driven.Connector { return &cursorPagedConnector{m.connector} }
+}
+
+func (c *cursorPagedConnector) FetchChangesPage(ctx context.Context, source *domain.Source, cursor, pageToken string) (*domain.ChangePage, error) {
+	from := cursor
+	if pageToken != "" {
+		from = pageToken
+	}
+	changes, nextCursor, err := c.FetchChanges(ctx, source, from)
+	if err != nil {
+		return nil, err
+	}
+	page := &domain.ChangePage{Changes: changes, Cursor: nextCursor}
+	if len(changes) > 0 && nextCursor != "" && nextCursor != from {
+		page.NextPageToken = nextCursor
+	}
+	return page, nil
+}
+
+// mergingBuilder is a builder whose connectors keep a comma-separated
+// cursor entry per container
+type mergingBuilder struct {
+	driven.ConnectorBuilder
+}
+
+func (mergingBuilder) MergeCursors(cursor, other string) string {
+	if cursor == "" {
+		return other
+	}
+	entries := strings.Split(cursor+","+other, ",")
+	sort.Strings(entries)
+	return strings.Join(entries, ",")
 }
 
 func (m *mockConnectorFactory) SupportsOAuth(providerType domain.ProviderType) bool {
@@ -568,6 +620,7 @@ func TestSyncSource_DeleteNonExistentDocument(t *testing.T) {
 // TestSyncSource_Pagination tests handling of paginated results
 func TestSyncSource_Pagination(t *testing.T) {
 	orchestrator, sourceStore, documentStore, _, _, _, connectorFactory := createTestSyncOrchestrator(t)
+	connectorFactory.pageByCursor()
 	ctx := context.Background()
 
 	// Create enabled source
@@ -615,9 +668,10 @@ func TestSyncSource_Pagination(t *testing.T) {
 	}
 }
 
-// TestSyncSource_PaginationStopsOnEmptyResults tests that pagination stops on empty results
-func TestSyncSource_PaginationStopsOnEmptyResults(t *testing.T) {
-	orchestrator, sourceStore, documentStore, _, _, _, connectorFactory := createTestSyncOrchestrator(t)
+// TestSyncSource_UnpagedConnectorFetchedOnce tests that a connector without
+// paging is fetched once per container, whatever cursor it returns
+func TestSyncSource_UnpagedConnectorFetchedOnce(t *testing.T) {
+	orchestrator, sourceStore, documentStore, _, syncStore, _, connectorFactory := createTestSyncOrchestrator(t)
 	ctx := context.Background()
 
 	source := &domain.Source{
@@ -629,13 +683,9 @@ func TestSyncSource_PaginationStopsOnEmptyResults(t *testing.T) {
 	callCount := 0
 	connectorFactory.connector.FetchChangesFn = func(ctx context.Context, source *domain.Source, cursor string) ([]*domain.Change, string, error) {
 		callCount++
-		if callCount == 1 {
-			return []*domain.Change{
-				{ExternalID: "ext-1", Type: domain.ChangeTypeAdded, Document: &domain.Document{ExternalID: "ext-1"}},
-			}, "cursor-1", nil
-		}
-		// Return empty results - should stop pagination
-		return []*domain.Change{}, "cursor-2", nil
+		return []*domain.Change{
+			{ExternalID: "ext-1", Type: domain.ChangeTypeAdded, Document: &domain.Document{ExternalID: "ext-1"}},
+		}, "cursor-" + strconv.Itoa(callCount), nil
 	}
 
 	_, err := orchestrator.SyncSource(ctx, "source-1")
@@ -643,14 +693,17 @@ func TestSyncSource_PaginationStopsOnEmptyResults(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
-	if callCount != 2 {
-		t.Errorf("expected 2 calls to FetchChanges, got %d", callCount)
+	if callCount != 1 {
+		t.Errorf("expected 1 call to FetchChanges, got %d", callCount)
 	}
 
 	count, _ := documentStore.CountBySource(ctx, "source-1")
 	if count != 1 {
 		t.Errorf("expected 1 document, got %d", count)
 	}
+	if state, _ := syncStore.Get(ctx, "source-1"); state.Cursor != "cursor-1" {
+		t.Errorf("expected the returned cursor to be saved, got %q", state.Cursor)
+	}
 }
 
 // TestSyncSource_PaginationStopsOnSameCursor tests that pagination stops when cursor doesn't advance
@@ -859,6 +912,136 @@ func TestSyncSource_NormaliserApplied(t *testing.T) {
 	}
 }
 
+func TestSyncSource_ContentExtracted(t *testing.T) {
+	orchestrator, sourceStore, _, _, _, _, connectorFactory := createTestSyncOrchestrator(t)
+	ctx := context.Background()
+
+	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-1", Enabled: true})
+
+	var extractedFrom []string
+	extractor := mocks.NewMockContentExtractor()
+	extractor.ExtractFn = func(ctx context.Context, data []byte, mimeType string) (string, error) {
+		extractedFrom = append(extractedFrom, mimeType)
+		if string(data) == "%PDF-broken" {
+			return "", errors.New("malformed")
+		}
+		return "extracted text", nil
+	}
+	orchestrator.contentExtractor = extractor
+
+	var processed []string
+	pipeline := orchestrator.legacyPipeline.(*mocks.MockPostProcessorPipeline)
+	pipeline.ProcessFn = func(content string) []driven.Chunk {
+		processed = append(processed, content)
+		return []driven.Chunk{{Content: content, Position: 0}}
+	}
+
+	connectorFactory.connector.FetchChangesFn = func(ctx context.Context, source *domain.Source, cursor string) ([]*domain.Change, string, error) {
+		if cursor != "" {
+			return nil, "", nil
+		}
+		return []*domain.Change{
+			{ExternalID: "ext-1", Type: domain.ChangeTypeAdded, Document: &domain.Document{MimeType: "application/pdf"}, Content: "%PDF-1.7 binary"},
+			{ExternalID: "ext-2", Type: domain.ChangeTypeAdded, Document: &domain.Document{MimeType: "text/plain"}, Content: "plain text"},
+			{ExternalID: "ext-3", Type: domain.ChangeTypeAdded, Document: &domain.Document{MimeType: "application/pdf"}, Content: "%PDF-broken"},
+		}, "", nil
+	}
+
+	result, err := orchestrator.SyncSource(ctx, "source-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(extractedFrom) != 2 {
+		t.Errorf("expected only PDF documents to be extracted, got %v", extractedFrom)
+	}
+	if len(processed) != 2 || processed[0] != "extracted text" || processed[1] != "plain text" {
+		t.Errorf("expected extracted text to replace binary content, got %q", processed)
+	}
+	if result.Stats.DocumentsAdded != 2 || result.Stats.Errors != 1 {
+		t.Errorf("expected 2 added and 1 failed extraction, got %+v", result.Stats)
+	}
+}
+
+func TestSyncSource_SkipsUnchangedDocuments(t *testing.T) {
+	orchestrator, sourceStore, documentStore, _, _, _, connectorFactory := createTestSyncOrchestrator(t)
+	ctx := context.Background()
+
+	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-1", Enabled: true})
+
+	var processed []string
+	pipeline := orchestrator.legacyPipeline.(*mocks.MockPostProcessorPipeline)
+	pipeline.ProcessFn = func(content string) []driven.Chunk {
+		processed = append(processed, content)
+		return []driven.Chunk{{Content: content, Position: 0}}
+	}
+
+	contents := map[string]string{"ext-1": "first", "ext-2": "second"}
+	titles := map[string]string{"ext-1": "One", "ext-2": "Two"}
+	connectorFactory.connector.FetchChangesFn = func(ctx context.Context, source *domain.Source, cursor string) ([]*domain.Change, string, error) {
+		if cursor != "" {
+			return nil, "", nil
+		}
+		var changes []*domain.Change
+		for _, id := range []string{"ext-1", "ext-2"} {
+			changes = append(changes, &domain.Change{
+				ExternalID: id,
+				Type:       domain.ChangeTypeModified,
+				Document:   &domain.Document{Title: titles[id], MimeType: "text/plain", Metadata: map[string]string{"state": "open"}},
+				Content:    contents[id],
+			})
+		}
+		return changes, "", nil
+	}
+
+	result, _ := orchestrator.SyncSource(ctx, "source-1")
+	if result.Stats.DocumentsAdded != 2 || result.Stats.DocumentsSkipped != 0 {
+		t.Fatalf("expected first sync to add both documents, got %+v", result.Stats)
+	}
+
+	// Identical content is skipped without reprocessing
+	processed = nil
+	result, _ = orchestrator.SyncSource(ctx, "source-1")
+	if result.Stats.DocumentsSkipped != 2 || result.Stats.DocumentsUpdated != 0 || len(processed) != 0 {
+		t.Errorf("expected both documents skipped, got %+v and processed %q", result.Stats, processed)
+	}
+
+	// Changed content or indexed fields are reprocessed
+	contents["ext-1"] = "first, edited"
+	titles["ext-2"] = "Two (renamed)"
+	processed = nil
+	result, _ = orchestrator.SyncSource(ctx, "source-1")
+	if result.Stats.DocumentsUpdated != 2 || result.Stats.DocumentsSkipped != 0 || len(processed) != 2 {
+		t.Errorf("expected both documents updated, got %+v and processed %q", result.Stats, processed)
+	}
+
+	doc, err := documentStore.GetByExternalID(ctx, "source-1", "ext-1")
+	if err != nil || doc.ContentHash == "" {
+		t.Errorf("expected content hash to be persisted, got %+v (%v)", doc, err)
+	}
+}
+
+func TestContentHash(t *testing.T) {
+	doc := &domain.Document{Title: "Doc", MimeType: "text/plain"}
+
+	base := contentHash(doc, &domain.Change{Content: "body"})
+	if base != contentHash(doc, &domain.Change{Content: "body"}) {
+		t.Error("expected hash to be deterministic")
+	}
+	if base == contentHash(doc, &domain.Change{Content: "body!"}) {
+		t.Error("expected content changes to change the hash")
+	}
+	if base == contentHash(&domain.Document{Title: "Doc", MimeType: "text/markdown"}, &domain.Change{Content: "body"}) {
+		t.Error("expected MIME type changes to change the hash")
+	}
+
+	// A connector-supplied version replaces hashing the content
+	versioned := contentHash(doc, &domain.Change{Content: "body", ContentHash: "sha-1"})
+	if versioned != contentHash(doc, &domain.Change{Content: "other", ContentHash: "sha-1"}) {
+		t.Error("expected connector content hash to be used instead of content")
+	}
+}
+
 // TestSyncSource_NilSearchEngine tests that sync works without search engine
 func TestSyncSource_NilSearchEngine(t *testing.T) {
 	sourceStore := mocks.NewMockSourceStore()
@@ -1022,178 +1205,1079 @@ func TestSyncSource_SyncStateProgression(t *testing.T) {
 	}
 }
 
-// TestSyncAll_NoSources tests SyncAll with no sources
-func TestSyncAll_NoSources(t *testing.T) {
-	orchestrator, _, _, _, _, _, _ := createTestSyncOrchestrator(t)
+// TestSyncSource_CancelledMidSync tests that a cancellation request stops the
+// sync between documents and keeps the documents already processed
+func TestSyncSource_CancelledMidSync(t *testing.T) {
+	orchestrator, sourceStore, documentStore, _, syncStore, _, connectorFactory := createTestSyncOrchestrator(t)
+	connectorFactory.pageByCursor()
+	orchestrator.cancelPoll = 5 * time.Millisecond
 	ctx := context.Background()
 
-	results, err := orchestrator.SyncAll(ctx)
-	if err != nil {
-		t.Fatalf("unexpected error: %v", err)
-	}
-	if len(results) != 0 {
-		t.Errorf("expected 0 results, got %d", len(results))
+	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-1", Enabled: true})
+	_ = syncStore.Save(ctx, &domain.SyncState{SourceID: "source-1", Status: domain.SyncStatusIdle, Cursor: "cursor-0"})
+
+	connectorFactory.connector.FetchChangesFn = func(ctx context.Context, source *domain.Source, cursor string) ([]*domain.Change, string, error) {
+		if cursor == "cursor-0" {
+			return []*domain.Change{
+				{ExternalID: "ext-1", Type: domain.ChangeTypeAdded, Document: &domain.Document{ExternalID: "ext-1"}, Content: "one"},
+			}, "cursor-1", nil
+		}
+
+		// Request cancellation and wait for the running sync to observe it
+		if err := orchestrator.CancelSync(context.Background(), "source-1"); err != nil {
+			t.Errorf("cancel failed: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(time.Second):
+			t.Error("expected sync context to be cancelled")
+		}
+		return []*domain.Change{
+			{ExternalID: "ext-2", Type: domain.ChangeTypeAdded, Document: &domain.Document{ExternalID: "ext-2"}, Content: "two"},
+		}, "cursor-2", nil
 	}
-}
 
-// TestSyncAll_SkipsDisabledSources tests that disabled sources are skipped
-func TestSyncAll_SkipsDisabledSources(t *testing.T) {
-	orchestrator, sourceStore, _, _, _, _, _ := createTestSyncOrchestrator(t)
-	ctx := context.Background()
+	result, err := orchestrator.SyncSource(ctx, "source-1")
+	if !errors.Is(err, domain.ErrSyncCancelled) {
+		t.Fatalf("expected ErrSyncCancelled, got %v", err)
+	}
+	if result.Success || result.Stats.DocumentsAdded != 1 {
+		t.Errorf("expected unsuccessful result with partial stats, got %+v", result)
+	}
 
-	// Create disabled source
-	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-1", Name: "Disabled", Enabled: false})
-	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-2", Name: "Disabled 2", Enabled: false})
+	if _, err := documentStore.GetByExternalID(ctx, "source-1", "ext-1"); err != nil {
+		t.Errorf("expected document processed before cancellation to be kept: %v", err)
+	}
+	if _, err := documentStore.GetByExternalID(ctx, "source-1", "ext-2"); err == nil {
+		t.Error("expected no documents to be processed after cancellation")
+	}
 
-	results, err := orchestrator.SyncAll(ctx)
-	if err != nil {
-		t.Fatalf("unexpected error: %v", err)
+	state, _ := syncStore.Get(ctx, "source-1")
+	if state.Status != domain.SyncStatusCancelled {
+		t.Errorf("expected status cancelled, got %s", state.Status)
 	}
-	if len(results) != 0 {
-		t.Errorf("expected 0 results (disabled sources skipped), got %d", len(results))
+	if state.Cursor != "cursor-0" {
+		t.Errorf("expected cursor to be left for the next sync, got %s", state.Cursor)
+	}
+	if state.LastSyncAt != nil || state.CompletedAt == nil {
+		t.Error("expected CompletedAt but not LastSyncAt to be set")
 	}
 }
 
-// TestSyncAll_MultipleSources tests syncing multiple enabled sources
-func TestSyncAll_MultipleSources(t *testing.T) {
-	orchestrator, sourceStore, _, _, _, _, connectorFactory := createTestSyncOrchestrator(t)
+func TestSyncSource_AlreadyInProgress(t *testing.T) {
+	orchestrator, sourceStore, _, _, syncStore, _, connectorFactory := createTestSyncOrchestrator(t)
+	lock := mocks.NewMockDistributedLock()
+	orchestrator.lock = lock
 	ctx := context.Background()
 
-	// Create two enabled sources
-	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-1", Name: "Source 1", Enabled: true})
-	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-2", Name: "Source 2", Enabled: true})
+	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-1", Enabled: true})
+	_ = syncStore.Save(ctx, &domain.SyncState{SourceID: "source-1", Status: domain.SyncStatusRunning, Cursor: "cursor-0"})
+	lock.SetLockHeld(syncLockName("source-1"), time.Minute)
 
-	// Setup connector to return different docs per source
 	connectorFactory.connector.FetchChangesFn = func(ctx context.Context, source *domain.Source, cursor string) ([]*domain.Change, string, error) {
-		return []*domain.Change{
-			{ExternalID: source.ID + "-doc", Type: domain.ChangeTypeAdded, Document: &domain.Document{ExternalID: source.ID + "-doc"}, Content: "Content"},
-		}, "", nil
+		t.Error("expected no fetch while another sync holds the lock")
+		return nil, "", nil
 	}
 
-	results, err := orchestrator.SyncAll(ctx)
-	if err != nil {
-		t.Fatalf("unexpected error: %v", err)
+	result, err := orchestrator.SyncSource(ctx, "source-1")
+	if !errors.Is(err, domain.ErrSyncInProgress) {
+		t.Fatalf("expected ErrSyncInProgress, got %v", err)
 	}
-	if len(results) != 2 {
-		t.Errorf("expected 2 results, got %d", len(results))
+	if result == nil || result.Success || result.Error != domain.ErrSyncInProgress.Error() {
+		t.Errorf("expected unsuccessful result reporting the running sync, got %+v", result)
 	}
 
-	// All should be successful
-	for _, r := range results {
-		if !r.Success {
-			t.Errorf("expected all syncs to succeed, but %s failed: %s", r.SourceID, r.Error)
-		}
+	// The running sync's state is left alone
+	state, _ := syncStore.Get(ctx, "source-1")
+	if state.Status != domain.SyncStatusRunning || state.Cursor != "cursor-0" {
+		t.Errorf("expected sync state to be untouched, got %s with cursor %s", state.Status, state.Cursor)
+	}
+
+	if _, err := orchestrator.RetryFailedDocuments(ctx, "source-1"); !errors.Is(err, domain.ErrSyncInProgress) {
+		t.Errorf("expected retry to be rejected with ErrSyncInProgress, got %v", err)
 	}
 }
 
-// TestSyncAll_MixedEnabledDisabled tests that only enabled sources are synced
-func TestSyncAll_MixedEnabledDisabled(t *testing.T) {
+func TestSyncSource_HoldsLock(t *testing.T) {
 	orchestrator, sourceStore, _, _, _, _, connectorFactory := createTestSyncOrchestrator(t)
+	lock := mocks.NewMockDistributedLock()
+	orchestrator.lock = lock
+	orchestrator.lockTTL = 30 * time.Millisecond
 	ctx := context.Background()
 
 	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-1", Enabled: true})
-	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-2", Enabled: false})
-	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-3", Enabled: true})
 
+	var extended atomic.Int32
+	lock.ExtendFn = func(name string, ttl time.Duration) error {
+		extended.Add(1)
+		return nil
+	}
 	connectorFactory.connector.FetchChangesFn = func(ctx context.Context, source *domain.Source, cursor string) ([]*domain.Change, string, error) {
-		return []*domain.Change{}, "", nil
+		if !lock.IsHeld(syncLockName("source-1")) {
+			t.Error("expected the source lock to be held during the sync")
+		}
+		time.Sleep(50 * time.Millisecond) // Outlives the TTL, so the lock must be extended
+		return nil, "", nil
 	}
 
-	results, err := orchestrator.SyncAll(ctx)
-	if err != nil {
-		t.Fatalf("unexpected error: %v", err)
+	result, err := orchestrator.SyncSource(ctx, "source-1")
+	if err != nil || !result.Success {
+		t.Fatalf("expected successful sync, got %+v, %v", result, err)
 	}
-	if len(results) != 2 {
-		t.Errorf("expected 2 results (only enabled sources), got %d", len(results))
+	if extended.Load() == 0 {
+		t.Error("expected the lock to be extended while the sync ran")
+	}
+	if lock.IsHeld(syncLockName("source-1")) {
+		t.Error("expected the lock to be released after the sync")
 	}
 }
 
-// TestSyncAll_ListSourcesError tests error when listing sources fails
-func TestSyncAll_ListSourcesError(t *testing.T) {
-	orchestrator, sourceStore, _, _, _, _, _ := createTestSyncOrchestrator(t)
+func TestSyncSource_LockLost(t *testing.T) {
+	orchestrator, sourceStore, _, _, syncStore, _, connectorFactory := createTestSyncOrchestrator(t)
+	lock := mocks.NewMockDistributedLock()
+	orchestrator.lock = lock
+	orchestrator.lockTTL = 30 * time.Millisecond
 	ctx := context.Background()
 
-	// Create a custom mock that returns an error on List
-	mockStore := &mockSourceStoreWithError{
-		MockSourceStore: sourceStore,
-		listErr:         errors.New("list failed"),
-	}
-	orchestrator.sourceStore = mockStore
+	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-1", Enabled: true})
 
-	_, err := orchestrator.SyncAll(ctx)
-	if err == nil {
-		t.Fatal("expected error when list sources fails")
+	lock.ExtendFn = func(name string, ttl time.Duration) error {
+		return errors.New("lock expired")
 	}
-	if !containsString(err.Error(), "failed to list sources") {
-		t.Errorf("expected error to mention list sources, got: %v", err)
+	connectorFactory.connector.FetchChangesFn = func(ctx context.Context, source *domain.Source, cursor string) ([]*domain.Change, string, error) {
+		select {
+		case <-ctx.Done():
+			return nil, "", ctx.Err()
+		case <-time.After(time.Second):
+			t.Error("expected the sync to stop once its lock was lost")
+			return nil, "", nil
+		}
 	}
-}
 
-// mockSourceStoreWithError wraps MockSourceStore to inject errors
-type mockSourceStoreWithError struct {
-	*mocks.MockSourceStore
-	listErr error
-}
+	_, err := orchestrator.SyncSource(ctx, "source-1")
+	if !errors.Is(err, errSyncLockLost) {
+		t.Fatalf("expected errSyncLockLost, got %v", err)
+	}
 
-func (m *mockSourceStoreWithError) List(ctx context.Context) ([]*domain.Source, error) {
-	if m.listErr != nil {
-		return nil, m.listErr
+	state, _ := syncStore.Get(ctx, "source-1")
+	if state.Status != domain.SyncStatusFailed {
+		t.Errorf("expected status failed, got %s", state.Status)
 	}
-	return m.MockSourceStore.List(ctx)
 }
 
-// TestSyncAll_PartialFailure tests that SyncAll continues after individual source failures
-func TestSyncAll_PartialFailure(t *testing.T) {
-	orchestrator, sourceStore, _, _, _, _, connectorFactory := createTestSyncOrchestrator(t)
-	ctx := context.Background()
+// TestSyncSource_ResumesFromCheckpoint tests that a sync interrupted by its
+// context resumes from its checkpoint, keeping the documents seen before the
+// interruption for reconciliation
+func TestSyncSource_ResumesFromCheckpoint(t *testing.T) {
+	orchestrator, sourceStore, documentStore, _, syncStore, _, connectorFactory := createTestSyncOrchestrator(t)
+	connectorFactory.pageByCursor()
 
-	// Create two sources
-	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-1", Enabled: true})
-	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-2", Enabled: true})
+	_ = sourceStore.Save(context.Background(), &domain.Source{ID: "source-1", Enabled: true})
+	_ = documentStore.Save(context.Background(), &domain.Document{ID: "doc-stale", SourceID: "source-1", ExternalID: "stale"})
+	for _, id := range []string{"ext-1", "ext-2", "ext-3"} {
+		_ = documentStore.Save(context.Background(), &domain.Document{ID: "doc-" + id, SourceID: "source-1", ExternalID: id})
+	}
 
-	// First source succeeds, second fails
-	connectorFactory.connector.FetchChangesFn = func(ctx context.Context, source *domain.Source, cursor string) ([]*domain.Change, string, error) {
-		if source.ID == "source-2" {
-			return nil, "", errors.New("fetch failed")
+	pages := map[string][]*domain.Change{
+		"":   {{ExternalID: "ext-1", Type: domain.ChangeTypeAdded, Document: &domain.Document{ExternalID: "ext-1"}, Content: "one"}},
+		"p1": {{ExternalID: "ext-2", Type: domain.ChangeTypeAdded, Document: &domain.Document{ExternalID: "ext-2"}, Content: "two"}},
+		"p2": {{ExternalID: "ext-3", Type: domain.ChangeTypeAdded, Document: &domain.Document{ExternalID: "ext-3"}, Content: "three"}},
+	}
+	next := map[string]string{"": "p1", "p1": "p2", "p2": "done"}
+
+	// The worker shuts down while the second page is fetched
+	ctx, cancel := context.WithCancel(context.Background())
+	var cursors []string
+	connectorFactory.connector.FetchChangesFn = func(_ context.Context, source *domain.Source, cursor string) ([]*domain.Change, string, error) {
+		cursors = append(cursors, cursor)
+		if cursor == "p1" {
+			cancel()
 		}
-		return []*domain.Change{
-			{ExternalID: "doc", Type: domain.ChangeTypeAdded, Document: &domain.Document{ExternalID: "doc"}, Content: "Content"},
-		}, "", nil
+		if cursor == "done" {
+			return nil, "done", nil
+		}
+		return pages[cursor], next[cursor], nil
 	}
 
-	results, err := orchestrator.SyncAll(ctx)
+	if _, err := orchestrator.SyncSource(ctx, "source-1"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected interrupted sync, got %v", err)
+	}
+
+	checkpoint, _ := syncStore.GetCheckpoint(context.Background(), "source-1")
+	if checkpoint == nil || checkpoint.PageTokens[""] != "p1" || len(checkpoint.Seen) != 1 {
+		t.Fatalf("expected checkpoint at the interrupted page, got %+v", checkpoint)
+	}
+	if checkpoint.Stats.DocumentsAdded+checkpoint.Stats.DocumentsUpdated != 1 {
+		t.Errorf("expected checkpoint stats for the first page, got %+v", checkpoint.Stats)
+	}
+
+	cursors = nil
+	result, err := orchestrator.SyncSource(context.Background(), "source-1")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if len(results) != 2 {
-		t.Errorf("expected 2 results, got %d", len(results))
+	if len(cursors) == 0 || cursors[0] != "p1" {
+		t.Errorf("expected sync to resume at the checkpointed page, got %v", cursors)
 	}
-
-	// Count successes and failures
-	var successes, failures int
-	for _, r := range results {
-		if r.Success {
-			successes++
-		} else {
-			failures++
-		}
+	if !result.Success || result.Stats.DocumentsDeleted != 1 {
+		t.Errorf("expected resumed full sync to reconcile the stale document, got %+v", result)
 	}
-	if successes != 1 || failures != 1 {
-		t.Errorf("expected 1 success and 1 failure, got %d successes and %d failures", successes, failures)
+	if _, err := documentStore.GetByExternalID(context.Background(), "source-1", "ext-1"); err != nil {
+		t.Errorf("expected document seen before the interruption to be kept: %v", err)
+	}
+	if _, err := documentStore.GetByExternalID(context.Background(), "source-1", "stale"); err == nil {
+		t.Error("expected stale document to be deleted")
 	}
 
-	// Verify failed result has error message
-	for _, r := range results {
-		if !r.Success && r.Error == "" {
-			t.Error("expected error message in failed result")
-		}
+	if checkpoint, _ := syncStore.GetCheckpoint(context.Background(), "source-1"); checkpoint != nil {
+		t.Errorf("expected checkpoint to be cleared, got %+v", checkpoint)
+	}
+	if state, _ := syncStore.Get(context.Background(), "source-1"); state.Cursor != "done" {
+		t.Errorf("expected cursor from the last page, got %q", state.Cursor)
 	}
 }
 
-// TestProcessChange_NilDocument tests processAddOrUpdate with nil document
-func TestProcessChange_NilDocument(t *testing.T) {
-	orchestrator, sourceStore, _, _, _, _, _ := createTestSyncOrchestrator(t)
-	ctx := context.Background()
+// recordingSyncStore wraps MockSyncStateStore to record the seen external
+// IDs of each saved checkpoint
+type recordingSyncStore struct {
+	*mocks.MockSyncStateStore
+	seen [][]string
+}
+
+func (m *recordingSyncStore) SaveCheckpoint(ctx context.Context, sourceID string, checkpoint *domain.SyncCheckpoint) error {
+	if checkpoint != nil {
+		m.seen = append(m.seen, checkpoint.Seen)
+	}
+	return m.MockSyncStateStore.SaveCheckpoint(ctx, sourceID, checkpoint)
+}
+
+// TestSyncSource_CheckpointsSaveNewSeenIDs tests that each checkpoint saves
+// only the external IDs seen since the previous one
+func TestSyncSource_CheckpointsSaveNewSeenIDs(t *testing.T) {
+	orchestrator, sourceStore, _, _, syncStore, _, connectorFactory := createTestSyncOrchestrator(t)
+	connectorFactory.pageByCursor()
+	recorder := &recordingSyncStore{MockSyncStateStore: syncStore}
+	orchestrator.syncStore = recorder
+	orchestrator.checkpointEvery = 0
+	ctx := context.Background()
+
+	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-1", Enabled: true})
+	next := map[string]string{"": "p1", "p1": "p2", "p2": ""}
+	connectorFactory.connector.FetchChangesFn = func(_ context.Context, _ *domain.Source, cursor string) ([]*domain.Change, string, error) {
+		externalID := "ext-" + cursor
+		if next[cursor] == "" {
+			return nil, "done", nil
+		}
+		return []*domain.Change{{ExternalID: externalID, Type: domain.ChangeTypeAdded, Document: &domain.Document{ExternalID: externalID}, Content: "text"}}, next[cursor], nil
+	}
+
+	if _, err := orchestrator.SyncSource(ctx, "source-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(recorder.seen) < 2 {
+		t.Fatalf("expected a checkpoint per page, got %d", len(recorder.seen))
+	}
+	for i, seen := range recorder.seen {
+		if len(seen) > 1 {
+			t.Errorf("expected checkpoint %d to save only the newly seen ID, got %v", i, seen)
+		}
+	}
+	if checkpoint, _ := syncStore.GetCheckpoint(ctx, "source-1"); checkpoint != nil {
+		t.Errorf("expected the checkpoint and its seen IDs to be cleared, got %+v", checkpoint)
+	}
+}
+
+// TestSyncSource_ParallelContainers tests that containers are synced in
+// parallel up to the container concurrency, with stats aggregated across them
+func TestSyncSource_ParallelContainers(t *testing.T) {
+	orchestrator, sourceStore, documentStore, _, _, _, connectorFactory := createTestSyncOrchestrator(t)
+	orchestrator.containerConcurrency = 2
+	orchestrator.documentConcurrency = 3
+	ctx := context.Background()
+
+	containers := []string{"repo-a", "repo-b", "repo-c", "repo-d"}
+	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-1", Enabled: true, SelectedContainers: containers})
+
+	var active, maxActive atomic.Int32
+	connectorFactory.createFn = func(containerID string) driven.Connector {
+		connector := mocks.NewMockConnector()
+		connector.FetchChangesFn = func(ctx context.Context, source *domain.Source, cursor string) ([]*domain.Change, string, error) {
+			n := active.Add(1)
+			defer active.Add(-1)
+			for {
+				current := maxActive.Load()
+				if n <= current || maxActive.CompareAndSwap(current, n) {
+					break
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+
+			if cursor != "" {
+				return nil, cursor, nil
+			}
+			var changes []*domain.Change
+			for i := 0; i < 5; i++ {
+				id := fmt.Sprintf("%s-%d", containerID, i)
+				changes = append(changes, &domain.Change{
+					ExternalID: id,
+					Type:       domain.ChangeTypeAdded,
+					Document:   &domain.Document{ExternalID: id},
+					Content:    id,
+				})
+			}
+			return changes, "cursor-" + containerID, nil
+		}
+		return connector
+	}
+
+	result, err := orchestrator.SyncSource(ctx, "source-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.Success || result.Stats.DocumentsAdded != 20 || result.Stats.ChunksIndexed != 20 {
+		t.Errorf("expected 20 documents across containers, got %+v", result)
+	}
+	if got := maxActive.Load(); got != 2 {
+		t.Errorf("expected 2 containers to sync in parallel, got %d", got)
+	}
+	for _, containerID := range containers {
+		if _, err := documentStore.GetByExternalID(ctx, "source-1", containerID+"-4"); err != nil {
+			t.Errorf("expected documents of %s to be saved: %v", containerID, err)
+		}
+	}
+}
+
+// TestSyncSource_MergesContainerCursors tests that the cursors of a
+// provider keeping a position per container are merged across containers
+func TestSyncSource_MergesContainerCursors(t *testing.T) {
+	orchestrator, sourceStore, _, _, syncStore, _, connectorFactory := createTestSyncOrchestrator(t)
+	orchestrator.containerConcurrency = 2
+	ctx := context.Background()
+
+	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-1", Enabled: true, SelectedContainers: []string{"a", "b", "c"}})
+	connectorFactory.createFn = func(containerID string) driven.Connector {
+		connector := mocks.NewMockConnector()
+		connector.FetchChangesFn = func(ctx context.Context, source *domain.Source, cursor string) ([]*domain.Change, string, error) {
+			return nil, containerID + "=1", nil
+		}
+		return connector
+	}
+
+	if _, err := orchestrator.SyncSource(ctx, "source-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if state, _ := syncStore.Get(ctx, "source-1"); strings.Count(state.Cursor, "=") != 1 {
+		t.Fatalf("expected the last container's cursor without a merger, got %q", state.Cursor)
+	}
+
+	connectorFactory.builder = mergingBuilder{}
+	_ = syncStore.Save(ctx, &domain.SyncState{SourceID: "source-1", Status: domain.SyncStatusCompleted})
+	if _, err := orchestrator.SyncSource(ctx, "source-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if state, _ := syncStore.Get(ctx, "source-1"); state.Cursor != "a=1,b=1,c=1" {
+		t.Errorf("expected the containers' cursors to be merged, got %q", state.Cursor)
+	}
+}
+
+// TestSyncSource_RateLimitedFetchRetried tests that a rate-limited fetch is
+// retried after the requested delay instead of failing the container
+func TestSyncSource_RateLimitedFetchRetried(t *testing.T) {
+	orchestrator, sourceStore, _, _, _, _, connectorFactory := createTestSyncOrchestrator(t)
+	orchestrator.containerConcurrency = 2
+	ctx := context.Background()
+
+	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-1", Enabled: true})
+
+	var fetches []time.Time
+	connectorFactory.connector.FetchChangesFn = func(ctx context.Context, source *domain.Source, cursor string) ([]*domain.Change, string, error) {
+		fetches = append(fetches, time.Now())
+		if len(fetches) == 1 {
+			return nil, "", fmt.Errorf("list issues: %w", &domain.RateLimitError{RetryAfter: 30 * time.Millisecond})
+		}
+		if cursor != "" {
+			return nil, cursor, nil
+		}
+		return []*domain.Change{
+			{ExternalID: "ext-1", Type: domain.ChangeTypeAdded, Document: &domain.Document{ExternalID: "ext-1"}, Content: "one"},
+		}, "cursor-1", nil
+	}
+
+	result, err := orchestrator.SyncSource(ctx, "source-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.Success || result.Stats.DocumentsAdded != 1 {
+		t.Errorf("expected rate-limited fetch to be retried, got %+v", result)
+	}
+	if len(fetches) < 2 || fetches[1].Sub(fetches[0]) < 25*time.Millisecond {
+		t.Errorf("expected retry after the requested delay, got %v", fetches)
+	}
+}
+
+// TestCoveredByRunningSync tests which requested syncs a running sync covers
+func TestCoveredByRunningSync(t *testing.T) {
+	orchestrator, _, _, _, syncStore, _, _ := createTestSyncOrchestrator(t)
+	ctx := context.Background()
+
+	started := time.Now()
+	before, after := started.Add(-time.Minute), started.Add(time.Minute)
+
+	covered := func(opts domain.SyncOptions, requestedAt time.Time) bool {
+		t.Helper()
+		ok, err := orchestrator.CoveredByRunningSync(ctx, "source-1", opts, requestedAt)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return ok
+	}
+
+	if covered(domain.SyncOptions{}, before) {
+		t.Error("expected no cover without sync state")
+	}
+
+	_ = syncStore.Save(ctx, &domain.SyncState{SourceID: "source-1", Status: domain.SyncStatusRunning, StartedAt: &started})
+	if !covered(domain.SyncOptions{}, before) {
+		t.Error("expected a sync started after the request to cover it")
+	}
+	if covered(domain.SyncOptions{}, after) {
+		t.Error("expected a sync started before the request not to cover it")
+	}
+	if covered(domain.SyncOptions{Full: true}, before) {
+		t.Error("expected an incremental sync not to cover a full sync")
+	}
+
+	_ = syncStore.SaveCheckpoint(ctx, "source-1", &domain.SyncCheckpoint{Full: true})
+	if !covered(domain.SyncOptions{Full: true}, before) {
+		t.Error("expected a full sync to cover a full sync")
+	}
+
+	_ = syncStore.UpdateStatus(ctx, "source-1", domain.SyncStatusCancelling)
+	if covered(domain.SyncOptions{}, before) {
+		t.Error("expected a sync being cancelled to cover nothing")
+	}
+}
+
+// TestCancelSync_NotRunning tests that cancelling without a running sync is a no-op
+func TestCancelSync_NotRunning(t *testing.T) {
+	orchestrator, _, _, _, syncStore, _, _ := createTestSyncOrchestrator(t)
+	ctx := context.Background()
+
+	if err := orchestrator.CancelSync(ctx, "source-1"); err != nil {
+		t.Errorf("expected no error without sync state, got %v", err)
+	}
+
+	_ = syncStore.Save(ctx, &domain.SyncState{SourceID: "source-1", Status: domain.SyncStatusCompleted})
+	if err := orchestrator.CancelSync(ctx, "source-1"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	state, _ := syncStore.Get(ctx, "source-1")
+	if state.Status != domain.SyncStatusCompleted {
+		t.Errorf("expected status to stay completed, got %s", state.Status)
+	}
+}
+
+// TestSyncSource_ExcludedByRules tests that the source's rules apply to
+// every document, removing indexed documents they now exclude
+func TestSyncSource_ExcludedByRules(t *testing.T) {
+	orchestrator, sourceStore, documentStore, _, _, _, connectorFactory := createTestSyncOrchestrator(t)
+	ctx := context.Background()
+
+	_ = sourceStore.Save(ctx, &domain.Source{
+		ID:      "source-1",
+		Enabled: true,
+		Config:  domain.SourceConfig{Rules: &domain.SourceRules{ExcludePaths: []string{"*.log"}, MaxSizeBytes: 10}},
+	})
+	_ = documentStore.Save(ctx, &domain.Document{ID: "doc-b", SourceID: "source-1", ExternalID: "b", Path: "debug.log"})
+
+	connectorFactory.connector.FetchChangesFn = func(ctx context.Context, source *domain.Source, cursor string) ([]*domain.Change, string, error) {
+		return []*domain.Change{
+			{ExternalID: "a", Type: domain.ChangeTypeAdded, Document: &domain.Document{Path: "notes.md"}, Content: "notes"},
+			{ExternalID: "b", Type: domain.ChangeTypeModified, Document: &domain.Document{Path: "debug.log"}, Content: "log"},
+			{ExternalID: "c", Type: domain.ChangeTypeAdded, Document: &domain.Document{Path: "big.md"}, Content: "far too long to index"},
+		}, "", nil
+	}
+
+	result, err := orchestrator.SyncSource(ctx, "source-1")
+	if err != nil || !result.Success {
+		t.Fatalf("expected successful sync, got %+v, %v", result, err)
+	}
+	if result.Stats.DocumentsAdded != 1 || result.Stats.DocumentsExcluded != 2 || result.Stats.DocumentsDeleted != 1 {
+		t.Errorf("expected 1 added, 2 excluded and 1 deleted, got %+v", result.Stats)
+	}
+
+	if _, err := documentStore.GetByExternalID(ctx, "source-1", "a"); err != nil {
+		t.Errorf("expected included document to be indexed: %v", err)
+	}
+	for _, id := range []string{"b", "c"} {
+		if _, err := documentStore.GetByExternalID(ctx, "source-1", id); err == nil {
+			t.Errorf("expected excluded document %s not to be indexed", id)
+		}
+	}
+}
+
+func TestSyncSource_InvalidRules(t *testing.T) {
+	orchestrator, sourceStore, _, _, _, _, _ := createTestSyncOrchestrator(t)
+	ctx := context.Background()
+
+	_ = sourceStore.Save(ctx, &domain.Source{
+		ID:      "source-1",
+		Enabled: true,
+		Config:  domain.SourceConfig{Rules: &domain.SourceRules{TitlePattern: "("}},
+	})
+
+	result, _ := orchestrator.SyncSource(ctx, "source-1")
+	if result.Success || !strings.Contains(result.Error, "invalid source rules") {
+		t.Errorf("expected sync to fail on invalid rules, got %+v", result)
+	}
+}
+
+func TestPreviewRules(t *testing.T) {
+	orchestrator, sourceStore, _, _, _, _, connectorFactory := createTestSyncOrchestrator(t)
+	ctx := context.Background()
+
+	_ = sourceStore.Save(ctx, &domain.Source{
+		ID:      "source-1",
+		Enabled: true,
+		Config:  domain.SourceConfig{Rules: &domain.SourceRules{ExcludePaths: []string{"drafts"}}},
+	})
+
+	connectorFactory.connector.FetchChangesFn = func(ctx context.Context, source *domain.Source, cursor string) ([]*domain.Change, string, error) {
+		if cursor != "" {
+			return nil, cursor, nil
+		}
+		return []*domain.Change{
+			{ExternalID: "a", Type: domain.ChangeTypeAdded, Document: &domain.Document{Path: "docs/guide.md", MimeType: "text/markdown"}},
+			{ExternalID: "b", Type: domain.ChangeTypeAdded, Document: &domain.Document{Path: "drafts/plan.md", MimeType: "text/markdown"}},
+			{ExternalID: "c", Type: domain.ChangeTypeAdded, Document: &domain.Document{Path: "docs/logo.png", MimeType: "image/png"}},
+		}, "cursor-1", nil
+	}
+
+	preview, err := orchestrator.PreviewRules(ctx, "source-1", &domain.SourceRules{ExcludeMimeTypes: []string{"image/*"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if preview.Scanned != 3 || preview.Included != 2 {
+		t.Errorf("expected 3 scanned and 2 included, got %+v", preview)
+	}
+	if preview.AddedCount != 1 || preview.Added[0].ExternalID != "b" || preview.Added[0].Reason != "path excluded" {
+		t.Errorf("expected the draft to be added, got %+v", preview.Added)
+	}
+	if preview.RemovedCount != 1 || preview.Removed[0].ExternalID != "c" || preview.Removed[0].Reason != "mime type excluded" {
+		t.Errorf("expected the image to be removed, got %+v", preview.Removed)
+	}
+
+	if _, err := orchestrator.PreviewRules(ctx, "source-1", &domain.SourceRules{TitlePattern: "("}); !errors.Is(err, domain.ErrInvalidInput) {
+		t.Errorf("expected ErrInvalidInput for invalid rules, got %v", err)
+	}
+}
+
+// TestDryRunSync tests that a dry run reports what a sync would index
+// without storing or indexing anything
+func TestDryRunSync(t *testing.T) {
+	orchestrator, sourceStore, documentStore, _, syncStore, searchEngine, connectorFactory := createTestSyncOrchestrator(t)
+	dryRunStore := mocks.NewMockDryRunStore()
+	orchestrator.dryRunStore = dryRunStore
+	ctx := context.Background()
+
+	_ = sourceStore.Save(ctx, &domain.Source{
+		ID:     "source-1",
+		Config: domain.SourceConfig{Rules: &domain.SourceRules{ExcludePaths: []string{"drafts"}}},
+	})
+
+	connectorFactory.connector.FetchChangesFn = func(ctx context.Context, source *domain.Source, cursor string) ([]*domain.Change, string, error) {
+		if cursor != "" {
+			return nil, cursor, nil
+		}
+		return []*domain.Change{
+			{ExternalID: "a", Type: domain.ChangeTypeAdded, Document: &domain.Document{Path: "docs/a.md", Title: "A", MimeType: "text/markdown"}, Content: "12345678"},
+			{ExternalID: "b", Type: domain.ChangeTypeAdded, Document: &domain.Document{Path: "docs/b.md", MimeType: "text/markdown"}, Content: "1234"},
+			{ExternalID: "c", Type: domain.ChangeTypeAdded, Document: &domain.Document{Path: "docs/c.txt", MimeType: "text/plain"}, Content: "123"},
+			{ExternalID: "d", Type: domain.ChangeTypeAdded, Document: &domain.Document{Path: "drafts/d.md", MimeType: "text/markdown"}, Content: "draft"},
+			{ExternalID: "e", Type: domain.ChangeTypeDeleted},
+		}, "cursor-1", nil
+	}
+
+	run, err := orchestrator.DryRunSync(ctx, "source-1", "task-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if run.Status != domain.SyncStatusCompleted || run.Documents != 3 || run.Excluded != 1 {
+		t.Errorf("expected 3 documents and 1 excluded, got %+v", run)
+	}
+	if run.Chunks != 3 || run.EmbeddingTokens != 4 {
+		t.Errorf("expected 3 chunks and 4 tokens, got %d and %d", run.Chunks, run.EmbeddingTokens)
+	}
+	if stats := run.MimeTypes["text/markdown"]; stats == nil || stats.Documents != 2 || stats.EmbeddingTokens != 3 {
+		t.Errorf("unexpected markdown stats: %+v", stats)
+	}
+	if len(run.Samples) != 3 || run.Samples[0].Title != "A" || run.Samples[0].Excerpt != "12345678" {
+		t.Errorf("unexpected samples: %+v", run.Samples)
+	}
+
+	// Nothing is written
+	if _, err := documentStore.GetByExternalID(ctx, "source-1", "a"); err == nil {
+		t.Error("expected no document to be stored")
+	}
+	if chunks, _, _ := searchEngine.Search(ctx, "", nil, domain.SearchOptions{}); len(chunks) != 0 {
+		t.Errorf("expected nothing indexed, got %d chunks", len(chunks))
+	}
+	if state, _ := syncStore.Get(ctx, "source-1"); state != nil && state.Cursor != "" {
+		t.Errorf("expected the sync cursor to be untouched, got %q", state.Cursor)
+	}
+
+	saved, err := orchestrator.GetDryRun(ctx, "source-1", "task-1")
+	if err != nil || saved.Status != domain.SyncStatusCompleted || saved.Documents != 3 {
+		t.Errorf("expected the completed run to be saved, got %+v (%v)", saved, err)
+	}
+	if _, err := orchestrator.GetDryRun(ctx, "source-2", "task-1"); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for another source, got %v", err)
+	}
+}
+
+// TestFullSync_DeletesOrphanedDocuments tests that a full sync deletes
+// documents the connector no longer returns, ignoring the stored cursor
+func TestFullSync_DeletesOrphanedDocuments(t *testing.T) {
+	orchestrator, sourceStore, documentStore, _, syncStore, searchEngine, connectorFactory := createTestSyncOrchestrator(t)
+	ctx := context.Background()
+
+	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-1", Enabled: true})
+	_ = syncStore.Save(ctx, &domain.SyncState{SourceID: "source-1", Status: domain.SyncStatusCompleted, Cursor: "cursor-1"})
+	for _, id := range []string{"a", "b", "c"} {
+		_ = documentStore.Save(ctx, &domain.Document{ID: "doc-" + id, SourceID: "source-1", ExternalID: id})
+	}
+	_ = searchEngine.Index(ctx, []*domain.Chunk{{ID: "chunk-c", DocumentID: "doc-c", SourceID: "source-1"}})
+
+	var cursors []string
+	connectorFactory.connector.FetchChangesFn = func(ctx context.Context, source *domain.Source, cursor string) ([]*domain.Change, string, error) {
+		cursors = append(cursors, cursor)
+		return []*domain.Change{
+			{ExternalID: "a", Type: domain.ChangeTypeModified, Document: &domain.Document{ExternalID: "a"}, Content: "a"},
+			{ExternalID: "b", Type: domain.ChangeTypeModified, Document: &domain.Document{ExternalID: "b"}, Content: "b"},
+		}, "", nil
+	}
+
+	result, err := orchestrator.SyncWithOptions(ctx, "source-1", domain.SyncOptions{Full: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cursors) != 1 || cursors[0] != "" {
+		t.Errorf("expected full sync to ignore the stored cursor, got %v", cursors)
+	}
+	if !result.Success || result.Stats.DocumentsDeleted != 1 {
+		t.Errorf("expected 1 orphan deleted, got %+v", result)
+	}
+
+	if _, err := documentStore.GetByExternalID(ctx, "source-1", "c"); err == nil {
+		t.Error("expected orphaned document to be deleted")
+	}
+	if _, err := documentStore.GetByExternalID(ctx, "source-1", "a"); err != nil {
+		t.Errorf("expected enumerated document to be kept: %v", err)
+	}
+	if chunks, _, _ := searchEngine.Search(ctx, "", nil, domain.SearchOptions{}); len(chunks) != 2 {
+		t.Errorf("expected orphan chunks to be removed from the index, got %d chunks", len(chunks))
+	}
+}
+
+// TestSyncSource_IncrementalDoesNotReconcile tests that incremental syncs,
+// which only see changed documents, never delete unseen documents
+func TestSyncSource_IncrementalDoesNotReconcile(t *testing.T) {
+	orchestrator, sourceStore, documentStore, _, syncStore, _, connectorFactory := createTestSyncOrchestrator(t)
+	ctx := context.Background()
+
+	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-1", Enabled: true})
+	_ = syncStore.Save(ctx, &domain.SyncState{SourceID: "source-1", Status: domain.SyncStatusCompleted, Cursor: "cursor-1"})
+	_ = documentStore.Save(ctx, &domain.Document{ID: "doc-a", SourceID: "source-1", ExternalID: "a"})
+
+	connectorFactory.connector.FetchChangesFn = func(ctx context.Context, source *domain.Source, cursor string) ([]*domain.Change, string, error) {
+		return nil, "", nil
+	}
+
+	result, _ := orchestrator.SyncSource(ctx, "source-1")
+	if result.Stats.DocumentsDeleted != 0 {
+		t.Errorf("expected no deletions, got %d", result.Stats.DocumentsDeleted)
+	}
+	if _, err := documentStore.GetByExternalID(ctx, "source-1", "a"); err != nil {
+		t.Errorf("expected document to be kept: %v", err)
+	}
+}
+
+// TestFullSync_ReconcileThreshold tests that reconciliation is skipped when
+// too large a fraction of the source would be deleted
+func TestFullSync_ReconcileThreshold(t *testing.T) {
+	orchestrator, sourceStore, documentStore, _, syncStore, _, connectorFactory := createTestSyncOrchestrator(t)
+	ctx := context.Background()
+
+	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-1", Enabled: true})
+	for _, id := range []string{"a", "b", "c"} {
+		_ = documentStore.Save(ctx, &domain.Document{ID: "doc-" + id, SourceID: "source-1", ExternalID: id})
+	}
+
+	// The source suddenly looks almost empty, e.g. an unmounted directory
+	connectorFactory.connector.FetchChangesFn = func(ctx context.Context, source *domain.Source, cursor string) ([]*domain.Change, string, error) {
+		return []*domain.Change{
+			{ExternalID: "a", Type: domain.ChangeTypeModified, Document: &domain.Document{ExternalID: "a"}, Content: "a"},
+		}, "", nil
+	}
+
+	result, err := orchestrator.SyncWithOptions(ctx, "source-1", domain.SyncOptions{Full: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.Success {
+		t.Errorf("expected skipped reconciliation not to fail the sync, got %s", result.Error)
+	}
+	if result.Stats.DocumentsDeleted != 0 {
+		t.Errorf("expected no deletions above the threshold, got %d", result.Stats.DocumentsDeleted)
+	}
+	if count, _ := documentStore.CountBySource(ctx, "source-1"); count != 3 {
+		t.Errorf("expected all documents to be kept, got %d", count)
+	}
+
+	state, _ := syncStore.Get(ctx, "source-1")
+	if state.Status != domain.SyncStatusCompleted || !containsString(state.Error, "safety threshold") {
+		t.Errorf("expected completed state reporting the threshold, got %s: %s", state.Status, state.Error)
+	}
+
+	// Raising the threshold allows the deletion
+	orchestrator.maxDeleteRatio = 1
+	result, _ = orchestrator.SyncWithOptions(ctx, "source-1", domain.SyncOptions{Full: true})
+	if result.Stats.DocumentsDeleted != 2 {
+		t.Errorf("expected 2 deletions with the check disabled, got %d", result.Stats.DocumentsDeleted)
+	}
+}
+
+// TestSyncSource_RecordsRun tests that each sync is recorded in the sync
+// history with its trigger, stats and per-document errors, and that old
+// runs are pruned
+func TestSyncSource_RecordsRun(t *testing.T) {
+	orchestrator, sourceStore, _, _, _, _, connectorFactory := createTestSyncOrchestrator(t)
+	runStore := mocks.NewMockSyncRunStore()
+	orchestrator.runStore = runStore
+	orchestrator.runRetention = 2
+	ctx := context.Background()
+
+	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-1", Enabled: true, SelectedContainers: []string{"repo-1"}})
+
+	connectorFactory.connector.FetchChangesFn = func(ctx context.Context, source *domain.Source, cursor string) ([]*domain.Change, string, error) {
+		return []*domain.Change{
+			{ExternalID: "a", Type: domain.ChangeTypeAdded, Document: &domain.Document{ExternalID: "a"}, Content: "a"},
+			{ExternalID: "b", Type: "bogus"},
+		}, "", nil
+	}
+
+	if _, err := orchestrator.SyncWithOptions(ctx, "source-1", domain.SyncOptions{Trigger: domain.SyncTriggerScheduled}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	runs, total, err := orchestrator.ListSyncRuns(ctx, "source-1", 10, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if total != 1 || len(runs) != 1 {
+		t.Fatalf("expected 1 run, got %d", total)
+	}
+	run := runs[0]
+	if run.Trigger != domain.SyncTriggerScheduled || run.Status != domain.SyncStatusCompleted {
+		t.Errorf("expected completed scheduled run, got %s %s", run.Trigger, run.Status)
+	}
+	if run.Stats.DocumentsAdded != 1 || run.Stats.Errors != 1 {
+		t.Errorf("unexpected run stats %+v", run.Stats)
+	}
+	if len(run.Errors) != 1 || run.Errors[0].ExternalID != "b" || run.Errors[0].ContainerID != "repo-1" {
+		t.Errorf("expected the failed document to be recorded, got %+v", run.Errors)
+	}
+	if run.CompletedAt.Before(run.StartedAt) || len(run.Containers) != 1 {
+		t.Errorf("unexpected run %+v", run)
+	}
+
+	// Only the newest runs are kept
+	_, _ = orchestrator.SyncSource(ctx, "source-1")
+	_, _ = orchestrator.SyncSource(ctx, "source-1")
+	runs, total, _ = orchestrator.ListSyncRuns(ctx, "source-1", 10, 0)
+	if total != 2 || runs[0].Trigger != domain.SyncTriggerManual {
+		t.Errorf("expected 2 runs after pruning, newest manual, got %d", total)
+	}
+
+	if _, _, err := orchestrator.ListSyncRuns(ctx, "missing", 10, 0); err != domain.ErrNotFound {
+		t.Errorf("expected ErrNotFound for unknown source, got %v", err)
+	}
+}
+
+// TestSyncSource_RecordsDocumentErrors tests that failed documents are
+// recorded with their stage and cleared once they sync successfully
+func TestSyncSource_RecordsDocumentErrors(t *testing.T) {
+	orchestrator, sourceStore, _, _, _, _, connectorFactory := createTestSyncOrchestrator(t)
+	errorStore := mocks.NewMockDocumentErrorStore()
+	orchestrator.errorStore = errorStore
+	ctx := context.Background()
+
+	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-1", Enabled: true})
+
+	failing := true
+	connectorFactory.connector.FetchChangesFn = func(ctx context.Context, source *domain.Source, cursor string) ([]*domain.Change, string, error) {
+		change := &domain.Change{ExternalID: "a", Type: domain.ChangeTypeAdded, Document: &domain.Document{ExternalID: "a"}, Content: "a"}
+		if failing {
+			change.Document = nil
+		}
+		return []*domain.Change{change}, "", nil
+	}
+
+	_, _ = orchestrator.SyncSource(ctx, "source-1")
+	_, _ = orchestrator.SyncSource(ctx, "source-1")
+
+	docErrs, total, err := orchestrator.ListDocumentErrors(ctx, "source-1", 10, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if total != 1 || docErrs[0].ExternalID != "a" || docErrs[0].Stage != domain.DocumentStageFetch || docErrs[0].Attempts != 2 {
+		t.Fatalf("expected one fetch error with 2 attempts, got %+v", docErrs)
+	}
+
+	failing = false
+	_, _ = orchestrator.SyncSource(ctx, "source-1")
+	if _, total, _ := orchestrator.ListDocumentErrors(ctx, "source-1", 10, 0); total != 0 {
+		t.Errorf("expected the error to be cleared after a successful sync, got %d", total)
+	}
+}
+
+// TestRetryFailedDocuments tests that a retry refetches only failed
+// documents, clears recovered ones and deletes ones no longer in the source
+func TestRetryFailedDocuments(t *testing.T) {
+	orchestrator, sourceStore, documentStore, _, syncStore, _, connectorFactory := createTestSyncOrchestrator(t)
+	errorStore := mocks.NewMockDocumentErrorStore()
+	orchestrator.errorStore = errorStore
+	ctx := context.Background()
+
+	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-1", Enabled: true})
+	_ = syncStore.Save(ctx, &domain.SyncState{SourceID: "source-1", Status: domain.SyncStatusCompleted, Cursor: "cursor-1"})
+	_ = documentStore.Save(ctx, &domain.Document{ID: "doc-gone", SourceID: "source-1", ExternalID: "gone"})
+	now := time.Now()
+	for _, id := range []string{"fixed", "gone", "broken"} {
+		_ = errorStore.Save(ctx, &domain.DocumentError{
+			SourceID: "source-1", ExternalID: id, Stage: domain.DocumentStageIndex,
+			Error: "boom", Attempts: 1, FirstFailedAt: now, LastFailedAt: now,
+		})
+	}
+
+	connectorFactory.connector.FetchChangesFn = func(ctx context.Context, source *domain.Source, cursor string) ([]*domain.Change, string, error) {
+		t.Error("expected retry not to fetch changes")
+		return nil, "", nil
+	}
+	var fetched []string
+	connectorFactory.connector.FetchDocumentFn = func(ctx context.Context, source *domain.Source, externalID string) (*domain.Change, error) {
+		fetched = append(fetched, externalID)
+		switch externalID {
+		case "fixed":
+			return &domain.Change{Type: domain.ChangeTypeModified, Document: &domain.Document{Title: "Fixed"}, Content: "fixed"}, nil
+		case "gone":
+			return nil, domain.ErrNotFound
+		default:
+			return nil, errors.New("rate limited")
+		}
+	}
+
+	result, err := orchestrator.RetryFailedDocuments(ctx, "source-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(fetched) != 3 {
+		t.Errorf("expected the 3 failed documents to be fetched, got %v", fetched)
+	}
+	if !result.Success || result.Stats.DocumentsAdded != 1 || result.Stats.DocumentsDeleted != 1 || result.Stats.Errors != 1 {
+		t.Errorf("unexpected retry result %+v", result)
+	}
+
+	docErrs, _ := errorStore.ListAll(ctx, "source-1")
+	if len(docErrs) != 1 || docErrs[0].ExternalID != "broken" || docErrs[0].Stage != domain.DocumentStageFetch || docErrs[0].Attempts != 2 {
+		t.Errorf("expected only the still failing document to remain, got %+v", docErrs)
+	}
+	if _, err := documentStore.GetByExternalID(ctx, "source-1", "fixed"); err != nil {
+		t.Errorf("expected recovered document to be indexed: %v", err)
+	}
+	if _, err := documentStore.GetByExternalID(ctx, "source-1", "gone"); err == nil {
+		t.Error("expected document no longer in the source to be deleted")
+	}
+
+	state, _ := syncStore.Get(ctx, "source-1")
+	if state.Cursor != "cursor-1" || state.Status != domain.SyncStatusCompleted {
+		t.Errorf("expected sync state to be untouched, got %+v", state)
+	}
+}
+
+// TestSyncAll_NoSources tests SyncAll with no sources
+func TestSyncAll_NoSources(t *testing.T) {
+	orchestrator, _, _, _, _, _, _ := createTestSyncOrchestrator(t)
+	ctx := context.Background()
+
+	results, err := orchestrator.SyncAll(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 0 {
+		t.Errorf("expected 0 results, got %d", len(results))
+	}
+}
+
+// TestSyncAll_SkipsDisabledSources tests that disabled sources are skipped
+func TestSyncAll_SkipsDisabledSources(t *testing.T) {
+	orchestrator, sourceStore, _, _, _, _, _ := createTestSyncOrchestrator(t)
+	ctx := context.Background()
+
+	// Create disabled source
+	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-1", Name: "Disabled", Enabled: false})
+	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-2", Name: "Disabled 2", Enabled: false})
+
+	results, err := orchestrator.SyncAll(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 0 {
+		t.Errorf("expected 0 results (disabled sources skipped), got %d", len(results))
+	}
+}
+
+// TestSyncAll_MultipleSources tests syncing multiple enabled sources
+func TestSyncAll_MultipleSources(t *testing.T) {
+	orchestrator, sourceStore, _, _, _, _, connectorFactory := createTestSyncOrchestrator(t)
+	ctx := context.Background()
+
+	// Create two enabled sources
+	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-1", Name: "Source 1", Enabled: true})
+	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-2", Name: "Source 2", Enabled: true})
+
+	// Setup connector to return different docs per source
+	connectorFactory.connector.FetchChangesFn = func(ctx context.Context, source *domain.Source, cursor string) ([]*domain.Change, string, error) {
+		return []*domain.Change{
+			{ExternalID: source.ID + "-doc", Type: domain.ChangeTypeAdded, Document: &domain.Document{ExternalID: source.ID + "-doc"}, Content: "Content"},
+		}, "", nil
+	}
+
+	results, err := orchestrator.SyncAll(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 2 {
+		t.Errorf("expected 2 results, got %d", len(results))
+	}
+
+	// All should be successful
+	for _, r := range results {
+		if !r.Success {
+			t.Errorf("expected all syncs to succeed, but %s failed: %s", r.SourceID, r.Error)
+		}
+	}
+}
+
+// TestSyncAll_MixedEnabledDisabled tests that only enabled sources are synced
+func TestSyncAll_MixedEnabledDisabled(t *testing.T) {
+	orchestrator, sourceStore, _, _, _, _, connectorFactory := createTestSyncOrchestrator(t)
+	ctx := context.Background()
+
+	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-1", Enabled: true})
+	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-2", Enabled: false})
+	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-3", Enabled: true})
+
+	connectorFactory.connector.FetchChangesFn = func(ctx context.Context, source *domain.Source, cursor string) ([]*domain.Change, string, error) {
+		return []*domain.Change{}, "", nil
+	}
+
+	results, err := orchestrator.SyncAll(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 2 {
+		t.Errorf("expected 2 results (only enabled sources), got %d", len(results))
+	}
+}
+
+// TestSyncAll_ListSourcesError tests error when listing sources fails
+func TestSyncAll_ListSourcesError(t *testing.T) {
+	orchestrator, sourceStore, _, _, _, _, _ := createTestSyncOrchestrator(t)
+	ctx := context.Background()
+
+	// Create a custom mock that returns an error on List
+	mockStore := &mockSourceStoreWithError{
+		MockSourceStore: sourceStore,
+		listErr:         errors.New("list failed"),
+	}
+	orchestrator.sourceStore = mockStore
+
+	_, err := orchestrator.SyncAll(ctx)
+	if err == nil {
+		t.Fatal("expected error when list sources fails")
+	}
+	if !containsString(err.Error(), "failed to list sources") {
+		t.Errorf("expected error to mention list sources, got: %v", err)
+	}
+}
+
+// mockSourceStoreWithError wraps MockSourceStore to inject errors
+type mockSourceStoreWithError struct {
+	*mocks.MockSourceStore
+	listErr error
+}
+
+func (m *mockSourceStoreWithError) List(ctx context.Context) ([]*domain.Source, error) {
+	if m.listErr != nil {
+		return nil, m.listErr
+	}
+	return m.MockSourceStore.List(ctx)
+}
+
+// TestSyncAll_PartialFailure tests that SyncAll continues after individual source failures
+func TestSyncAll_PartialFailure(t *testing.T) {
+	orchestrator, sourceStore, _, _, _, _, connectorFactory := createTestSyncOrchestrator(t)
+	ctx := context.Background()
+
+	// Create two sources
+	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-1", Enabled: true})
+	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-2", Enabled: true})
+
+	// First source succeeds, second fails
+	connectorFactory.connector.FetchChangesFn = func(ctx context.Context, source *domain.Source, cursor string) ([]*domain.Change, string, error) {
+		if source.ID == "source-2" {
+			return nil, "", errors.New("fetch failed")
+		}
+		return []*domain.Change{
+			{ExternalID: "doc", Type: domain.ChangeTypeAdded, Document: &domain.Document{ExternalID: "doc"}, Content: "Content"},
+		}, "", nil
+	}
+
+	results, err := orchestrator.SyncAll(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 2 {
+		t.Errorf("expected 2 results, got %d", len(results))
+	}
+
+	// Count successes and failures
+	var successes, failures int
+	for _, r := range results {
+		if r.Success {
+			successes++
+		} else {
+			failures++
+		}
+	}
+	if successes != 1 || failures != 1 {
+		t.Errorf("expected 1 success and 1 failure, got %d successes and %d failures", successes, failures)
+	}
+
+	// Verify failed result has error message
+	for _, r := range results {
+		if !r.Success && r.Error == "" {
+			t.Error("expected error message in failed result")
+		}
+	}
+}
+
+// TestProcessChange_NilDocument tests processAddOrUpdate with nil document
+func TestProcessChange_NilDocument(t *testing.T) {
+	orchestrator, sourceStore, _, _, _, _, _ := createTestSyncOrchestrator(t)
+	ctx := context.Background()
 
 	source := &domain.Source{ID: "source-1"}
 	_ = sourceStore.Save(ctx, source)
@@ -1400,6 +2484,69 @@ func (m *mockSearchEngineWithError) DeleteByDocument(ctx context.Context, docume
 	return m.MockSearchEngine.DeleteByDocument(ctx, documentID)
 }
 
+// mockChunkStoreWithError wraps MockChunkStore to inject errors
+type mockChunkStoreWithError struct {
+	*mocks.MockChunkStore
+	saveErr error
+}
+
+func (m *mockChunkStoreWithError) Save(ctx context.Context, chunk *domain.Chunk) error {
+	if m.saveErr != nil {
+		return m.saveErr
+	}
+	return m.MockChunkStore.Save(ctx, chunk)
+}
+
+// TestSyncSource_ChunkSaveFailureKeepsHashUnset tests that a document whose
+// chunks fail to save is not marked as processed, so the next sync retries it
+func TestSyncSource_ChunkSaveFailureKeepsHashUnset(t *testing.T) {
+	sourceStore := mocks.NewMockSourceStore()
+	documentStore := mocks.NewMockDocumentStore()
+	chunkStore := &mockChunkStoreWithError{MockChunkStore: mocks.NewMockChunkStore(), saveErr: errors.New("disk full")}
+	connectorFactory := newMockConnectorFactory()
+
+	orchestrator := NewSyncOrchestrator(SyncOrchestratorConfig{
+		SourceStore:      sourceStore,
+		DocumentStore:    documentStore,
+		ChunkStore:       chunkStore,
+		SyncStore:        mocks.NewMockSyncStateStore(),
+		SearchEngine:     mocks.NewMockSearchEngine(),
+		ConnectorFactory: connectorFactory,
+		NormaliserReg:    mocks.NewMockNormaliserRegistry(),
+		LegacyPipeline:   mocks.NewMockPostProcessorPipeline(),
+	})
+
+	ctx := context.Background()
+	_ = sourceStore.Save(ctx, &domain.Source{ID: "source-1", Enabled: true})
+
+	connectorFactory.connector.FetchChangesFn = func(ctx context.Context, source *domain.Source, cursor string) ([]*domain.Change, string, error) {
+		return []*domain.Change{
+			{ExternalID: "ext-1", Type: domain.ChangeTypeAdded, Document: &domain.Document{ExternalID: "ext-1"}, Content: "Content"},
+		}, "", nil
+	}
+
+	if _, err := orchestrator.SyncSource(ctx, "source-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	doc, err := documentStore.GetByExternalID(ctx, "source-1", "ext-1")
+	if err != nil {
+		t.Fatalf("expected document to be saved: %v", err)
+	}
+	if doc.ContentHash != "" {
+		t.Errorf("expected no content hash after chunks failed to save, got %q", doc.ContentHash)
+	}
+
+	// Once the chunks save, the hash is persisted
+	chunkStore.saveErr = nil
+	if _, err := orchestrator.SyncSource(ctx, "source-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	doc, _ = documentStore.GetByExternalID(ctx, "source-1", "ext-1")
+	if doc.ContentHash == "" {
+		t.Error("expected content hash to be persisted")
+	}
+}
+
 // Helper function to check if a string contains a substring
 func containsString(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || len(substr) == 0 ||
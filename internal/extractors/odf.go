@@ -0,0 +1,93 @@
+package extractors
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Ensure ODFExtractor implements the interface
+var _ driven.ContentExtractor = (*ODFExtractor)(nil)
+
+// maxODFSpaces caps the run length of a text:s element
+const maxODFSpaces = 64
+
+// ODFExtractor extracts text from OpenDocument text, spreadsheet and
+// presentation files (.odt, .ods, .odp). All three keep their body in
+// content.xml with the same paragraph and table elements.
+type ODFExtractor struct{}
+
+// NewODFExtractor creates a new OpenDocument extractor
+func NewODFExtractor() *ODFExtractor {
+	return &ODFExtractor{}
+}
+
+// SupportedTypes returns the MIME types this extractor handles
+func (e *ODFExtractor) SupportedTypes() []string {
+	return []string{MimeTypeODT, MimeTypeODS, MimeTypeODP}
+}
+
+// odfRules maps OpenDocument content to text. Paragraph text is mixed
+// content, so everything inside text:p and text:h is kept apart from notes
+// and annotations, which would otherwise be spliced mid-sentence.
+var odfRules = xmlTextRules{
+	keep: map[string]bool{"p": true, "h": true},
+	skip: map[string]bool{"note": true, "annotation": true, "tracked-changes": true},
+	start: func(el xml.StartElement) string {
+		switch el.Name.Local {
+		case "s":
+			n := 1
+			for _, a := range el.Attr {
+				if a.Name.Local == "c" {
+					if c, err := strconv.Atoi(a.Value); err == nil && c > 0 {
+						n = min(c, maxODFSpaces)
+					}
+				}
+			}
+			return strings.Repeat(" ", n)
+		case "tab":
+			return "\t"
+		case "line-break":
+			return "\n"
+		}
+		return ""
+	},
+	end: map[string]string{
+		"p":          "\n",
+		"h":          "\n",
+		"table-cell": "\t",
+		"table-row":  "\n",
+		"page":       "\n\n", // Presentation slides
+	},
+	cells: map[string]bool{"table-cell": true},
+}
+
+// Extract returns the document text
+func (e *ODFExtractor) Extract(ctx context.Context, data []byte, mimeType string) (string, error) {
+	zr, err := openZip(data)
+	if err != nil {
+		return "", err
+	}
+
+	content, err := readZipPart(zr, "content.xml")
+	if err != nil {
+		return "", err
+	}
+	if content == nil {
+		return "", fmt.Errorf("%w: missing content.xml", ErrMalformed)
+	}
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	var out textBuilder
+	if err := extractXMLText(content, odfRules, &out); err != nil {
+		return "", fmt.Errorf("content.xml: %w", err)
+	}
+
+	return cleanText(out.String()), nil
+}
@@ -0,0 +1,150 @@
+package extractors
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+)
+
+// buildZip creates an archive from part names to contents
+func buildZip(t *testing.T, parts map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range parts {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatalf("create %s: %v", name, err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("close zip: %v", err)
+	}
+	return buf.Bytes()
+}
+
+const wNS = `xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"`
+
+func TestOOXMLExtractor_DOCX(t *testing.T) {
+	data := buildZip(t, map[string]string{
+		"word/document.xml": `<?xml version="1.0"?><w:document ` + wNS + `><w:body>
+<w:p><w:r><w:t>Incident</w:t></w:r><w:r><w:t xml:space="preserve"> review</w:t></w:r></w:p>
+<w:p><w:r><w:t>Owner:</w:t><w:tab/><w:t>SRE</w:t></w:r><w:r><w:instrText>PAGE</w:instrText></w:r></w:p>
+<w:tbl><w:tr><w:tc><w:p><w:r><w:t>Step</w:t></w:r></w:p></w:tc><w:tc><w:p><w:r><w:t>Rotate</w:t></w:r></w:p><w:p><w:r><w:t>keys</w:t></w:r></w:p></w:tc></w:tr></w:tbl>
+</w:body></w:document>`,
+		"word/footnotes.xml": `<w:footnotes ` + wNS + `><w:footnote><w:p><w:r><w:t>See runbook.</w:t></w:r></w:p></w:footnote></w:footnotes>`,
+	})
+
+	text, err := NewOOXMLExtractor().Extract(context.Background(), data, MimeTypeDOCX)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := "Incident review\nOwner:\tSRE\nStep\tRotate keys\n\nSee runbook."
+	if text != want {
+		t.Errorf("expected %q, got %q", want, text)
+	}
+}
+
+func TestOOXMLExtractor_XLSX(t *testing.T) {
+	data := buildZip(t, map[string]string{
+		"xl/workbook.xml": `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets><sheet name="Budget" sheetId="1" r:id="rId2"/><sheet name="Notes" sheetId="2" r:id="rId1"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Target="worksheets/sheet1.xml"/><Relationship Id="rId2" Target="/xl/worksheets/sheet2.xml"/></Relationships>`,
+		"xl/sharedStrings.xml": `<sst><si><t>Team</t></si><si><r><t>Spend</t></r><rPh><t>ignored</t></rPh></si><si><t>Platform</t></si></sst>`,
+		"xl/worksheets/sheet2.xml": `<worksheet><sheetData>
+<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c></row>
+<row r="2"><c r="A2" t="s"><v>2</v></c><c r="B2"><f>SUM(C1:C9)</f><v>1200.5</v></c><c r="C2" t="b"><v>1</v></c></row>
+<row r="3"></row></sheetData></worksheet>`,
+		"xl/worksheets/sheet1.xml": `<worksheet><sheetData><row r="1"><c r="A1" t="inlineStr"><is><t>Draft</t></is></c></row></sheetData></worksheet>`,
+	})
+
+	text, err := NewOOXMLExtractor().Extract(context.Background(), data, MimeTypeXLSX)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := "Budget\nTeam\tSpend\nPlatform\t1200.5\tTRUE\n\nNotes\nDraft"
+	if text != want {
+		t.Errorf("expected %q, got %q", want, text)
+	}
+}
+
+func TestOOXMLExtractor_PPTX(t *testing.T) {
+	slide := func(text string) string {
+		return `<p:sld xmlns:p="p" xmlns:a="a"><p:cSld><p:spTree><p:sp><p:txBody><a:p><a:r><a:t>` + text + `</a:t></a:r></a:p></p:txBody></p:sp></p:spTree></p:cSld></p:sld>`
+	}
+	data := buildZip(t, map[string]string{
+		"ppt/slides/slide10.xml":           slide("Ten"),
+		"ppt/slides/slide2.xml":            slide("Two"),
+		"ppt/slides/slide1.xml":            slide("One"),
+		"ppt/slides/_rels/slide1.xml.rels": `<Relationships/>`,
+	})
+
+	text, err := NewOOXMLExtractor().Extract(context.Background(), data, MimeTypePPTX)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if text != "One\n\nTwo\n\nTen" {
+		t.Errorf("expected slides in numeric order, got %q", text)
+	}
+}
+
+func TestOOXMLExtractor_Malformed(t *testing.T) {
+	_, err := NewOOXMLExtractor().Extract(context.Background(), []byte("not a zip"), MimeTypeDOCX)
+	if !errors.Is(err, ErrMalformed) {
+		t.Errorf("expected ErrMalformed, got %v", err)
+	}
+
+	empty := buildZip(t, map[string]string{"[Content_Types].xml": "<Types/>"})
+	if _, err := NewOOXMLExtractor().Extract(context.Background(), empty, MimeTypeDOCX); !errors.Is(err, ErrMalformed) {
+		t.Errorf("expected ErrMalformed for missing document part, got %v", err)
+	}
+}
+
+const odfNS = `xmlns:office="urn:oasis:names:tc:opendocument:xmlns:office:1.0" xmlns:text="urn:oasis:names:tc:opendocument:xmlns:text:1.0" xmlns:table="urn:oasis:names:tc:opendocument:xmlns:table:1.0"`
+
+func TestODFExtractor_Text(t *testing.T) {
+	data := buildZip(t, map[string]string{
+		"mimetype": MimeTypeODT,
+		"content.xml": `<office:document-content ` + odfNS + `><office:body><office:text>
+<text:h text:outline-level="1">Design notes</text:h>
+<text:p>Two<text:s text:c="2"/>spaces<text:note><text:note-body><text:p>footnote</text:p></text:note-body></text:note> and a <text:span>span</text:span>.</text:p>
+</office:text></office:body></office:document-content>`,
+	})
+
+	text, err := NewODFExtractor().Extract(context.Background(), data, MimeTypeODT)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := "Design notes\nTwo  spaces and a span."
+	if text != want {
+		t.Errorf("expected %q, got %q", want, text)
+	}
+}
+
+func TestODFExtractor_Spreadsheet(t *testing.T) {
+	data := buildZip(t, map[string]string{
+		"content.xml": `<office:document-content ` + odfNS + `><office:body><office:spreadsheet><table:table table:name="Sheet1">
+<table:table-row><table:table-cell><text:p>Region</text:p></table:table-cell><table:table-cell><text:p>Total</text:p></table:table-cell></table:table-row>
+<table:table-row><table:table-cell><text:p>EMEA</text:p><text:p>North</text:p></table:table-cell><table:table-cell><text:p>42</text:p></table:table-cell></table:table-row>
+</table:table></office:spreadsheet></office:body></office:document-content>`,
+	})
+
+	text, err := NewODFExtractor().Extract(context.Background(), data, MimeTypeODS)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := "Region\tTotal\nEMEA North\t42"
+	if text != want {
+		t.Errorf("expected %q, got %q", want, text)
+	}
+}
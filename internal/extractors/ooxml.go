@@ -0,0 +1,365 @@
+package extractors
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Ensure OOXMLExtractor implements the interface
+var _ driven.ContentExtractor = (*OOXMLExtractor)(nil)
+
+// OOXMLExtractor extracts text from Office Open XML documents
+// (Word .docx, Excel .xlsx and PowerPoint .pptx) by reading the XML parts
+// of the zip package directly.
+type OOXMLExtractor struct{}
+
+// NewOOXMLExtractor creates a new Office Open XML extractor
+func NewOOXMLExtractor() *OOXMLExtractor {
+	return &OOXMLExtractor{}
+}
+
+// SupportedTypes returns the MIME types this extractor handles
+func (e *OOXMLExtractor) SupportedTypes() []string {
+	return []string{MimeTypeDOCX, MimeTypeXLSX, MimeTypePPTX}
+}
+
+// Extract returns the document text
+func (e *OOXMLExtractor) Extract(ctx context.Context, data []byte, mimeType string) (string, error) {
+	zr, err := openZip(data)
+	if err != nil {
+		return "", err
+	}
+
+	var out textBuilder
+	switch normaliseMIMEType(mimeType) {
+	case MimeTypeDOCX:
+		err = extractDOCX(ctx, zr, &out)
+	case MimeTypeXLSX:
+		err = extractXLSX(ctx, zr, &out)
+	case MimeTypePPTX:
+		err = extractPPTX(ctx, zr, &out)
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedType, mimeType)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return cleanText(out.String()), nil
+}
+
+// wordprocessingRules maps WordprocessingML to text. Only w:t holds text,
+// which keeps field instructions and deleted runs out of the output.
+var wordprocessingRules = xmlTextRules{
+	keep: map[string]bool{"t": true},
+	start: func(el xml.StartElement) string {
+		switch el.Name.Local {
+		case "tab":
+			return "\t"
+		case "br", "cr":
+			return "\n"
+		}
+		return ""
+	},
+	end:   map[string]string{"p": "\n", "tc": "\t", "tr": "\n"},
+	cells: map[string]bool{"tc": true},
+}
+
+// extractDOCX reads the main document followed by footnotes and endnotes
+func extractDOCX(ctx context.Context, zr *zip.Reader, out *textBuilder) error {
+	parts := []string{"word/document.xml", "word/footnotes.xml", "word/endnotes.xml"}
+	for i, name := range parts {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		data, err := readZipPart(zr, name)
+		if err != nil {
+			return err
+		}
+		if data == nil {
+			if i == 0 {
+				return fmt.Errorf("%w: missing %s", ErrMalformed, name)
+			}
+			continue
+		}
+		if err := extractXMLText(data, wordprocessingRules, out); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		out.paragraph()
+	}
+	return nil
+}
+
+// drawingRules maps DrawingML text bodies (slides) to text
+var drawingRules = xmlTextRules{
+	keep: map[string]bool{"t": true},
+	start: func(el xml.StartElement) string {
+		if el.Name.Local == "br" {
+			return "\n"
+		}
+		return ""
+	},
+	end:   map[string]string{"p": "\n", "tc": "\t", "tr": "\n"},
+	cells: map[string]bool{"tc": true},
+}
+
+// extractPPTX reads the slides in order, one paragraph block per slide
+func extractPPTX(ctx context.Context, zr *zip.Reader, out *textBuilder) error {
+	slides := zipPartsIn(zr, "ppt/slides", "slide")
+	if len(slides) == 0 {
+		return fmt.Errorf("%w: no slides found", ErrMalformed)
+	}
+
+	for _, name := range slides {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		data, err := readZipPart(zr, name)
+		if err != nil {
+			return err
+		}
+		if err := extractXMLText(data, drawingRules, out); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		out.paragraph()
+	}
+	return nil
+}
+
+// extractXLSX reads each worksheet in workbook order. Each sheet starts with
+// its name, followed by one tab-separated line per non-empty row.
+func extractXLSX(ctx context.Context, zr *zip.Reader, out *textBuilder) error {
+	shared, err := readSharedStrings(zr)
+	if err != nil {
+		return err
+	}
+
+	sheets, err := workbookSheets(zr)
+	if err != nil {
+		return err
+	}
+	if len(sheets) == 0 {
+		return fmt.Errorf("%w: no worksheets found", ErrMalformed)
+	}
+
+	for _, sheet := range sheets {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		data, err := readZipPart(zr, sheet.part)
+		if err != nil {
+			return err
+		}
+		if data == nil {
+			continue
+		}
+		if sheet.name != "" {
+			out.write(sheet.name)
+			out.newline()
+		}
+		if err := extractWorksheet(data, shared, out); err != nil {
+			return fmt.Errorf("%s: %w", sheet.part, err)
+		}
+		out.paragraph()
+	}
+	return nil
+}
+
+// xlsxSheet is a worksheet and the zip part holding it
+type xlsxSheet struct {
+	name string
+	part string
+}
+
+// workbookSheets lists worksheets in workbook order, resolving their parts
+// through the workbook relationships. Packages without a readable workbook
+// fall back to the worksheet parts in name order.
+func workbookSheets(zr *zip.Reader) ([]xlsxSheet, error) {
+	workbook, err := readZipPart(zr, "xl/workbook.xml")
+	if err != nil {
+		return nil, err
+	}
+	rels, err := readZipPart(zr, "xl/_rels/workbook.xml.rels")
+	if err != nil {
+		return nil, err
+	}
+
+	var sheets []xlsxSheet
+	if workbook != nil && rels != nil {
+		var wb struct {
+			Sheets []struct {
+				Name string     `xml:"name,attr"`
+				Attr []xml.Attr `xml:",any,attr"`
+			} `xml:"sheets>sheet"`
+		}
+		var rs struct {
+			Relationships []struct {
+				ID     string `xml:"Id,attr"`
+				Target string `xml:"Target,attr"`
+			} `xml:"Relationship"`
+		}
+		if xml.Unmarshal(workbook, &wb) == nil && xml.Unmarshal(rels, &rs) == nil {
+			targets := make(map[string]string, len(rs.Relationships))
+			for _, r := range rs.Relationships {
+				targets[r.ID] = r.Target
+			}
+			for _, s := range wb.Sheets {
+				for _, a := range s.Attr {
+					if a.Name.Local != "id" {
+						continue
+					}
+					if target, ok := targets[a.Value]; ok {
+						sheets = append(sheets, xlsxSheet{name: s.Name, part: resolvePartTarget("xl", target)})
+					}
+				}
+			}
+		}
+	}
+
+	if len(sheets) == 0 {
+		for _, part := range zipPartsIn(zr, "xl/worksheets", "sheet") {
+			sheets = append(sheets, xlsxSheet{part: part})
+		}
+	}
+	return sheets, nil
+}
+
+// resolvePartTarget resolves a relationship target against the source part's directory
+func resolvePartTarget(dir, target string) string {
+	if strings.HasPrefix(target, "/") {
+		return strings.TrimPrefix(target, "/")
+	}
+	return path.Join(dir, target)
+}
+
+// readSharedStrings reads the shared string table. Phonetic runs are skipped.
+func readSharedStrings(zr *zip.Reader) ([]string, error) {
+	data, err := readZipPart(zr, "xl/sharedStrings.xml")
+	if err != nil || data == nil {
+		return nil, err
+	}
+
+	var shared []string
+	var current strings.Builder
+	inText, skipDepth := false, 0
+
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			return shared, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: shared strings: %v", ErrMalformed, err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch {
+			case skipDepth > 0 || t.Name.Local == "rPh":
+				skipDepth++
+			case t.Name.Local == "si":
+				current.Reset()
+			case t.Name.Local == "t":
+				inText = true
+			}
+		case xml.EndElement:
+			switch {
+			case skipDepth > 0:
+				skipDepth--
+			case t.Name.Local == "si":
+				shared = append(shared, current.String())
+			case t.Name.Local == "t":
+				inText = false
+			}
+		case xml.CharData:
+			if inText && skipDepth == 0 {
+				current.Write(t)
+			}
+		}
+	}
+}
+
+// extractWorksheet writes the non-empty rows of a worksheet as tab-separated lines
+func extractWorksheet(data []byte, shared []string, out *textBuilder) error {
+	var row []string
+	var value strings.Builder
+	cellType, inValue := "", false
+
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrMalformed, err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "row":
+				row = row[:0]
+			case "c":
+				cellType = ""
+				for _, a := range t.Attr {
+					if a.Name.Local == "t" {
+						cellType = a.Value
+					}
+				}
+				value.Reset()
+			case "v", "t":
+				inValue = true
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "v", "t":
+				inValue = false
+			case "c":
+				if text := cellText(cellType, strings.TrimSpace(value.String()), shared); text != "" {
+					row = append(row, text)
+				}
+			case "row":
+				if len(row) > 0 {
+					out.write(strings.Join(row, "\t"))
+					out.newline()
+				}
+			}
+		case xml.CharData:
+			if inValue {
+				value.Write(t)
+			}
+		}
+	}
+}
+
+// cellText resolves a cell's display text from its type and raw value
+func cellText(cellType, raw string, shared []string) string {
+	switch cellType {
+	case "s":
+		idx, err := strconv.Atoi(raw)
+		if err != nil || idx < 0 || idx >= len(shared) {
+			return ""
+		}
+		return strings.TrimSpace(shared[idx])
+	case "b":
+		switch raw {
+		case "1":
+			return "TRUE"
+		case "0":
+			return "FALSE"
+		}
+	}
+	return raw
+}
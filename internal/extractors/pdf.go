@@ -0,0 +1,58 @@
+package extractors
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Ensure PDFExtractor implements the interface
+var _ driven.ContentExtractor = (*PDFExtractor)(nil)
+
+// PDFExtractor extracts the text layer of PDF files.
+// Text is read from page content streams, using each font's ToUnicode CMap
+// where present. Scanned PDFs without a text layer yield no text, and
+// encrypted PDFs are rejected.
+type PDFExtractor struct{}
+
+// NewPDFExtractor creates a new PDF extractor
+func NewPDFExtractor() *PDFExtractor {
+	return &PDFExtractor{}
+}
+
+// SupportedTypes returns the MIME types this extractor handles
+func (e *PDFExtractor) SupportedTypes() []string {
+	return []string{MimeTypePDF}
+}
+
+// Extract returns the text of every page, separated by blank lines.
+// A file the parser cannot cope with is reported as malformed rather than
+// crashing the worker.
+func (e *PDFExtractor) Extract(ctx context.Context, data []byte, mimeType string) (text string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			text, err = "", fmt.Errorf("%w: %v", ErrMalformed, r)
+		}
+	}()
+
+	return extractPDF(ctx, data)
+}
+
+// extractPDF extracts the text of a PDF file
+func extractPDF(ctx context.Context, data []byte) (string, error) {
+	doc, err := parsePDF(data)
+	if err != nil {
+		return "", err
+	}
+
+	x := &pdfTextExtractor{doc: doc, fonts: make(map[pdfRef]*pdfFont)}
+	for _, page := range doc.pages() {
+		if err := x.page(ctx, page); err != nil {
+			return "", err
+		}
+		x.out.paragraph()
+	}
+
+	return cleanText(x.out.String()), nil
+}
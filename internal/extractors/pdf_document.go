@@ -0,0 +1,378 @@
+package extractors
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/ascii85"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// Limits guarding against malformed or hostile files
+const (
+	// maxDecodedStream bounds the decompressed size of a single stream
+	maxDecodedStream = 64 << 20
+
+	// maxRefDepth bounds reference chains and page-tree recursion
+	maxRefDepth = 32
+)
+
+var (
+	// pdfObjectHeader matches "N G obj" object headers
+	pdfObjectHeader = regexp.MustCompile(`(\d+)\s+(\d+)\s+obj\b`)
+
+	// pdfTrailer matches the start of a classic trailer dictionary
+	pdfTrailer = regexp.MustCompile(`trailer\s*<<`)
+)
+
+// pdfObject is an indirect object, with its raw stream data if it has one
+type pdfObject struct {
+	value  any
+	stream []byte // Still encoded; nil when the object is not a stream
+}
+
+// pdfDocument is an index of the indirect objects in a PDF file.
+// Objects are located by scanning rather than through the xref table,
+// which also copes with files whose xref offsets are wrong.
+type pdfDocument struct {
+	objects  map[int]*pdfObject
+	trailers []pdfDict // Trailer dictionaries and cross-reference stream dictionaries
+}
+
+// parsePDF indexes the objects of a PDF file
+func parsePDF(data []byte) (*pdfDocument, error) {
+	header := data
+	if len(header) > 1024 {
+		header = header[:1024]
+	}
+	if !bytes.Contains(header, []byte("%PDF-")) {
+		return nil, fmt.Errorf("%w: missing PDF header", ErrMalformed)
+	}
+
+	doc := &pdfDocument{objects: make(map[int]*pdfObject)}
+
+	skipUntil := 0
+	for _, m := range pdfObjectHeader.FindAllSubmatchIndex(data, -1) {
+		// Ignore matches inside stream data already consumed
+		if m[0] < skipUntil {
+			continue
+		}
+		num, _ := strconv.Atoi(string(data[m[2]:m[3]]))
+
+		l := &pdfLexer{data: data, pos: m[1]}
+		obj := &pdfObject{value: l.object()}
+
+		l.skipSpace()
+		if l.pos > len(data) {
+			// Truncated object; the lexer stopped past the end of the file
+			l.pos = len(data)
+		}
+		if bytes.HasPrefix(data[l.pos:], []byte("stream")) {
+			start := l.pos + len("stream")
+			if start < len(data) && data[start] == '\r' {
+				start++
+			}
+			if start < len(data) && data[start] == '\n' {
+				start++
+			}
+			end := streamEnd(data, start, obj.value)
+			obj.stream = data[start:end]
+			skipUntil = end
+		}
+
+		// Later definitions (incremental updates) replace earlier ones
+		doc.objects[num] = obj
+	}
+
+	for _, idx := range pdfTrailer.FindAllIndex(data, -1) {
+		l := &pdfLexer{data: data, pos: idx[0] + len("trailer")}
+		if d, ok := l.object().(pdfDict); ok {
+			doc.trailers = append(doc.trailers, d)
+		}
+	}
+
+	nums := doc.objectNumbers()
+	for _, num := range nums {
+		obj := doc.objects[num]
+		d, ok := obj.value.(pdfDict)
+		if !ok || obj.stream == nil {
+			continue
+		}
+		switch doc.name(d["Type"]) {
+		case "XRef":
+			doc.trailers = append(doc.trailers, d)
+		case "ObjStm":
+			doc.expandObjectStream(obj)
+		}
+	}
+
+	for _, t := range doc.trailers {
+		if _, ok := t["Encrypt"]; ok {
+			return nil, ErrEncrypted
+		}
+	}
+
+	return doc, nil
+}
+
+// streamEnd finds where stream data starting at start ends, preferring
+// a direct /Length and falling back to the endstream keyword
+func streamEnd(data []byte, start int, value any) int {
+	if d, ok := value.(pdfDict); ok {
+		if n, ok := d["Length"].(int64); ok && n >= 0 && start+int(n) <= len(data) {
+			rest := bytes.TrimLeft(data[start+int(n):], "\r\n \t")
+			if bytes.HasPrefix(rest, []byte("endstream")) {
+				return start + int(n)
+			}
+		}
+	}
+
+	idx := bytes.Index(data[start:], []byte("endstream"))
+	if idx < 0 {
+		return len(data)
+	}
+	end := start + idx
+	if end > start && data[end-1] == '\n' {
+		end--
+	}
+	if end > start && data[end-1] == '\r' {
+		end--
+	}
+	return end
+}
+
+// expandObjectStream adds the objects packed in an object stream.
+// Objects already defined directly in the file take precedence.
+func (d *pdfDocument) expandObjectStream(obj *pdfObject) {
+	dict := obj.value.(pdfDict)
+	n, _ := d.resolve(dict["N"]).(int64)
+	first, _ := d.resolve(dict["First"]).(int64)
+
+	data, err := d.decodeStream(obj)
+	if err != nil || first < 0 || int(first) > len(data) {
+		return
+	}
+
+	header := &pdfLexer{data: data[:first]}
+	for i := int64(0); i < n; i++ {
+		num, ok1 := header.next().(int64)
+		offset, ok2 := header.next().(int64)
+		if !ok1 || !ok2 {
+			return
+		}
+		if _, exists := d.objects[int(num)]; exists {
+			continue
+		}
+		pos := int(first + offset)
+		if offset < 0 || pos >= len(data) {
+			continue
+		}
+		l := &pdfLexer{data: data, pos: pos}
+		d.objects[int(num)] = &pdfObject{value: l.object()}
+	}
+}
+
+// objectNumbers returns the indexed object numbers in ascending order
+func (d *pdfDocument) objectNumbers() []int {
+	nums := make([]int, 0, len(d.objects))
+	for num := range d.objects {
+		nums = append(nums, num)
+	}
+	sort.Ints(nums)
+	return nums
+}
+
+// resolve follows indirect references to their values
+func (d *pdfDocument) resolve(v any) any {
+	for i := 0; i < maxRefDepth; i++ {
+		ref, ok := v.(pdfRef)
+		if !ok {
+			return v
+		}
+		obj, ok := d.objects[ref.num]
+		if !ok {
+			return nil
+		}
+		v = obj.value
+	}
+	return nil
+}
+
+// streamObject resolves v to a stream object
+func (d *pdfDocument) streamObject(v any) *pdfObject {
+	ref, ok := v.(pdfRef)
+	if !ok {
+		return nil
+	}
+	obj, ok := d.objects[ref.num]
+	if !ok || obj.stream == nil {
+		return nil
+	}
+	return obj
+}
+
+func (d *pdfDocument) dict(v any) pdfDict {
+	dict, _ := d.resolve(v).(pdfDict)
+	return dict
+}
+
+func (d *pdfDocument) name(v any) pdfName {
+	name, _ := d.resolve(v).(pdfName)
+	return name
+}
+
+// decodeStream applies the stream's filters. Only the general-purpose
+// filters used for text and fonts are supported; image codecs are not.
+func (d *pdfDocument) decodeStream(obj *pdfObject) ([]byte, error) {
+	dict, _ := obj.value.(pdfDict)
+
+	var filters []pdfName
+	switch f := d.resolve(dict["Filter"]).(type) {
+	case pdfName:
+		filters = []pdfName{f}
+	case pdfArray:
+		for _, v := range f {
+			filters = append(filters, d.name(v))
+		}
+	}
+
+	data := obj.stream
+	for _, filter := range filters {
+		var err error
+		switch filter {
+		case "FlateDecode", "Fl":
+			data, err = inflate(data)
+		case "ASCIIHexDecode", "AHx":
+			data, err = decodeASCIIHex(data)
+		case "ASCII85Decode", "A85":
+			data, err = decodeASCII85(data)
+		default:
+			return nil, fmt.Errorf("unsupported PDF filter %s", filter)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
+// inflate decompresses zlib data, keeping whatever was recovered from a
+// truncated stream
+func inflate(data []byte) ([]byte, error) {
+	r, err := zlib.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("flate: %w", err)
+	}
+	defer r.Close()
+
+	out, err := io.ReadAll(io.LimitReader(r, maxDecodedStream))
+	if err != nil && len(out) == 0 {
+		return nil, fmt.Errorf("flate: %w", err)
+	}
+	return out, nil
+}
+
+func decodeASCIIHex(data []byte) ([]byte, error) {
+	var digits []byte
+	for _, c := range data {
+		if c == '>' {
+			break
+		}
+		if !isPDFWhitespace(c) {
+			digits = append(digits, c)
+		}
+	}
+	if len(digits)%2 == 1 {
+		digits = append(digits, '0')
+	}
+	return hex.DecodeString(string(digits))
+}
+
+func decodeASCII85(data []byte) ([]byte, error) {
+	data = bytes.TrimSpace(data)
+	data = bytes.TrimPrefix(data, []byte("<~"))
+	if idx := bytes.Index(data, []byte("~>")); idx >= 0 {
+		data = data[:idx]
+	}
+	out := make([]byte, 4*len(data)/5+4)
+	n, _, err := ascii85.Decode(out, data, true)
+	if err != nil {
+		return nil, fmt.Errorf("ascii85: %w", err)
+	}
+	return out[:n], nil
+}
+
+// pdfPage is a page dictionary with its effective (possibly inherited) resources
+type pdfPage struct {
+	dict      pdfDict
+	resources pdfDict
+}
+
+// pages returns the pages in document order by walking the page tree.
+// Files without a usable catalog fall back to every /Page object in
+// object-number order.
+func (d *pdfDocument) pages() []pdfPage {
+	var root pdfDict
+	for i := len(d.trailers) - 1; i >= 0 && root == nil; i-- {
+		root = d.dict(d.trailers[i]["Root"])
+	}
+	if root == nil {
+		for _, num := range d.objectNumbers() {
+			if dict, ok := d.objects[num].value.(pdfDict); ok && d.name(dict["Type"]) == "Catalog" {
+				root = dict
+			}
+		}
+	}
+
+	var pages []pdfPage
+	if root != nil {
+		visited := make(map[int]bool)
+		d.walkPages(root["Pages"], nil, visited, &pages, 0)
+	}
+	if len(pages) > 0 {
+		return pages
+	}
+
+	for _, num := range d.objectNumbers() {
+		if dict, ok := d.objects[num].value.(pdfDict); ok && d.name(dict["Type"]) == "Page" {
+			pages = append(pages, pdfPage{dict: dict, resources: d.dict(dict["Resources"])})
+		}
+	}
+	return pages
+}
+
+func (d *pdfDocument) walkPages(node any, inherited pdfDict, visited map[int]bool, pages *[]pdfPage, depth int) {
+	if depth > maxRefDepth {
+		return
+	}
+	if ref, ok := node.(pdfRef); ok {
+		if visited[ref.num] {
+			return
+		}
+		visited[ref.num] = true
+	}
+
+	dict := d.dict(node)
+	if dict == nil {
+		return
+	}
+
+	resources := inherited
+	if r := d.dict(dict["Resources"]); r != nil {
+		resources = r
+	}
+
+	if d.name(dict["Type"]) == "Page" {
+		*pages = append(*pages, pdfPage{dict: dict, resources: resources})
+		return
+	}
+
+	kids, _ := d.resolve(dict["Kids"]).(pdfArray)
+	for _, kid := range kids {
+		d.walkPages(kid, resources, visited, pages, depth+1)
+	}
+}
@@ -0,0 +1,285 @@
+package extractors
+
+import (
+	"strconv"
+	"strings"
+)
+
+// PDF object model used by the lexer and parser.
+// Only the subset needed for text extraction is represented.
+type (
+	pdfName    string
+	pdfKeyword string
+	pdfString  string // Raw bytes; interpretation depends on the font
+	pdfDelim   string // "<<", ">>", "[", "]", "{", "}"
+	pdfDict    map[pdfName]any
+	pdfArray   []any
+	pdfRef     struct{ num, gen int }
+)
+
+// pdfLexer tokenises PDF object syntax and content streams
+type pdfLexer struct {
+	data []byte
+	pos  int
+}
+
+func isPDFWhitespace(c byte) bool {
+	return c == 0 || c == '\t' || c == '\n' || c == '\f' || c == '\r' || c == ' '
+}
+
+func isPDFDelimiter(c byte) bool {
+	return strings.IndexByte("()<>[]{}/%", c) >= 0
+}
+
+// skipSpace skips whitespace and comments
+func (l *pdfLexer) skipSpace() {
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		if isPDFWhitespace(c) {
+			l.pos++
+			continue
+		}
+		if c == '%' {
+			for l.pos < len(l.data) && l.data[l.pos] != '\n' && l.data[l.pos] != '\r' {
+				l.pos++
+			}
+			continue
+		}
+		return
+	}
+}
+
+// next returns the next token, or nil at the end of input.
+// Tokens are pdfName, pdfString, pdfDelim, int64, float64 or pdfKeyword.
+func (l *pdfLexer) next() any {
+	for {
+		l.skipSpace()
+		if l.pos >= len(l.data) {
+			return nil
+		}
+
+		c := l.data[l.pos]
+		switch c {
+		case '/':
+			return l.readName()
+		case '(':
+			return l.readLiteralString()
+		case '<':
+			if l.pos+1 < len(l.data) && l.data[l.pos+1] == '<' {
+				l.pos += 2
+				return pdfDelim("<<")
+			}
+			return l.readHexString()
+		case '>':
+			if l.pos+1 < len(l.data) && l.data[l.pos+1] == '>' {
+				l.pos += 2
+				return pdfDelim(">>")
+			}
+			l.pos++ // Stray delimiter
+			continue
+		case ')':
+			l.pos++ // Stray delimiter
+			continue
+		case '[', ']', '{', '}':
+			l.pos++
+			return pdfDelim(string(c))
+		}
+
+		start := l.pos
+		for l.pos < len(l.data) && !isPDFWhitespace(l.data[l.pos]) && !isPDFDelimiter(l.data[l.pos]) {
+			l.pos++
+		}
+		tok := string(l.data[start:l.pos])
+		if n, ok := parsePDFNumber(tok); ok {
+			return n
+		}
+		return pdfKeyword(tok)
+	}
+}
+
+// parsePDFNumber parses an integer (int64) or real (float64) token
+func parsePDFNumber(tok string) (any, bool) {
+	if tok == "" || strings.IndexByte("+-.0123456789", tok[0]) < 0 {
+		return nil, false
+	}
+	if n, err := strconv.ParseInt(tok, 10, 64); err == nil {
+		return n, true
+	}
+	if f, err := strconv.ParseFloat(tok, 64); err == nil {
+		return f, true
+	}
+	return nil, false
+}
+
+// readName reads a name token, decoding #xx escapes
+func (l *pdfLexer) readName() pdfName {
+	l.pos++ // Skip '/'
+	var b strings.Builder
+	for l.pos < len(l.data) && !isPDFWhitespace(l.data[l.pos]) && !isPDFDelimiter(l.data[l.pos]) {
+		c := l.data[l.pos]
+		if c == '#' && l.pos+2 < len(l.data) {
+			if v, err := strconv.ParseUint(string(l.data[l.pos+1:l.pos+3]), 16, 8); err == nil {
+				b.WriteByte(byte(v))
+				l.pos += 3
+				continue
+			}
+		}
+		b.WriteByte(c)
+		l.pos++
+	}
+	return pdfName(b.String())
+}
+
+// readLiteralString reads a (...) string with balanced parentheses and escapes
+func (l *pdfLexer) readLiteralString() pdfString {
+	l.pos++ // Skip '('
+	var b []byte
+	depth := 1
+
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		l.pos++
+
+		switch c {
+		case '(':
+			depth++
+			b = append(b, c)
+		case ')':
+			depth--
+			if depth == 0 {
+				return pdfString(b)
+			}
+			b = append(b, c)
+		case '\\':
+			if l.pos >= len(l.data) {
+				return pdfString(b)
+			}
+			e := l.data[l.pos]
+			l.pos++
+			switch e {
+			case 'n':
+				b = append(b, '\n')
+			case 'r':
+				b = append(b, '\r')
+			case 't':
+				b = append(b, '\t')
+			case 'b':
+				b = append(b, '\b')
+			case 'f':
+				b = append(b, '\f')
+			case '\r':
+				// Line continuation
+				if l.pos < len(l.data) && l.data[l.pos] == '\n' {
+					l.pos++
+				}
+			case '\n':
+				// Line continuation
+			default:
+				if e >= '0' && e <= '7' {
+					v := int(e - '0')
+					for i := 0; i < 2 && l.pos < len(l.data) && l.data[l.pos] >= '0' && l.data[l.pos] <= '7'; i++ {
+						v = v*8 + int(l.data[l.pos]-'0')
+						l.pos++
+					}
+					b = append(b, byte(v))
+				} else {
+					b = append(b, e)
+				}
+			}
+		default:
+			b = append(b, c)
+		}
+	}
+
+	return pdfString(b)
+}
+
+// readHexString reads a <...> hex string
+func (l *pdfLexer) readHexString() pdfString {
+	l.pos++ // Skip '<'
+	var digits []byte
+	for l.pos < len(l.data) && l.data[l.pos] != '>' {
+		c := l.data[l.pos]
+		if (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F') {
+			digits = append(digits, c)
+		}
+		l.pos++
+	}
+	l.pos++ // Skip '>'
+
+	if len(digits)%2 == 1 {
+		digits = append(digits, '0')
+	}
+	b := make([]byte, len(digits)/2)
+	for i := range b {
+		v, _ := strconv.ParseUint(string(digits[2*i:2*i+2]), 16, 8)
+		b[i] = byte(v)
+	}
+	return pdfString(b)
+}
+
+// object parses the next complete object
+func (l *pdfLexer) object() any {
+	return l.objectFrom(l.next())
+}
+
+// objectFrom completes an object whose first token has already been read.
+// Dictionaries and arrays are parsed recursively and "N G R" becomes a pdfRef.
+func (l *pdfLexer) objectFrom(tok any) any {
+	switch t := tok.(type) {
+	case pdfDelim:
+		switch t {
+		case "<<":
+			return l.dict()
+		case "[":
+			return l.array()
+		}
+		return t
+	case int64:
+		save := l.pos
+		if gen, ok := l.next().(int64); ok {
+			if kw, ok := l.next().(pdfKeyword); ok && kw == "R" {
+				return pdfRef{num: int(t), gen: int(gen)}
+			}
+		}
+		l.pos = save
+		return t
+	}
+	return tok
+}
+
+func (l *pdfLexer) dict() pdfDict {
+	d := pdfDict{}
+	for {
+		tok := l.next()
+		if tok == nil {
+			return d
+		}
+		if delim, ok := tok.(pdfDelim); ok && delim == ">>" {
+			return d
+		}
+		key, ok := tok.(pdfName)
+		if !ok {
+			continue
+		}
+		val := l.object()
+		if delim, ok := val.(pdfDelim); ok && delim == ">>" {
+			return d // Malformed: key without value
+		}
+		d[key] = val
+	}
+}
+
+func (l *pdfLexer) array() pdfArray {
+	var a pdfArray
+	for {
+		tok := l.next()
+		if tok == nil {
+			return a
+		}
+		if delim, ok := tok.(pdfDelim); ok && delim == "]" {
+			return a
+		}
+		a = append(a, l.objectFrom(tok))
+	}
+}
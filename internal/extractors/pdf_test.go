@@ -0,0 +1,191 @@
+package extractors
+
+import (
+	"bytes"
+	"compress/zlib"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// pdfBuilder assembles a minimal PDF from numbered object bodies
+type pdfBuilder struct {
+	objects []string
+	trailer string
+}
+
+func (b *pdfBuilder) add(body string) int {
+	b.objects = append(b.objects, body)
+	return len(b.objects)
+}
+
+// stream returns an object body for a stream, optionally Flate-compressed
+func pdfStream(dict, data string, compress bool) string {
+	if compress {
+		var buf bytes.Buffer
+		zw := zlib.NewWriter(&buf)
+		_, _ = zw.Write([]byte(data))
+		_ = zw.Close()
+		data = buf.String()
+		dict += " /Filter /FlateDecode"
+	}
+	return fmt.Sprintf("<< %s /Length %d >>\nstream\n%s\nendstream", dict, len(data), data)
+}
+
+func (b *pdfBuilder) bytes() []byte {
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.7\n%\xe2\xe3\xcf\xd3\n")
+	for i, body := range b.objects {
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, body)
+	}
+	fmt.Fprintf(&buf, "trailer\n%s\nstartxref\n0\n%%%%EOF\n", b.trailer)
+	return buf.Bytes()
+}
+
+// simplePDF builds a one-page PDF using a standard font and the given content stream
+func simplePDF(content string, compress bool) []byte {
+	b := &pdfBuilder{}
+	b.add("<< /Type /Catalog /Pages 2 0 R >>")
+	b.add("<< /Type /Pages /Kids [3 0 R] /Count 1 /Resources << /Font << /F1 5 0 R >> >> >>")
+	b.add("<< /Type /Page /Parent 2 0 R /Contents 4 0 R >>")
+	b.add(pdfStream("", content, compress))
+	b.add("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>")
+	b.trailer = "<< /Root 1 0 R /Size 6 >>"
+	return b.bytes()
+}
+
+func TestPDFExtractor_SimpleText(t *testing.T) {
+	content := `BT /F1 12 Tf 72 720 Td (Quarterly \(Q3\) report) Tj 0 -14 Td [(Rev) 30 (enue) -300 (grew)] TJ ET`
+
+	for _, compress := range []bool{false, true} {
+		text, err := NewPDFExtractor().Extract(context.Background(), simplePDF(content, compress), MimeTypePDF)
+		if err != nil {
+			t.Fatalf("compress=%v: unexpected error: %v", compress, err)
+		}
+		want := "Quarterly (Q3) report\nRevenue grew"
+		if text != want {
+			t.Errorf("compress=%v: expected %q, got %q", compress, want, text)
+		}
+	}
+}
+
+func TestPDFExtractor_ToUnicodeAndPageOrder(t *testing.T) {
+	cmap := `/CIDInit /ProcSet findresource begin
+12 dict begin
+begincmap
+1 begincodespacerange <0000> <FFFF> endcodespacerange
+2 beginbfchar
+<0001> <0048>
+<0002> <00E9>
+endbfchar
+1 beginbfrange
+<0010> <0012> <0061>
+endbfrange
+endcmap
+end end`
+
+	b := &pdfBuilder{}
+	b.add("<< /Type /Catalog /Pages 2 0 R >>")
+	// Kids are listed out of object order to check the page tree is followed
+	b.add("<< /Type /Pages /Kids [4 0 R 3 0 R] /Count 2 >>")
+	b.add("<< /Type /Page /Parent 2 0 R /Resources << /Font << /F1 6 0 R >> >> /Contents 5 0 R >>")
+	b.add("<< /Type /Page /Parent 2 0 R /Resources << /Font << /F1 6 0 R >> >> /Contents 8 0 R >>")
+	b.add(pdfStream("", "BT /F1 10 Tf <0001000200100011> Tj ET", true))
+	b.add("<< /Type /Font /Subtype /Type0 /BaseFont /Foo /Encoding /Identity-H /ToUnicode 7 0 R >>")
+	b.add(pdfStream("", cmap, true))
+	b.add(pdfStream("", "BT /F1 10 Tf <0012> Tj ET", false))
+	b.trailer = "<< /Root 1 0 R /Size 9 >>"
+
+	text, err := NewPDFExtractor().Extract(context.Background(), b.bytes(), MimeTypePDF)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if text != "c\n\nHéab" {
+		t.Errorf("expected pages in tree order decoded via ToUnicode, got %q", text)
+	}
+}
+
+func TestPDFExtractor_FormXObject(t *testing.T) {
+	b := &pdfBuilder{}
+	b.add("<< /Type /Catalog /Pages 2 0 R >>")
+	b.add("<< /Type /Pages /Kids [3 0 R] /Count 1 >>")
+	b.add("<< /Type /Page /Parent 2 0 R /Resources << /Font << /F1 5 0 R >> /XObject << /X1 6 0 R >> >> /Contents 4 0 R >>")
+	b.add(pdfStream("", "BT /F1 12 Tf (Header) Tj ET /X1 Do", false))
+	b.add("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>")
+	b.add(pdfStream("/Type /XObject /Subtype /Form", "BT /F1 12 Tf 0 -20 Td (Footer) Tj ET", false))
+	b.trailer = "<< /Root 1 0 R >>"
+
+	text, err := NewPDFExtractor().Extract(context.Background(), b.bytes(), MimeTypePDF)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(text, "Header") || !strings.Contains(text, "Footer") {
+		t.Errorf("expected page and form text, got %q", text)
+	}
+}
+
+func TestPDFExtractor_Encrypted(t *testing.T) {
+	b := &pdfBuilder{}
+	b.add("<< /Type /Catalog /Pages 2 0 R >>")
+	b.add("<< /Type /Pages /Kids [] /Count 0 >>")
+	b.add("<< /Filter /Standard /V 2 >>")
+	b.trailer = "<< /Root 1 0 R /Encrypt 3 0 R >>"
+
+	_, err := NewPDFExtractor().Extract(context.Background(), b.bytes(), MimeTypePDF)
+	if !errors.Is(err, ErrEncrypted) {
+		t.Errorf("expected ErrEncrypted, got %v", err)
+	}
+}
+
+func TestPDFExtractor_NotAPDF(t *testing.T) {
+	_, err := NewPDFExtractor().Extract(context.Background(), []byte("hello world"), MimeTypePDF)
+	if !errors.Is(err, ErrMalformed) {
+		t.Errorf("expected ErrMalformed, got %v", err)
+	}
+}
+
+func TestPDFLexer_Objects(t *testing.T) {
+	l := &pdfLexer{data: []byte(`<< /Kids [1 0 R 2 0 R] /Name /A#20B /Str (a\051b\\) /Hex <4142 4> /N -1.5 >>`)}
+	d, ok := l.object().(pdfDict)
+	if !ok {
+		t.Fatal("expected dictionary")
+	}
+
+	kids, _ := d["Kids"].(pdfArray)
+	if len(kids) != 2 || kids[1] != (pdfRef{num: 2}) {
+		t.Errorf("expected two references, got %#v", d["Kids"])
+	}
+	if d["Name"] != pdfName("A B") {
+		t.Errorf("expected escaped name, got %#v", d["Name"])
+	}
+	if d["Str"] != pdfString(`a)b\`) {
+		t.Errorf("expected escaped string, got %#v", d["Str"])
+	}
+	if d["Hex"] != pdfString("AB@") {
+		t.Errorf("expected hex string padded with zero, got %#v", d["Hex"])
+	}
+	if d["N"] != -1.5 {
+		t.Errorf("expected real number, got %#v", d["N"])
+	}
+}
+
+func TestPDFExtractor_Truncated(t *testing.T) {
+	_, err := NewPDFExtractor().Extract(context.Background(), []byte("%PDF-0 0 obj<"), MimeTypePDF)
+	if err != nil && !errors.Is(err, ErrMalformed) {
+		t.Errorf("expected no error or ErrMalformed, got %v", err)
+	}
+}
+
+// FuzzPDF checks that no input panics the parser or text extraction.
+// extractPDF is fuzzed rather than Extract, which recovers from panics.
+func FuzzPDF(f *testing.F) {
+	f.Add([]byte("%PDF-0 0 obj<"))
+	f.Add(simplePDF("BT /F1 12 Tf (Hello) Tj ET", false))
+	f.Add(simplePDF("BT /F1 12 Tf (Hello) Tj ET", true))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, _ = extractPDF(context.Background(), data)
+	})
+}
@@ -0,0 +1,416 @@
+package extractors
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"strings"
+	"unicode/utf16"
+)
+
+const (
+	// maxXObjectDepth bounds nesting of form XObjects
+	maxXObjectDepth = 8
+
+	// maxCMapRange bounds the number of codes a single bfrange entry expands to
+	maxCMapRange = 1 << 16
+
+	// tjSpaceThreshold is the TJ displacement (thousandths of an em) treated as a word gap
+	tjSpaceThreshold = -200
+)
+
+// pdfFont decodes shown strings to text
+type pdfFont struct {
+	toUnicode map[string]string // Code bytes to text, from the font's ToUnicode CMap
+	codeLens  []int             // Code lengths in bytes, longest first
+	composite bool              // Type0 fonts use multi-byte codes
+}
+
+// decode converts the bytes of a shown string to text.
+// Simple fonts without a ToUnicode CMap are assumed to use WinAnsiEncoding;
+// composite fonts without one cannot be decoded and yield nothing.
+func (f *pdfFont) decode(s pdfString) string {
+	if f == nil || len(f.toUnicode) == 0 {
+		if f != nil && f.composite {
+			return ""
+		}
+		return decodeWinAnsi(string(s))
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		matched := false
+		for _, n := range f.codeLens {
+			if i+n > len(s) {
+				continue
+			}
+			if text, ok := f.toUnicode[string(s[i:i+n])]; ok {
+				b.WriteString(text)
+				i += n
+				matched = true
+				break
+			}
+		}
+		if matched {
+			continue
+		}
+		if f.composite {
+			i += f.codeLens[len(f.codeLens)-1]
+		} else {
+			b.WriteString(decodeWinAnsi(string(s[i])))
+			i++
+		}
+	}
+	return b.String()
+}
+
+// winAnsiHigh maps the WinAnsiEncoding bytes 0x80-0x9F that differ from Latin-1
+var winAnsiHigh = map[byte]rune{
+	0x80: '€', 0x82: '‚', 0x83: 'ƒ', 0x84: '„', 0x85: '…', 0x86: '†', 0x87: '‡',
+	0x88: 'ˆ', 0x89: '‰', 0x8A: 'Š', 0x8B: '‹', 0x8C: 'Œ', 0x8E: 'Ž',
+	0x91: '‘', 0x92: '’', 0x93: '“', 0x94: '”', 0x95: '•', 0x96: '–', 0x97: '—',
+	0x98: '˜', 0x99: '™', 0x9A: 'š', 0x9B: '›', 0x9C: 'œ', 0x9E: 'ž', 0x9F: 'Ÿ',
+}
+
+func decodeWinAnsi(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if r, ok := winAnsiHigh[c]; ok {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune(rune(c))
+		}
+	}
+	return b.String()
+}
+
+// decodeUTF16BE decodes a big-endian UTF-16 string as used in CMap destinations
+func decodeUTF16BE(s pdfString) string {
+	if len(s)%2 == 1 {
+		s += "\x00"
+	}
+	units := make([]uint16, len(s)/2)
+	for i := range units {
+		units[i] = uint16(s[2*i])<<8 | uint16(s[2*i+1])
+	}
+	return string(utf16.Decode(units))
+}
+
+// parseToUnicode reads the bfchar and bfrange mappings of a ToUnicode CMap
+func parseToUnicode(data []byte) (map[string]string, []int) {
+	mapping := make(map[string]string)
+	lens := make(map[int]bool)
+
+	l := &pdfLexer{data: data}
+	var operands []any
+	for tok := l.next(); tok != nil; tok = l.next() {
+		kw, ok := tok.(pdfKeyword)
+		if !ok {
+			operands = append(operands, l.objectFrom(tok))
+			continue
+		}
+
+		switch kw {
+		case "endcodespacerange":
+			for i := 0; i+1 < len(operands); i += 2 {
+				if lo, ok := operands[i].(pdfString); ok && len(lo) > 0 {
+					lens[len(lo)] = true
+				}
+			}
+		case "endbfchar":
+			for i := 0; i+1 < len(operands); i += 2 {
+				src, ok1 := operands[i].(pdfString)
+				dst, ok2 := operands[i+1].(pdfString)
+				if ok1 && ok2 {
+					mapping[string(src)] = decodeUTF16BE(dst)
+					lens[len(src)] = true
+				}
+			}
+		case "endbfrange":
+			for i := 0; i+2 < len(operands); i += 3 {
+				lo, ok1 := operands[i].(pdfString)
+				hi, ok2 := operands[i+1].(pdfString)
+				if !ok1 || !ok2 || len(lo) == 0 || len(lo) > 4 || len(lo) != len(hi) {
+					continue
+				}
+				addBFRange(mapping, lo, hi, operands[i+2])
+				lens[len(lo)] = true
+			}
+		}
+		operands = operands[:0]
+	}
+
+	codeLens := make([]int, 0, len(lens))
+	for n := range lens {
+		codeLens = append(codeLens, n)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(codeLens)))
+	return mapping, codeLens
+}
+
+// addBFRange expands one bfrange entry. The destination is either a base
+// string whose last code unit is incremented across the range, or an array
+// with one destination per code.
+func addBFRange(mapping map[string]string, lo, hi pdfString, dst any) {
+	start, end := codeValue(lo), codeValue(hi)
+	if end < start || end-start >= maxCMapRange {
+		return
+	}
+
+	for code := start; code <= end; code++ {
+		key := codeBytes(code, len(lo))
+		offset := code - start
+
+		switch d := dst.(type) {
+		case pdfString:
+			if len(d) < 2 {
+				continue
+			}
+			b := []byte(d)
+			last := uint32(b[len(b)-2])<<8 | uint32(b[len(b)-1])
+			last += offset
+			b[len(b)-2], b[len(b)-1] = byte(last>>8), byte(last)
+			mapping[key] = decodeUTF16BE(pdfString(b))
+		case pdfArray:
+			if int(offset) < len(d) {
+				if s, ok := d[offset].(pdfString); ok {
+					mapping[key] = decodeUTF16BE(s)
+				}
+			}
+		}
+	}
+}
+
+func codeValue(s pdfString) uint32 {
+	var v uint32
+	for i := 0; i < len(s); i++ {
+		v = v<<8 | uint32(s[i])
+	}
+	return v
+}
+
+func codeBytes(v uint32, n int) string {
+	b := make([]byte, n)
+	for i := n - 1; i >= 0; i-- {
+		b[i] = byte(v)
+		v >>= 8
+	}
+	return string(b)
+}
+
+// pdfTextExtractor walks page content streams and writes the shown text
+type pdfTextExtractor struct {
+	doc   *pdfDocument
+	out   textBuilder
+	fonts map[pdfRef]*pdfFont
+}
+
+// page extracts the text of one page
+func (x *pdfTextExtractor) page(ctx context.Context, page pdfPage) error {
+	var content bytes.Buffer
+	switch c := page.dict["Contents"].(type) {
+	case pdfRef:
+		if arr, ok := x.doc.resolve(c).(pdfArray); ok {
+			x.appendStreams(&content, arr)
+		} else {
+			x.appendStreams(&content, pdfArray{c})
+		}
+	case pdfArray:
+		x.appendStreams(&content, c)
+	}
+
+	visited := make(map[int]bool)
+	x.content(ctx, content.Bytes(), page.resources, visited, 0)
+	return ctx.Err()
+}
+
+// appendStreams decodes content streams, skipping any that cannot be decoded
+func (x *pdfTextExtractor) appendStreams(buf *bytes.Buffer, refs pdfArray) {
+	for _, ref := range refs {
+		obj := x.doc.streamObject(ref)
+		if obj == nil {
+			continue
+		}
+		data, err := x.doc.decodeStream(obj)
+		if err != nil {
+			continue
+		}
+		buf.Write(data)
+		buf.WriteByte('\n')
+	}
+}
+
+// content interprets the text operators of a content stream. Positioning
+// operators only decide between word and line breaks; layout is not rebuilt.
+func (x *pdfTextExtractor) content(ctx context.Context, data []byte, resources pdfDict, visited map[int]bool, depth int) {
+	l := &pdfLexer{data: data}
+	var operands []any
+	var font *pdfFont
+	lastY, haveY := 0.0, false
+
+	for tok := l.next(); tok != nil; tok = l.next() {
+		kw, ok := tok.(pdfKeyword)
+		if !ok {
+			operands = append(operands, l.objectFrom(tok))
+			continue
+		}
+
+		switch kw {
+		case "ET":
+			x.out.space()
+		case "Tf":
+			if len(operands) >= 2 {
+				if name, ok := operands[len(operands)-2].(pdfName); ok {
+					font = x.font(resources, name)
+				}
+			}
+		case "Tj":
+			x.show(font, operands)
+		case "'", "\"":
+			x.out.newline()
+			x.show(font, operands)
+		case "TJ":
+			if len(operands) > 0 {
+				arr, _ := operands[len(operands)-1].(pdfArray)
+				for _, item := range arr {
+					switch v := item.(type) {
+					case pdfString:
+						x.out.write(font.decode(v))
+					case int64, float64:
+						if number(v) < tjSpaceThreshold {
+							x.out.space()
+						}
+					}
+				}
+			}
+		case "Td", "TD":
+			if len(operands) >= 2 && number(operands[len(operands)-1]) != 0 {
+				x.out.newline()
+			} else {
+				x.out.space()
+			}
+		case "T*":
+			x.out.newline()
+		case "Tm":
+			if len(operands) >= 6 {
+				y := number(operands[len(operands)-1])
+				if haveY && y != lastY {
+					x.out.newline()
+				} else {
+					x.out.space()
+				}
+				lastY, haveY = y, true
+			}
+		case "Do":
+			if len(operands) > 0 && depth < maxXObjectDepth && ctx.Err() == nil {
+				if name, ok := operands[len(operands)-1].(pdfName); ok {
+					x.xobject(ctx, resources, name, visited, depth)
+				}
+			}
+		case "ID":
+			l.pos = skipInlineImage(data, l.pos)
+		}
+		operands = operands[:0]
+	}
+}
+
+// show writes the string operand of Tj, ' and "
+func (x *pdfTextExtractor) show(font *pdfFont, operands []any) {
+	if len(operands) == 0 {
+		return
+	}
+	if s, ok := operands[len(operands)-1].(pdfString); ok {
+		x.out.write(font.decode(s))
+	}
+}
+
+// xobject extracts text from a form XObject drawn with Do
+func (x *pdfTextExtractor) xobject(ctx context.Context, resources pdfDict, name pdfName, visited map[int]bool, depth int) {
+	xobjects := x.doc.dict(resources["XObject"])
+	ref, ok := xobjects[name].(pdfRef)
+	if !ok || visited[ref.num] {
+		return
+	}
+	visited[ref.num] = true
+
+	obj := x.doc.streamObject(ref)
+	if obj == nil {
+		return
+	}
+	dict, _ := obj.value.(pdfDict)
+	if x.doc.name(dict["Subtype"]) != "Form" {
+		return
+	}
+	data, err := x.doc.decodeStream(obj)
+	if err != nil {
+		return
+	}
+
+	formResources := resources
+	if r := x.doc.dict(dict["Resources"]); r != nil {
+		formResources = r
+	}
+	x.content(ctx, data, formResources, visited, depth+1)
+}
+
+// font loads (and caches) the named font from a resource dictionary
+func (x *pdfTextExtractor) font(resources pdfDict, name pdfName) *pdfFont {
+	fonts := x.doc.dict(resources["Font"])
+	raw, ok := fonts[name]
+	if !ok {
+		return nil
+	}
+
+	// Only indirect fonts are cached; direct font dictionaries are rare
+	ref, isRef := raw.(pdfRef)
+	if f, ok := x.fonts[ref]; isRef && ok {
+		return f
+	}
+
+	dict := x.doc.dict(raw)
+	f := &pdfFont{composite: x.doc.name(dict["Subtype"]) == "Type0"}
+	if obj := x.doc.streamObject(dict["ToUnicode"]); obj != nil {
+		if data, err := x.doc.decodeStream(obj); err == nil {
+			f.toUnicode, f.codeLens = parseToUnicode(data)
+		}
+	}
+	if len(f.codeLens) == 0 {
+		if f.composite {
+			f.codeLens = []int{2}
+		} else {
+			f.codeLens = []int{1}
+		}
+	}
+
+	if isRef {
+		x.fonts[ref] = f
+	}
+	return f
+}
+
+// skipInlineImage returns the position after the EI that ends inline image data
+func skipInlineImage(data []byte, pos int) int {
+	for i := pos; i+2 <= len(data); i++ {
+		if data[i] != 'E' || data[i+1] != 'I' {
+			continue
+		}
+		before := i == 0 || isPDFWhitespace(data[i-1])
+		after := i+2 == len(data) || isPDFWhitespace(data[i+2])
+		if before && after {
+			return i + 2
+		}
+	}
+	return len(data)
+}
+
+// number converts a numeric operand to float64
+func number(v any) float64 {
+	switch n := v.(type) {
+	case int64:
+		return float64(n)
+	case float64:
+		return n
+	}
+	return 0
+}
@@ -0,0 +1,121 @@
+// Package extractors converts binary document formats (PDF, Office Open XML
+// and OpenDocument) to plain text before normalisation. All extractors are
+// pure Go and read only the parts of a file that carry text.
+package extractors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
+)
+
+// Verify interface compliance
+var _ driven.ContentExtractor = (*Registry)(nil)
+
+// MIME types handled by the built-in extractors
+const (
+	MimeTypePDF  = "application/pdf"
+	MimeTypeDOCX = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+	MimeTypeXLSX = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	MimeTypePPTX = "application/vnd.openxmlformats-officedocument.presentationml.presentation"
+	MimeTypeODT  = "application/vnd.oasis.opendocument.text"
+	MimeTypeODS  = "application/vnd.oasis.opendocument.spreadsheet"
+	MimeTypeODP  = "application/vnd.oasis.opendocument.presentation"
+)
+
+var (
+	// ErrUnsupportedType is returned when no extractor handles a MIME type
+	ErrUnsupportedType = errors.New("unsupported content type")
+
+	// ErrMalformed is returned when a file cannot be parsed as its declared type
+	ErrMalformed = errors.New("malformed document")
+
+	// ErrEncrypted is returned for password-protected documents
+	ErrEncrypted = errors.New("document is encrypted")
+)
+
+// Registry implements ContentExtractor by dispatching to the extractor
+// registered for a MIME type. It lets the sync pipeline depend on a single
+// extractor regardless of how many formats are supported.
+type Registry struct {
+	mu         sync.RWMutex
+	extractors map[string]driven.ContentExtractor
+}
+
+// NewRegistry creates a new, empty extractor registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		extractors: make(map[string]driven.ContentExtractor),
+	}
+}
+
+// Register registers an extractor for each of its supported types.
+// A later registration for the same type replaces the earlier one.
+func (r *Registry) Register(extractor driven.ContentExtractor) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, t := range extractor.SupportedTypes() {
+		r.extractors[normaliseMIMEType(t)] = extractor
+	}
+}
+
+// Get retrieves the extractor for a MIME type.
+// Returns nil if no extractor is registered for the type.
+func (r *Registry) Get(mimeType string) driven.ContentExtractor {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.extractors[normaliseMIMEType(mimeType)]
+}
+
+// Extract extracts text using the extractor registered for mimeType.
+// Returns ErrUnsupportedType if there is none.
+func (r *Registry) Extract(ctx context.Context, data []byte, mimeType string) (string, error) {
+	extractor := r.Get(mimeType)
+	if extractor == nil {
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedType, mimeType)
+	}
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	return extractor.Extract(ctx, data, mimeType)
+}
+
+// SupportedTypes returns all registered MIME types, sorted.
+func (r *Registry) SupportedTypes() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	types := make([]string, 0, len(r.extractors))
+	for t := range r.extractors {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// normaliseMIMEType lowercases a MIME type and strips its parameters
+func normaliseMIMEType(mimeType string) string {
+	mimeType = strings.ToLower(strings.TrimSpace(mimeType))
+	if idx := strings.Index(mimeType, ";"); idx != -1 {
+		mimeType = strings.TrimSpace(mimeType[:idx])
+	}
+	return mimeType
+}
+
+// DefaultRegistry creates a registry with the built-in extractors registered.
+func DefaultRegistry() *Registry {
+	r := NewRegistry()
+
+	r.Register(NewPDFExtractor())
+	r.Register(NewOOXMLExtractor())
+	r.Register(NewODFExtractor())
+
+	return r
+}
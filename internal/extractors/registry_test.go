@@ -0,0 +1,60 @@
+package extractors
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// stubExtractor returns fixed text for its types
+type stubExtractor struct {
+	types []string
+	text  string
+}
+
+func (s *stubExtractor) Extract(ctx context.Context, data []byte, mimeType string) (string, error) {
+	return s.text, nil
+}
+
+func (s *stubExtractor) SupportedTypes() []string {
+	return s.types
+}
+
+func TestRegistry_Dispatch(t *testing.T) {
+	r := NewRegistry()
+	r.Register(&stubExtractor{types: []string{"application/x-one"}, text: "one"})
+	r.Register(&stubExtractor{types: []string{"application/x-two"}, text: "two"})
+
+	text, err := r.Extract(context.Background(), nil, "Application/X-Two; charset=binary")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if text != "two" {
+		t.Errorf("expected dispatch to be case- and parameter-insensitive, got %q", text)
+	}
+}
+
+func TestRegistry_Unsupported(t *testing.T) {
+	r := NewRegistry()
+
+	_, err := r.Extract(context.Background(), nil, "image/png")
+	if !errors.Is(err, ErrUnsupportedType) {
+		t.Errorf("expected ErrUnsupportedType, got %v", err)
+	}
+	if r.Get("image/png") != nil {
+		t.Error("expected no extractor for unregistered type")
+	}
+}
+
+func TestDefaultRegistry(t *testing.T) {
+	r := DefaultRegistry()
+
+	for _, mimeType := range []string{MimeTypePDF, MimeTypeDOCX, MimeTypeXLSX, MimeTypePPTX, MimeTypeODT, MimeTypeODS, MimeTypeODP} {
+		if r.Get(mimeType) == nil {
+			t.Errorf("expected default extractor for %s", mimeType)
+		}
+	}
+	if len(r.SupportedTypes()) != 7 {
+		t.Errorf("expected 7 supported types, got %v", r.SupportedTypes())
+	}
+}
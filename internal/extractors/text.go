@@ -0,0 +1,94 @@
+package extractors
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// textBuilder accumulates extracted text. Word separators are deferred
+// until more text follows, so they never end up before a tab, a line break
+// or the end of the text.
+type textBuilder struct {
+	b            strings.Builder
+	last         rune
+	pendingSpace bool
+}
+
+func (t *textBuilder) write(s string) {
+	if s == "" {
+		return
+	}
+	first, _ := utf8.DecodeRuneInString(s)
+	if t.pendingSpace && !unicode.IsSpace(first) {
+		t.b.WriteByte(' ')
+	}
+	t.pendingSpace = false
+
+	t.b.WriteString(s)
+	t.last, _ = utf8.DecodeLastRuneInString(s)
+}
+
+// space separates words unless a separator was just written
+func (t *textBuilder) space() {
+	if t.b.Len() == 0 || unicode.IsSpace(t.last) {
+		return
+	}
+	t.pendingSpace = true
+}
+
+// newline ends the current line unless it is already empty
+func (t *textBuilder) newline() {
+	if t.b.Len() == 0 || t.last == '\n' {
+		return
+	}
+	t.write("\n")
+}
+
+// paragraph separates blocks such as pages or slides with a blank line
+func (t *textBuilder) paragraph() {
+	if t.b.Len() == 0 {
+		return
+	}
+	t.newline()
+	t.write("\n")
+}
+
+func (t *textBuilder) String() string {
+	return t.b.String()
+}
+
+// cleanText normalises extracted text: line endings are unified, control
+// characters dropped, trailing whitespace trimmed from each line and runs
+// of blank lines collapsed to one
+func cleanText(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	s = strings.ReplaceAll(s, "\r", "\n")
+	s = strings.Map(func(r rune) rune {
+		if r == '\n' || r == '\t' {
+			return r
+		}
+		if r == unicode.ReplacementChar || unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, s)
+
+	lines := strings.Split(s, "\n")
+	out := make([]string, 0, len(lines))
+	blank := false
+	for _, line := range lines {
+		line = strings.TrimRightFunc(line, unicode.IsSpace)
+		if line == "" {
+			if !blank && len(out) > 0 {
+				out = append(out, "")
+			}
+			blank = true
+			continue
+		}
+		out = append(out, line)
+		blank = false
+	}
+
+	return strings.TrimSpace(strings.Join(out, "\n"))
+}
@@ -0,0 +1,164 @@
+package extractors
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxZipPartSize bounds the uncompressed size of a single archive part,
+// protecting against zip bombs
+const maxZipPartSize = 64 << 20
+
+// partNumber matches the trailing number in part names such as slide12.xml
+var partNumber = regexp.MustCompile(`(\d+)\.xml$`)
+
+// openZip opens an OOXML or ODF package
+func openZip(data []byte) (*zip.Reader, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformed, err)
+	}
+	return zr, nil
+}
+
+// readZipPart reads a part by name, returning nil if it does not exist
+func readZipPart(zr *zip.Reader, name string) ([]byte, error) {
+	for _, f := range zr.File {
+		if f.Name != name {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, fmt.Errorf("open %s: %w", name, err)
+		}
+		defer rc.Close()
+
+		data, err := io.ReadAll(io.LimitReader(rc, maxZipPartSize+1))
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", name, err)
+		}
+		if len(data) > maxZipPartSize {
+			return nil, fmt.Errorf("%w: %s exceeds %d bytes", ErrMalformed, name, maxZipPartSize)
+		}
+		return data, nil
+	}
+	return nil, nil
+}
+
+// zipPartsIn returns the XML parts directly inside dir, ordered by the
+// number in their name so that slide10.xml follows slide9.xml
+func zipPartsIn(zr *zip.Reader, dir, prefix string) []string {
+	var names []string
+	for _, f := range zr.File {
+		if path.Dir(f.Name) == dir && strings.HasPrefix(path.Base(f.Name), prefix) && strings.HasSuffix(f.Name, ".xml") {
+			names = append(names, f.Name)
+		}
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return partIndex(names[i]) < partIndex(names[j])
+	})
+	return names
+}
+
+func partIndex(name string) int {
+	m := partNumber.FindStringSubmatch(name)
+	if m == nil {
+		return 0
+	}
+	n, _ := strconv.Atoi(m[1])
+	return n
+}
+
+// xmlTextRules describes how an XML part maps to plain text.
+// Elements are matched by local name, so namespace prefixes do not matter.
+type xmlTextRules struct {
+	// keep lists the elements whose character data is text; nil keeps all
+	keep map[string]bool
+
+	// skip lists elements whose whole subtree is ignored
+	skip map[string]bool
+
+	// start returns text to write when an element opens, such as a tab
+	start func(el xml.StartElement) string
+
+	// end maps elements to the separator written when they close
+	end map[string]string
+
+	// cells lists table cell elements. Line separators inside a cell are
+	// written as spaces so a row stays on one line.
+	cells map[string]bool
+}
+
+// extractXMLText writes the text of an XML part to out according to rules
+func extractXMLText(data []byte, rules xmlTextRules, out *textBuilder) error {
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	keepDepth, skipDepth, cellDepth := 0, 0, 0
+
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrMalformed, err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			name := t.Name.Local
+			if skipDepth > 0 || rules.skip[name] {
+				skipDepth++
+				continue
+			}
+			if rules.keep[name] {
+				keepDepth++
+			}
+			if rules.cells[name] {
+				cellDepth++
+			}
+			if rules.start != nil {
+				writeSeparator(out, rules.start(t), cellDepth > 0)
+			}
+		case xml.EndElement:
+			name := t.Name.Local
+			if skipDepth > 0 {
+				skipDepth--
+				continue
+			}
+			if rules.keep[name] {
+				keepDepth--
+			}
+			if rules.cells[name] {
+				cellDepth--
+			}
+			writeSeparator(out, rules.end[name], cellDepth > 0)
+		case xml.CharData:
+			if skipDepth == 0 && (rules.keep == nil || keepDepth > 0) {
+				out.write(string(t))
+			}
+		}
+	}
+}
+
+// writeSeparator writes a structural separator, folding line breaks to
+// spaces inside table cells
+func writeSeparator(out *textBuilder, sep string, inCell bool) {
+	switch {
+	case sep == "":
+	case sep == "\n" && inCell:
+		out.space()
+	case sep == "\n":
+		out.newline()
+	default:
+		out.write(sep)
+	}
+}
@@ -134,6 +134,8 @@ func DefaultRegistry() *Registry {
 	// Register connector-specific normalisers (high priority)
 	r.Register(&GitHubIssueNormaliser{})
 	r.Register(&GitHubPRNormaliser{})
+	r.Register(&ChannelMessageNormaliser{})
+	r.Register(&ConfluenceStorageNormaliser{})
 
 	return r
 }
@@ -291,7 +293,7 @@ func decodeHTMLEntities(content string) string {
 	return content
 }
 
-// GitHubIssueNormaliser handles GitHub issue content.
+// GitHubIssueNormaliser handles GitHub and GitLab issue content.
 // It preserves Markdown formatting while cleaning up issue-specific artifacts.
 type GitHubIssueNormaliser struct{}
 
@@ -319,14 +321,14 @@ func (n *GitHubIssueNormaliser) Normalise(content string, mimeType string) strin
 }
 
 func (n *GitHubIssueNormaliser) SupportedTypes() []string {
-	return []string{"application/x-github-issue"}
+	return []string{"application/x-github-issue", "application/x-gitlab-issue"}
 }
 
 func (n *GitHubIssueNormaliser) Priority() int {
 	return 90 // High priority - connector-specific
 }
 
-// GitHubPRNormaliser handles GitHub pull request content.
+// GitHubPRNormaliser handles GitHub pull request and GitLab merge request content.
 // It preserves Markdown formatting while cleaning up PR-specific artifacts.
 type GitHubPRNormaliser struct{}
 
@@ -347,13 +349,297 @@ func (n *GitHubPRNormaliser) Normalise(content string, mimeType string) string {
 }
 
 func (n *GitHubPRNormaliser) SupportedTypes() []string {
-	return []string{"application/x-github-pr"}
+	return []string{"application/x-github-pr", "application/x-gitlab-mr"}
 }
 
 func (n *GitHubPRNormaliser) Priority() int {
 	return 90 // High priority - connector-specific
 }
 
+// ChannelMessageNormaliser handles chat messages, such as Slack threads.
+// It turns the message markup into plain text, resolving user and channel
+// mentions to the names their markup is labelled with.
+type ChannelMessageNormaliser struct{}
+
+func (n *ChannelMessageNormaliser) Normalise(content string, mimeType string) string {
+	// Normalize line endings
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	content = strings.ReplaceAll(content, "\r", "\n")
+
+	content = formatMessageMarkup(content)
+
+	// Messages escape only these characters; &amp; is decoded last so
+	// that escaped entities stay literal
+	content = strings.ReplaceAll(content, "&lt;", "<")
+	content = strings.ReplaceAll(content, "&gt;", ">")
+	content = strings.ReplaceAll(content, "&amp;", "&")
+
+	// Remove excessive blank lines
+	for strings.Contains(content, "\n\n\n") {
+		content = strings.ReplaceAll(content, "\n\n\n", "\n\n")
+	}
+
+	return strings.TrimSpace(content)
+}
+
+func (n *ChannelMessageNormaliser) SupportedTypes() []string {
+	return []string{"application/x-slack-message"}
+}
+
+func (n *ChannelMessageNormaliser) Priority() int {
+	return 90 // High priority - connector-specific
+}
+
+// formatMessageMarkup replaces the "<...>" markup of a chat message with
+// text: user mentions ("<@U123|ann>") become "@ann", channel mentions
+// ("<#C123|general>") "#general", special mentions ("<!here>") "@here",
+// and links ("<https://example.com|example>") "example (https://example.com)".
+// Mentions without a label keep their ID.
+func formatMessageMarkup(content string) string {
+	var result strings.Builder
+
+	for {
+		startIdx := strings.Index(content, "<")
+		if startIdx == -1 {
+			break
+		}
+		endIdx := strings.Index(content[startIdx:], ">")
+		if endIdx == -1 {
+			break
+		}
+
+		result.WriteString(content[:startIdx])
+		result.WriteString(formatMessageToken(content[startIdx+1 : startIdx+endIdx]))
+		content = content[startIdx+endIdx+1:]
+	}
+	result.WriteString(content)
+
+	return result.String()
+}
+
+// formatMessageToken formats the inside of one "<...>" markup token.
+func formatMessageToken(token string) string {
+	target, label, hasLabel := strings.Cut(token, "|")
+
+	switch {
+	case strings.HasPrefix(target, "@"):
+		if hasLabel && label != "" {
+			return "@" + strings.TrimPrefix(label, "@")
+		}
+		return target
+	case strings.HasPrefix(target, "#"):
+		if hasLabel && label != "" {
+			return "#" + strings.TrimPrefix(label, "#")
+		}
+		return target
+	case strings.HasPrefix(target, "!"):
+		// Special mentions ("!here"), user groups ("!subteam^S123|@team")
+		// and dates ("!date^1392734382^{date}|fallback")
+		if hasLabel && label != "" {
+			return label
+		}
+		name, _, _ := strings.Cut(target[1:], "^")
+		return "@" + name
+	}
+
+	target = strings.TrimPrefix(target, "mailto:")
+	if hasLabel && label != "" && label != target {
+		return label + " (" + target + ")"
+	}
+	return target
+}
+
+// ConfluenceStorageNormaliser handles Confluence content in storage
+// format, the XHTML Confluence stores pages in. It keeps the text of the
+// page, including code and the titles of linked pages, with headings and
+// list items marked up as in Markdown, and drops macro parameters, images
+// and emoticons.
+type ConfluenceStorageNormaliser struct{}
+
+func (n *ConfluenceStorageNormaliser) Normalise(content string, mimeType string) string {
+	// Normalize line endings
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	content = strings.ReplaceAll(content, "\r", "\n")
+
+	content = formatStorageXHTML(content)
+
+	// Trim trailing whitespace left by tags
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	content = strings.Join(lines, "\n")
+
+	// Remove excessive blank lines
+	for strings.Contains(content, "\n\n\n") {
+		content = strings.ReplaceAll(content, "\n\n\n", "\n\n")
+	}
+
+	return strings.TrimSpace(content)
+}
+
+func (n *ConfluenceStorageNormaliser) SupportedTypes() []string {
+	return []string{"application/x-confluence-storage"}
+}
+
+func (n *ConfluenceStorageNormaliser) Priority() int {
+	return 90 // High priority - connector-specific
+}
+
+// storageBlockTags are the storage format elements that start a new line.
+var storageBlockTags = map[string]bool{
+	"p": true, "div": true, "ul": true, "ol": true, "table": true, "tr": true,
+	"blockquote": true, "pre": true, "hr": true, "br": true,
+	"ac:structured-macro": true, "ac:rich-text-body": true, "ac:plain-text-body": true,
+	"ac:task-list": true, "ac:layout-section": true, "ac:layout-cell": true,
+}
+
+// storageSkippedTags are the storage format elements whose content is not
+// text: macro parameters, placeholders, task metadata, styles and scripts.
+var storageSkippedTags = map[string]bool{
+	"ac:parameter": true, "ac:placeholder": true, "ac:task-id": true, "ac:task-status": true,
+	"style": true, "script": true,
+}
+
+// storageLinkAttributes are the attributes of a link's resource that name
+// it, used for links without a body.
+var storageLinkAttributes = []string{"ri:content-title", "ri:filename", "ri:space-key", "ri:username", "ri:value"}
+
+// formatStorageXHTML extracts the text of storage format XHTML. CDATA
+// sections, which hold code and plain text macro bodies, are kept as is.
+func formatStorageXHTML(content string) string {
+	var result strings.Builder
+	var last byte // Last byte written
+	write := func(text string) {
+		if text != "" {
+			result.WriteString(text)
+			last = text[len(text)-1]
+		}
+	}
+	skipDepth := 0
+	inLink := false
+	linkStart, linkName := 0, ""
+
+	for content != "" {
+		switch {
+		case strings.HasPrefix(content, "<![CDATA["):
+			endIdx := strings.Index(content, "]]>")
+			if endIdx == -1 {
+				endIdx = len(content)
+			}
+			if skipDepth == 0 {
+				write(content[len("<![CDATA["):endIdx])
+			}
+			content = content[min(endIdx+len("]]>"), len(content)):]
+			continue
+		case strings.HasPrefix(content, "<!--"):
+			endIdx := strings.Index(content, "-->")
+			if endIdx == -1 {
+				return result.String()
+			}
+			content = content[endIdx+len("-->"):]
+			continue
+		case !strings.HasPrefix(content, "<"):
+			endIdx := strings.Index(content, "<")
+			if endIdx == -1 {
+				endIdx = len(content)
+			}
+			if skipDepth == 0 {
+				write(decodeHTMLEntities(content[:endIdx]))
+			}
+			content = content[endIdx:]
+			continue
+		}
+
+		endIdx := strings.Index(content, ">")
+		if endIdx == -1 {
+			break
+		}
+		tag := content[1:endIdx]
+		content = content[endIdx+1:]
+
+		closing := strings.HasPrefix(tag, "/")
+		selfClosing := strings.HasSuffix(tag, "/")
+		tag = strings.TrimSuffix(strings.TrimPrefix(tag, "/"), "/")
+		name, attributes, _ := strings.Cut(tag, " ")
+		name = strings.ToLower(name)
+
+		if storageSkippedTags[name] {
+			switch {
+			case closing:
+				skipDepth = max(skipDepth-1, 0)
+			case !selfClosing:
+				skipDepth++
+			}
+			continue
+		}
+		if skipDepth > 0 {
+			continue
+		}
+
+		switch {
+		case name == "ac:link":
+			// Links without a body are written as the resource's name
+			if !closing {
+				inLink, linkStart, linkName = !selfClosing, result.Len(), ""
+			} else if inLink {
+				if result.Len() == linkStart {
+					write(linkName)
+				}
+				inLink = false
+			}
+		case strings.HasPrefix(name, "ri:"):
+			if inLink && linkName == "" {
+				for _, attribute := range storageLinkAttributes {
+					if value := storageAttribute(attributes, attribute); value != "" {
+						linkName = value
+						break
+					}
+				}
+			}
+		case len(name) == 2 && name[0] == 'h' && name[1] >= '1' && name[1] <= '6':
+			write("\n")
+			if !closing {
+				write(strings.Repeat("#", int(name[1]-'0')) + " ")
+			}
+		case name == "li" || name == "ac:task":
+			write("\n")
+			if !closing {
+				write("- ")
+			}
+		case name == "td" || name == "th":
+			if !closing && result.Len() > 0 && last != '\n' {
+				write(" | ")
+			}
+		case storageBlockTags[name]:
+			write("\n")
+		}
+	}
+
+	return result.String()
+}
+
+// storageAttribute returns the decoded value of a double-quoted attribute
+// from the attributes of a tag, or "" if it is not set.
+func storageAttribute(attributes, name string) string {
+	for {
+		idx := strings.Index(attributes, name+"=\"")
+		if idx == -1 {
+			return ""
+		}
+		// Require a whole attribute name, not the end of a longer one
+		if idx > 0 && attributes[idx-1] != ' ' {
+			attributes = attributes[idx+len(name):]
+			continue
+		}
+		value := attributes[idx+len(name)+2:]
+		if endIdx := strings.Index(value, "\""); endIdx != -1 {
+			value = value[:endIdx]
+		}
+		return decodeHTMLEntities(value)
+	}
+}
+
 // removeHTMLComments removes HTML comments from content.
 func removeHTMLComments(content string) string {
 	result := content
@@ -436,6 +436,96 @@ func TestDecodeHTMLEntities(t *testing.T) {
 	}
 }
 
+func TestChannelMessageNormaliser(t *testing.T) {
+	n := &ChannelMessageNormaliser{}
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"labelled user mention", "thanks <@U123|Ann>!", "thanks @Ann!"},
+		{"user mention without label", "ping <@U123>", "ping @U123"},
+		{"channel mention", "see <#C123|general>", "see #general"},
+		{"special mention", "<!here> deploy at 5", "@here deploy at 5"},
+		{"user group", "<!subteam^S123|@oncall> help", "@oncall help"},
+		{"labelled link", "<https://example.com|the docs>", "the docs (https://example.com)"},
+		{"link", "<https://example.com>", "https://example.com"},
+		{"email", "<mailto:ann@example.com|ann@example.com>", "ann@example.com"},
+		{"escaped characters", "a &lt;b&gt; &amp;amp; c", "a <b> &amp; c"},
+		{"blank lines", "one\r\n\n\n\ntwo", "one\n\ntwo"},
+		{"unterminated markup", "a < b", "a < b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := n.Normalise(tt.input, "application/x-slack-message")
+			if result != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+
+	if DefaultRegistry().Get("application/x-slack-message") == nil {
+		t.Error("expected the default registry to normalise Slack messages")
+	}
+}
+
+func TestConfluenceStorageNormaliser(t *testing.T) {
+	n := &ConfluenceStorageNormaliser{}
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"paragraphs", "<p>One &amp; <strong>two</strong></p><p>Three</p>", "One & two\n\nThree"},
+		{"headings", "<h1>Title</h1><p>Intro</p><h3>Detail</h3>", "# Title\n\nIntro\n\n### Detail"},
+		{"lists", "<ul><li>one</li><li>two</li></ul>", "- one\n\n- two"},
+		{"line breaks", "a<br/>b", "a\nb"},
+		{"table", "<table><tbody><tr><th>Name</th><th>Owner</th></tr><tr><td>api</td><td>Ann</td></tr></tbody></table>", "Name | Owner\n\napi | Ann"},
+		{
+			"code macro",
+			`<ac:structured-macro ac:name="code"><ac:parameter ac:name="language">go</ac:parameter><ac:plain-text-body><![CDATA[if a < b {}]]></ac:plain-text-body></ac:structured-macro>`,
+			"if a < b {}",
+		},
+		{
+			"page link",
+			`See <ac:link><ri:page ri:content-title="Runbook &amp; FAQ" /></ac:link>.`,
+			"See Runbook & FAQ.",
+		},
+		{
+			"link with body",
+			`<ac:link><ri:page ri:content-title="Runbook" /><ac:plain-text-link-body><![CDATA[the runbook]]></ac:plain-text-link-body></ac:link>`,
+			"the runbook",
+		},
+		{
+			"tasks",
+			`<ac:task-list><ac:task><ac:task-id>1</ac:task-id><ac:task-status>complete</ac:task-status><ac:task-body>Ship</ac:task-body></ac:task></ac:task-list>`,
+			"- Ship",
+		},
+		{
+			"images and emoticons",
+			`<p>Logo <ac:image><ri:attachment ri:filename="logo.png" /></ac:image><ac:emoticon ac:name="smile" /></p>`,
+			"Logo",
+		},
+		{"comments", "a<!-- hidden -->b", "ab"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := n.Normalise(tt.input, "application/x-confluence-storage")
+			if result != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+
+	if DefaultRegistry().Get("application/x-confluence-storage") == nil {
+		t.Error("expected the default registry to normalise Confluence storage format")
+	}
+}
+
 // Verify interface compliance
 func TestInterfaceCompliance(t *testing.T) {
 	var _ driven.NormaliserRegistry = (*Registry)(nil)
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/custodia-labs/sercha-core/internal/core/domain"
+	"github.com/custodia-labs/sercha-core/internal/core/ports/driven"
 )
 
 // mockEmbeddingService is a mock implementation for testing
@@ -57,6 +58,14 @@ func (m *mockLLMService) RewriteQuery(ctx context.Context, query string) (string
 	return "", nil
 }
 
+func (m *mockLLMService) Complete(ctx context.Context, req driven.CompletionRequest) (string, error) {
+	return "", nil
+}
+
+func (m *mockLLMService) StreamComplete(ctx context.Context, req driven.CompletionRequest, onToken func(string) error) (string, error) {
+	return "", nil
+}
+
 func (m *mockLLMService) Model() string {
 	return "test-llm"
 }
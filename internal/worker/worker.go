@@ -17,9 +17,17 @@ import (
 // This is a minimal interface to allow for testing.
 type Orchestrator interface {
 	SyncSource(ctx context.Context, sourceID string) (*domain.SyncResult, error)
+	SyncWithOptions(ctx context.Context, sourceID string, opts domain.SyncOptions) (*domain.SyncResult, error)
+	RetryFailedDocuments(ctx context.Context, sourceID string) (*domain.SyncResult, error)
 	SyncAll(ctx context.Context) ([]*domain.SyncResult, error)
+	DryRunSync(ctx context.Context, sourceID, runID string) (*domain.DryRun, error)
+	CoveredByRunningSync(ctx context.Context, sourceID string, opts domain.SyncOptions, requestedAt time.Time) (bool, error)
 }
 
+// syncInProgressDelay is how long a task rejected because its source was
+// already syncing waits before it runs again.
+const syncInProgressDelay = time.Minute
+
 // Worker processes tasks from the task queue.
 // It runs the sync orchestrator for each sync task.
 type Worker struct {
@@ -200,12 +208,31 @@ func (w *Worker) processTask(ctx context.Context, task *domain.Task, logger *slo
 		err = w.handleSyncSource(ctx, task)
 	case domain.TaskTypeSyncAll:
 		err = w.handleSyncAll(ctx, task)
+	case domain.TaskTypeRetryFailedDocuments:
+		err = w.handleRetryFailedDocuments(ctx, task)
+	case domain.TaskTypeDryRunSync:
+		err = w.handleDryRunSync(ctx, task)
 	default:
 		err = fmt.Errorf("unknown task type: %s", task.Type)
 	}
 
 	duration := time.Since(startTime)
 
+	if errors.Is(err, domain.ErrSyncCancelled) {
+		logger.Info("task cancelled", "duration", duration)
+
+		// Cancelled tasks are final and must not be retried
+		if ackErr := w.taskQueue.AckCancelled(ctx, task.ID, err.Error()); ackErr != nil {
+			logger.Error("failed to ack cancelled task", "ack_error", ackErr)
+		}
+		return
+	}
+
+	if errors.Is(err, domain.ErrSyncInProgress) {
+		w.deferTask(ctx, task, logger)
+		return
+	}
+
 	if err != nil {
 		logger.Error("task failed",
 			"duration", duration,
@@ -227,6 +254,72 @@ func (w *Worker) processTask(ctx context.Context, task *domain.Task, logger *slo
 	}
 }
 
+// deferTask handles a task rejected because its source was already syncing.
+// A sync_source task covered by the running sync is dropped, as is a task
+// covered by a pending task of the source. Otherwise pending tasks of the
+// source that the task covers are cancelled, and the task itself is put back
+// to run again after syncInProgressDelay.
+func (w *Worker) deferTask(ctx context.Context, task *domain.Task, logger *slog.Logger) {
+	sourceID := task.SourceID()
+	reason := domain.ErrSyncInProgress.Error()
+
+	if task.Type == domain.TaskTypeSyncSource {
+		covered, err := w.orchestrator.CoveredByRunningSync(ctx, sourceID, task.SyncOptions(), task.CreatedAt)
+		if err != nil {
+			logger.Warn("failed to check running sync", "error", err)
+		}
+		if covered {
+			logger.Info("task skipped, covered by the running sync")
+			if ackErr := w.taskQueue.AckCancelled(ctx, task.ID, reason); ackErr != nil {
+				logger.Error("failed to ack skipped task", "ack_error", ackErr)
+			}
+			return
+		}
+	}
+
+	pending, err := w.taskQueue.ListTasks(ctx, driven.TaskFilter{
+		TeamID: task.TeamID,
+		Status: domain.TaskStatusPending,
+		Type:   task.Type,
+	})
+	if err != nil {
+		logger.Warn("failed to list pending tasks", "error", err)
+	}
+	for _, other := range pending {
+		if other.ID == task.ID || other.Status != domain.TaskStatusPending || other.Type != task.Type || other.SourceID() != sourceID {
+			continue
+		}
+		if covers(other, task) {
+			logger.Info("task skipped, source already syncing and a pending task covers it", "pending_task_id", other.ID)
+			if ackErr := w.taskQueue.AckCancelled(ctx, task.ID, reason); ackErr != nil {
+				logger.Error("failed to ack skipped task", "ack_error", ackErr)
+			}
+			return
+		}
+		if cancelErr := w.taskQueue.CancelTask(ctx, other.ID); cancelErr != nil {
+			logger.Warn("failed to cancel pending task", "pending_task_id", other.ID, "error", cancelErr)
+		}
+	}
+
+	logger.Info("task deferred, source already syncing", "delay", syncInProgressDelay)
+	if deferErr := w.taskQueue.Defer(ctx, task.ID, reason, time.Now().Add(syncInProgressDelay)); deferErr != nil {
+		// Retry the task instead
+		logger.Error("failed to defer task", "error", deferErr)
+		if nackErr := w.taskQueue.Nack(ctx, task.ID, reason); nackErr != nil {
+			logger.Error("failed to nack task", "nack_error", nackErr)
+		}
+	}
+}
+
+// covers reports whether task a, once run, makes task b unnecessary. Both
+// are of the same type and source; only a full sync covers a full sync.
+func covers(a, b *domain.Task) bool {
+	if a.Type != domain.TaskTypeSyncSource {
+		return true
+	}
+	return a.SyncOptions().Full || !b.SyncOptions().Full
+}
+
 // handleSyncSource handles a sync_source task.
 func (w *Worker) handleSyncSource(ctx context.Context, task *domain.Task) error {
 	sourceID := task.SourceID()
@@ -234,7 +327,7 @@ func (w *Worker) handleSyncSource(ctx context.Context, task *domain.Task) error
 		return fmt.Errorf("source_id not found in task payload")
 	}
 
-	result, err := w.orchestrator.SyncSource(ctx, sourceID)
+	result, err := w.orchestrator.SyncWithOptions(ctx, sourceID, task.SyncOptions())
 	if err != nil {
 		return err
 	}
@@ -246,6 +339,37 @@ func (w *Worker) handleSyncSource(ctx context.Context, task *domain.Task) error
 	return nil
 }
 
+// handleRetryFailedDocuments handles a retry_failed_documents task.
+func (w *Worker) handleRetryFailedDocuments(ctx context.Context, task *domain.Task) error {
+	sourceID := task.SourceID()
+	if sourceID == "" {
+		return fmt.Errorf("source_id not found in task payload")
+	}
+
+	result, err := w.orchestrator.RetryFailedDocuments(ctx, sourceID)
+	if err != nil {
+		return err
+	}
+
+	if !result.Success {
+		return fmt.Errorf("retry failed: %s", result.Error)
+	}
+
+	return nil
+}
+
+// handleDryRunSync handles a dry_run_sync task. The result is saved under
+// the task ID, where the API looks it up.
+func (w *Worker) handleDryRunSync(ctx context.Context, task *domain.Task) error {
+	sourceID := task.SourceID()
+	if sourceID == "" {
+		return fmt.Errorf("source_id not found in task payload")
+	}
+
+	_, err := w.orchestrator.DryRunSync(ctx, sourceID, task.ID)
+	return err
+}
+
 // handleSyncAll handles a sync_all task.
 func (w *Worker) handleSyncAll(ctx context.Context, task *domain.Task) error {
 	results, err := w.orchestrator.SyncAll(ctx)
@@ -14,14 +14,16 @@ import (
 
 // mockTaskQueue implements driven.TaskQueue for testing
 type mockTaskQueue struct {
-	mu           sync.Mutex
-	tasks        []*domain.Task
-	dequeueDelay time.Duration
-	enqueueFn    func(*domain.Task) error
-	dequeueFn    func() (*domain.Task, error)
-	ackFn        func(string) error
-	nackFn       func(string, string) error
-	pingFn       func() error
+	mu             sync.Mutex
+	tasks          []*domain.Task
+	dequeueDelay   time.Duration
+	enqueueFn      func(*domain.Task) error
+	dequeueFn      func() (*domain.Task, error)
+	ackFn          func(string) error
+	nackFn         func(string, string) error
+	ackCancelledFn func(string, string) error
+	deferFn        func(string, string, time.Time) error
+	pingFn         func() error
 }
 
 func newMockTaskQueue() *mockTaskQueue {
@@ -88,6 +90,20 @@ func (m *mockTaskQueue) Nack(ctx context.Context, taskID string, reason string)
 	return nil
 }
 
+func (m *mockTaskQueue) AckCancelled(ctx context.Context, taskID string, reason string) error {
+	if m.ackCancelledFn != nil {
+		return m.ackCancelledFn(taskID, reason)
+	}
+	return nil
+}
+
+func (m *mockTaskQueue) Defer(ctx context.Context, taskID string, reason string, runAt time.Time) error {
+	if m.deferFn != nil {
+		return m.deferFn(taskID, reason, runAt)
+	}
+	return nil
+}
+
 func (m *mockTaskQueue) GetTask(ctx context.Context, taskID string) (*domain.Task, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -423,8 +439,19 @@ func TestMockTaskQueueInterface(t *testing.T) {
 
 // mockOrchestrator implements Orchestrator for testing
 type mockOrchestrator struct {
-	syncSourceFn func(ctx context.Context, sourceID string) (*domain.SyncResult, error)
-	syncAllFn    func(ctx context.Context) ([]*domain.SyncResult, error)
+	syncSourceFn      func(ctx context.Context, sourceID string) (*domain.SyncResult, error)
+	syncWithOptionsFn func(ctx context.Context, sourceID string, opts domain.SyncOptions) (*domain.SyncResult, error)
+	retryFailedFn     func(ctx context.Context, sourceID string) (*domain.SyncResult, error)
+	syncAllFn         func(ctx context.Context) ([]*domain.SyncResult, error)
+	dryRunSyncFn      func(ctx context.Context, sourceID, runID string) (*domain.DryRun, error)
+	coveredFn         func(ctx context.Context, sourceID string, opts domain.SyncOptions, requestedAt time.Time) (bool, error)
+}
+
+func (m *mockOrchestrator) CoveredByRunningSync(ctx context.Context, sourceID string, opts domain.SyncOptions, requestedAt time.Time) (bool, error) {
+	if m.coveredFn != nil {
+		return m.coveredFn(ctx, sourceID, opts, requestedAt)
+	}
+	return false, nil
 }
 
 func (m *mockOrchestrator) SyncSource(ctx context.Context, sourceID string) (*domain.SyncResult, error) {
@@ -434,6 +461,20 @@ func (m *mockOrchestrator) SyncSource(ctx context.Context, sourceID string) (*do
 	return &domain.SyncResult{Success: true, SourceID: sourceID}, nil
 }
 
+func (m *mockOrchestrator) SyncWithOptions(ctx context.Context, sourceID string, opts domain.SyncOptions) (*domain.SyncResult, error) {
+	if m.syncWithOptionsFn != nil {
+		return m.syncWithOptionsFn(ctx, sourceID, opts)
+	}
+	return m.SyncSource(ctx, sourceID)
+}
+
+func (m *mockOrchestrator) RetryFailedDocuments(ctx context.Context, sourceID string) (*domain.SyncResult, error) {
+	if m.retryFailedFn != nil {
+		return m.retryFailedFn(ctx, sourceID)
+	}
+	return &domain.SyncResult{Success: true, SourceID: sourceID}, nil
+}
+
 func (m *mockOrchestrator) SyncAll(ctx context.Context) ([]*domain.SyncResult, error) {
 	if m.syncAllFn != nil {
 		return m.syncAllFn(ctx)
@@ -441,6 +482,13 @@ func (m *mockOrchestrator) SyncAll(ctx context.Context) ([]*domain.SyncResult, e
 	return []*domain.SyncResult{{Success: true}}, nil
 }
 
+func (m *mockOrchestrator) DryRunSync(ctx context.Context, sourceID, runID string) (*domain.DryRun, error) {
+	if m.dryRunSyncFn != nil {
+		return m.dryRunSyncFn(ctx, sourceID, runID)
+	}
+	return &domain.DryRun{ID: runID, SourceID: sourceID, Status: domain.SyncStatusCompleted}, nil
+}
+
 // Test that mock implements the interface
 func TestMockOrchestratorInterface(t *testing.T) {
 	var _ Orchestrator = (*mockOrchestrator)(nil)
@@ -486,6 +534,99 @@ func TestWorker_HandleSyncSource_Success(t *testing.T) {
 	}
 }
 
+func TestWorker_HandleSyncSource_Options(t *testing.T) {
+	queue := newMockTaskQueue()
+	var got domain.SyncOptions
+	orch := &mockOrchestrator{
+		syncWithOptionsFn: func(ctx context.Context, sourceID string, opts domain.SyncOptions) (*domain.SyncResult, error) {
+			got = opts
+			return &domain.SyncResult{Success: true, SourceID: sourceID}, nil
+		},
+	}
+
+	task := domain.NewSyncSourceTaskWithOptions("team-123", "source-456", domain.SyncOptions{
+		Full:    true,
+		Trigger: domain.SyncTriggerScheduled,
+	})
+
+	w := NewWorker(WorkerConfig{
+		TaskQueue:    queue,
+		Orchestrator: orch,
+		Concurrency:  1,
+	})
+	w.processTask(context.Background(), task, slog.Default())
+
+	if !got.Full || got.Trigger != domain.SyncTriggerScheduled {
+		t.Errorf("expected scheduled full sync options, got %+v", got)
+	}
+}
+
+func TestWorker_HandleRetryFailedDocuments(t *testing.T) {
+	queue := newMockTaskQueue()
+	var retried string
+	orch := &mockOrchestrator{
+		retryFailedFn: func(ctx context.Context, sourceID string) (*domain.SyncResult, error) {
+			retried = sourceID
+			return &domain.SyncResult{Success: true, SourceID: sourceID}, nil
+		},
+	}
+
+	var acked []string
+	queue.ackFn = func(taskID string) error {
+		acked = append(acked, taskID)
+		return nil
+	}
+
+	task := domain.NewRetryFailedDocumentsTask("team-123", "source-456")
+
+	w := NewWorker(WorkerConfig{
+		TaskQueue:    queue,
+		Orchestrator: orch,
+		Concurrency:  1,
+	})
+	w.processTask(context.Background(), task, slog.Default())
+
+	if retried != "source-456" {
+		t.Errorf("expected retry of source-456, got %q", retried)
+	}
+	if len(acked) != 1 {
+		t.Errorf("expected 1 ack, got %d", len(acked))
+	}
+}
+
+func TestWorker_HandleDryRunSync(t *testing.T) {
+	queue := newMockTaskQueue()
+	var sourceID, runID string
+	orch := &mockOrchestrator{
+		dryRunSyncFn: func(ctx context.Context, id, run string) (*domain.DryRun, error) {
+			sourceID, runID = id, run
+			return &domain.DryRun{ID: run, SourceID: id, Status: domain.SyncStatusCompleted}, nil
+		},
+	}
+
+	var acked []string
+	queue.ackFn = func(taskID string) error {
+		acked = append(acked, taskID)
+		return nil
+	}
+
+	task := domain.NewDryRunSyncTask("team-123", "source-456")
+
+	w := NewWorker(WorkerConfig{
+		TaskQueue:    queue,
+		Orchestrator: orch,
+		Concurrency:  1,
+	})
+	w.processTask(context.Background(), task, slog.Default())
+
+	if sourceID != "source-456" || runID != task.ID {
+		t.Errorf("expected dry run of source-456 under the task ID, got %q/%q", sourceID, runID)
+	}
+	if len(acked) != 1 {
+		t.Errorf("expected 1 ack, got %d", len(acked))
+	}
+}
+
 func TestWorker_HandleSyncSource_Error(t *testing.T) {
 	queue := newMockTaskQueue()
 	orch := &mockOrchestrator{
@@ -522,6 +663,171 @@ func TestWorker_HandleSyncSource_Error(t *testing.T) {
 	}
 }
 
+func TestWorker_HandleSyncSource_Cancelled(t *testing.T) {
+	queue := newMockTaskQueue()
+	orch := &mockOrchestrator{
+		syncSourceFn: func(ctx context.Context, sourceID string) (*domain.SyncResult, error) {
+			return &domain.SyncResult{SourceID: sourceID, Error: "cancelled by user"}, domain.ErrSyncCancelled
+		},
+	}
+
+	var nacked, cancelled []string
+	queue.nackFn = func(taskID, reason string) error {
+		nacked = append(nacked, taskID)
+		return nil
+	}
+	queue.ackCancelledFn = func(taskID, reason string) error {
+		cancelled = append(cancelled, taskID)
+		return nil
+	}
+
+	task := &domain.Task{
+		ID:      "task-123",
+		Type:    domain.TaskTypeSyncSource,
+		TeamID:  "team-123",
+		Payload: map[string]string{"source_id": "source-456"},
+	}
+
+	w := NewWorker(WorkerConfig{
+		TaskQueue:    queue,
+		Orchestrator: orch,
+		Concurrency:  1,
+	})
+
+	w.processTask(context.Background(), task, slog.Default())
+
+	// Cancelled syncs are final and must not be retried
+	if len(cancelled) != 1 || len(nacked) != 0 {
+		t.Errorf("expected task to be acked as cancelled, got %d cancelled and %d nacked", len(cancelled), len(nacked))
+	}
+}
+
+func TestWorker_HandleSyncSource_InProgress(t *testing.T) {
+	queue := newMockTaskQueue()
+	orch := &mockOrchestrator{
+		syncSourceFn: func(ctx context.Context, sourceID string) (*domain.SyncResult, error) {
+			return &domain.SyncResult{SourceID: sourceID, Error: "sync already in progress"}, domain.ErrSyncInProgress
+		},
+	}
+
+	var nacked, cancelled, deferred []string
+	var runAt time.Time
+	queue.nackFn = func(taskID, reason string) error {
+		nacked = append(nacked, taskID)
+		return nil
+	}
+	queue.ackCancelledFn = func(taskID, reason string) error {
+		cancelled = append(cancelled, taskID)
+		return nil
+	}
+	queue.deferFn = func(taskID, reason string, at time.Time) error {
+		deferred = append(deferred, taskID)
+		runAt = at
+		return nil
+	}
+
+	task := domain.NewSyncSourceTaskWithOptions("team-123", "source-456", domain.SyncOptions{Full: true})
+
+	w := NewWorker(WorkerConfig{
+		TaskQueue:    queue,
+		Orchestrator: orch,
+		Concurrency:  1,
+	})
+
+	w.processTask(context.Background(), task, slog.Default())
+
+	// The task itself runs again later, no new task is queued
+	if len(deferred) != 1 || deferred[0] != task.ID || len(cancelled) != 0 || len(nacked) != 0 {
+		t.Fatalf("expected task to be deferred, got %d deferred, %d cancelled and %d nacked", len(deferred), len(cancelled), len(nacked))
+	}
+	if len(queue.tasks) != 0 {
+		t.Errorf("expected no new task, got %d tasks", len(queue.tasks))
+	}
+	if runAt.Before(time.Now().Add(syncInProgressDelay / 2)) {
+		t.Errorf("expected the task to be delayed, deferred to %v", runAt)
+	}
+
+	// A pending task covering the next one absorbs it
+	task.Status = domain.TaskStatusPending
+	queue.tasks = append(queue.tasks, task)
+	deferred = nil
+	w.processTask(context.Background(), domain.NewSyncSourceTask("team-123", "source-456"), slog.Default())
+	if len(cancelled) != 1 || len(deferred) != 0 {
+		t.Errorf("expected the task to be coalesced into the pending task, got %d cancelled and %d deferred", len(cancelled), len(deferred))
+	}
+}
+
+func TestWorker_HandleSyncSource_InProgressDeferError(t *testing.T) {
+	queue := newMockTaskQueue()
+	orch := &mockOrchestrator{
+		syncSourceFn: func(ctx context.Context, sourceID string) (*domain.SyncResult, error) {
+			return &domain.SyncResult{SourceID: sourceID, Error: "sync already in progress"}, domain.ErrSyncInProgress
+		},
+	}
+
+	var nacked []string
+	queue.nackFn = func(taskID, reason string) error {
+		nacked = append(nacked, taskID)
+		return nil
+	}
+	queue.deferFn = func(taskID, reason string, at time.Time) error {
+		return errors.New("queue unavailable")
+	}
+
+	task := domain.NewSyncSourceTask("team-123", "source-456")
+	w := NewWorker(WorkerConfig{
+		TaskQueue:    queue,
+		Orchestrator: orch,
+		Concurrency:  1,
+	})
+
+	w.processTask(context.Background(), task, slog.Default())
+
+	if len(nacked) != 1 || nacked[0] != task.ID {
+		t.Errorf("expected task to be nacked when it cannot be deferred, got %v", nacked)
+	}
+}
+
+func TestWorker_HandleSyncSource_InProgressCovered(t *testing.T) {
+	queue := newMockTaskQueue()
+	var requested time.Time
+	orch := &mockOrchestrator{
+		syncSourceFn: func(ctx context.Context, sourceID string) (*domain.SyncResult, error) {
+			return &domain.SyncResult{SourceID: sourceID, Error: "sync already in progress"}, domain.ErrSyncInProgress
+		},
+		coveredFn: func(ctx context.Context, sourceID string, opts domain.SyncOptions, requestedAt time.Time) (bool, error) {
+			requested = requestedAt
+			return true, nil
+		},
+	}
+
+	var reasons []string
+	queue.ackCancelledFn = func(taskID, reason string) error {
+		reasons = append(reasons, reason)
+		return nil
+	}
+
+	task := domain.NewSyncSourceTask("team-123", "source-456")
+	w := NewWorker(WorkerConfig{
+		TaskQueue:    queue,
+		Orchestrator: orch,
+		Concurrency:  1,
+	})
+
+	w.processTask(context.Background(), task, slog.Default())
+
+	// The running sync started after the task was queued, so it is dropped
+	if len(reasons) != 1 || reasons[0] != domain.ErrSyncInProgress.Error() {
+		t.Fatalf("expected task to be acked as cancelled, got %v", reasons)
+	}
+	if len(queue.tasks) != 0 {
+		t.Errorf("expected no new task, got %d", len(queue.tasks))
+	}
+	if !requested.Equal(task.CreatedAt) {
+		t.Errorf("expected the running sync to be compared with the task's creation, got %v", requested)
+	}
+}
+
 func TestWorker_HandleSyncSource_NotSuccessful(t *testing.T) {
 	queue := newMockTaskQueue()
 	orch := &mockOrchestrator{
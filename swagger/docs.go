@@ -214,6 +214,69 @@ const docTemplate = `{
                 }
             }
         },
+        "/answer": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Search indexed documents and generate an answer with the configured LLM. The answer contains inline [n] markers that map to the returned citations.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Search"
+                ],
+                "summary": "Answer a question",
+                "parameters": [
+                    {
+                        "description": "Question",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.answerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.Answer"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or missing query",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Answer generation failed",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "No LLM provider configured",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/auth/login": {
             "post": {
                 "description": "Authenticate with email and password to receive a JWT token",
@@ -455,7 +518,7 @@ const docTemplate = `{
         },
         "/health": {
             "get": {
-                "description": "Returns the health status of the API and its components",
+                "description": "Returns 200 if the service is up, with status of each dependency in the body",
                 "produces": [
                     "application/json"
                 ],
@@ -465,13 +528,7 @@ const docTemplate = `{
                 "summary": "Health check",
                 "responses": {
                     "200": {
-                        "description": "All components healthy",
-                        "schema": {
-                            "$ref": "#/definitions/internal_adapters_driving_http.HealthResponse"
-                        }
-                    },
-                    "503": {
-                        "description": "One or more components unhealthy",
+                        "description": "Service is up with dependency status",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_driving_http.HealthResponse"
                         }
@@ -523,6 +580,67 @@ const docTemplate = `{
                         }
                     }
                 }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new installation for non-OAuth connectors (API key, path-based). Used for connectors like localfs.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Installations"
+                ],
+                "summary": "Create installation",
+                "parameters": [
+                    {
+                        "description": "Installation configuration",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_ports_driving.CreateInstallationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.InstallationSummary"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - admin only",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    }
+                }
             }
         },
         "/installations/{id}": {
@@ -1280,6 +1398,63 @@ const docTemplate = `{
                 }
             }
         },
+        "/search/stream": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Execute a search and stream the results as Server-Sent Events. A \"results\" event with the hits is sent first, followed by \"token\" events carrying incremental LLM summary text, and a final \"summary\" event with the full summary and citations. An \"error\" event is sent instead of a summary when no LLM is configured or generation fails.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "Search"
+                ],
+                "summary": "Search and summarise (streaming)",
+                "parameters": [
+                    {
+                        "description": "Search query",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.searchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Stream of summary events",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.SummaryEvent"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or missing query",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Search failed",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/settings": {
             "get": {
                 "security": [
@@ -2101,21 +2276,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/sources/{id}/enable": {
+        "/sources/{id}/dry-run": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Enable a source for syncing. Enabled sources will be included in scheduled syncs.",
+                "description": "Enqueue a task that fetches a source and normalises and chunks its documents without storing or indexing anything (admin only). The result, with document counts by MIME type, estimated chunks and embedding tokens and sample documents, is read from the dry-run result endpoint under the returned task ID.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Sources"
                 ],
-                "summary": "Enable source",
+                "summary": "Dry-run sync",
                 "parameters": [
                     {
                         "type": "string",
@@ -2126,10 +2301,10 @@ const docTemplate = `{
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "202": {
+                        "description": "Accepted",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_driving_http.StatusResponse"
+                            "$ref": "#/definitions/internal_adapters_driving_http.SyncAcceptedResponse"
                         }
                     },
                     "400": {
@@ -2165,24 +2340,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/sources/{id}/selection": {
-            "put": {
+        "/sources/{id}/dry-run/{task_id}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update which containers (repos, drives, spaces) a source should index. Pass an empty array to index all available containers.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get the result of a dry-run sync of a source (admin only). A dry run that has not started yet is reported with 202 and the status of its task; a running dry run returns its results so far.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Sources"
                 ],
-                "summary": "Update source selection",
+                "summary": "Get dry-run result",
                 "parameters": [
                     {
                         "type": "string",
@@ -2192,26 +2364,24 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Container selection",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_adapters_driving_http.UpdateSelectionRequest"
-                        }
+                        "type": "string",
+                        "description": "Dry-run task ID",
+                        "name": "task_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_driving_http.StatusResponse"
+                            "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.DryRun"
                         }
                     },
-                    "400": {
-                        "description": "Invalid request",
+                    "202": {
+                        "description": "Dry run not started yet",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                            "$ref": "#/definitions/internal_adapters_driving_http.SyncAcceptedResponse"
                         }
                     },
                     "401": {
@@ -2227,7 +2397,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Source not found",
+                        "description": "Dry run not found",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
                         }
@@ -2241,21 +2411,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/sources/{id}/sync": {
-            "get": {
+        "/sources/{id}/enable": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get the current sync state and statistics for a specific source. Returns the last sync time, status, and document/chunk counts from the most recent sync operation.",
+                "description": "Enable a source for syncing. Enabled sources will be included in scheduled syncs.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Sources"
                 ],
-                "summary": "Get sync state for source",
+                "summary": "Enable source",
                 "parameters": [
                     {
                         "type": "string",
@@ -2269,7 +2439,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.SyncState"
+                            "$ref": "#/definitions/internal_adapters_driving_http.StatusResponse"
                         }
                     },
                     "400": {
@@ -2303,21 +2473,26 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/sources/{id}/rules/preview": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Trigger a sync operation for a specific source (admin only)",
+                "description": "Show which documents of a source would be added or removed if its include/exclude rules were replaced (admin only). The source is enumerated without syncing, so the request takes about as long as fetching the source. Up to 100 documents are listed per side; the counts are totals.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Sources"
                 ],
-                "summary": "Trigger sync",
+                "summary": "Preview source rules",
                 "parameters": [
                     {
                         "type": "string",
@@ -2325,17 +2500,26 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Proposed rules",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.PreviewRulesRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "202": {
-                        "description": "Accepted",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_driving_http.SyncAcceptedResponse"
+                            "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.RulesPreview"
                         }
                     },
                     "400": {
-                        "description": "Missing source ID",
+                        "description": "Invalid rules",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
                         }
@@ -2351,25 +2535,513 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
                         }
+                    },
+                    "404": {
+                        "description": "Source not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/users": {
-            "get": {
+        "/sources/{id}/selection": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a list of all users (admin only)",
+                "description": "Update which containers (repos, drives, spaces) a source should index. Pass an empty array to index all available containers.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Sources"
                 ],
-                "summary": "List all users",
+                "summary": "Update source selection",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Source ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Container selection",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.UpdateSelectionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.StatusResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - admin only",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Source not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/sources/{id}/sync": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the current sync state and statistics for a specific source. Returns the last sync time, status, and document/chunk counts from the most recent sync operation.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sources"
+                ],
+                "summary": "Get sync state for source",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Source ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.SyncState"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing source ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - admin only",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Source not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Trigger a sync operation for a specific source (admin only). A full sync ignores the sync cursor, refetches every document and deletes documents the source no longer has. A request covered by a sync of the source that is still queued is coalesced into it; a sync started while another runs is rejected by the worker with a cancelled task.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sources"
+                ],
+                "summary": "Trigger sync",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Source ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Run a full sync",
+                        "name": "full",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.SyncAcceptedResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing source ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - admin only",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/sources/{id}/sync/cancel": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Request cancellation of the running sync for a source (admin only). The sync stops after the document in flight, keeping the documents already processed, and ends with status \"cancelled\". Returns the sync state, which is \"cancelling\" until the worker running the sync picks up the request. Does nothing if no sync is running.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sources"
+                ],
+                "summary": "Cancel sync",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Source ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.SyncState"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing source ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - admin only",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Source not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/sources/{id}/sync/errors": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the documents of a source that failed to sync, with the processing stage and error of the last attempt (admin only). A document is removed from the list once it syncs successfully.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sources"
+                ],
+                "summary": "List failed documents",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Source ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of documents to return (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of documents to skip (default 0)",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.DocumentErrorsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing source ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - admin only",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Source not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/sources/{id}/sync/retry": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Enqueue a task that refetches and reprocesses only the documents of a source that failed to sync (admin only). Documents no longer in the source are deleted.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sources"
+                ],
+                "summary": "Retry failed documents",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Source ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.SyncAcceptedResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing source ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - admin only",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Source not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/sources/{id}/sync/runs": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the sync history of a source, newest first (admin only). Each run records its trigger, containers, duration, statistics and the first per-document errors. Only the most recent runs of each source are kept.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sources"
+                ],
+                "summary": "List sync runs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Source ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of runs to return (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of runs to skip (default 0)",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.SyncRunsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing source ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - admin only",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Source not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_driving_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a list of all users (admin only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "List all users",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -2571,6 +3243,33 @@ const docTemplate = `{
                 "AIProviderVoyage"
             ]
         },
+        "github_com_custodia-labs_sercha-core_internal_core_domain.Answer": {
+            "type": "object",
+            "properties": {
+                "answer": {
+                    "description": "Answer text with inline [n] markers",
+                    "type": "string"
+                },
+                "citations": {
+                    "description": "Sources referenced by the markers",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.Citation"
+                    }
+                },
+                "model": {
+                    "description": "LLM model that produced the answer",
+                    "type": "string"
+                },
+                "query": {
+                    "type": "string"
+                },
+                "took": {
+                    "type": "integer",
+                    "example": 1500000
+                }
+            }
+        },
         "github_com_custodia-labs_sercha-core_internal_core_domain.AuthMethod": {
             "type": "string",
             "enum": [
@@ -2634,9 +3333,47 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_custodia-labs_sercha-core_internal_core_domain.Citation": {
+            "type": "object",
+            "properties": {
+                "chunk_id": {
+                    "type": "string"
+                },
+                "document_id": {
+                    "type": "string"
+                },
+                "end_char": {
+                    "type": "integer"
+                },
+                "index": {
+                    "description": "Number used in the answer text",
+                    "type": "integer"
+                },
+                "path": {
+                    "type": "string"
+                },
+                "snippet": {
+                    "type": "string"
+                },
+                "source_id": {
+                    "type": "string"
+                },
+                "start_char": {
+                    "description": "Chunk offsets within the document",
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
         "github_com_custodia-labs_sercha-core_internal_core_domain.Document": {
             "type": "object",
             "properties": {
+                "content_hash": {
+                    "description": "Hash of the last indexed content",
+                    "type": "string"
+                },
                 "created_at": {
                     "type": "string"
                 },
@@ -2674,6 +3411,63 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_custodia-labs_sercha-core_internal_core_domain.DocumentError": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "description": "Consecutive failed attempts",
+                    "type": "integer"
+                },
+                "container_id": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "external_id": {
+                    "type": "string"
+                },
+                "first_failed_at": {
+                    "type": "string"
+                },
+                "last_failed_at": {
+                    "type": "string"
+                },
+                "source_id": {
+                    "type": "string"
+                },
+                "stage": {
+                    "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.DocumentStage"
+                }
+            }
+        },
+        "github_com_custodia-labs_sercha-core_internal_core_domain.DocumentStage": {
+            "type": "string",
+            "enum": [
+                "fetch",
+                "extract",
+                "index",
+                "delete"
+            ],
+            "x-enum-comments": {
+                "DocumentStageDelete": "Removing a deleted document",
+                "DocumentStageExtract": "Converting binary content to text",
+                "DocumentStageFetch": "Fetching the document from the source",
+                "DocumentStageIndex": "Chunking, embedding, storing and indexing"
+            },
+            "x-enum-descriptions": [
+                "Fetching the document from the source",
+                "Converting binary content to text",
+                "Chunking, embedding, storing and indexing",
+                "Removing a deleted document"
+            ],
+            "x-enum-varnames": [
+                "DocumentStageFetch",
+                "DocumentStageExtract",
+                "DocumentStageIndex",
+                "DocumentStageDelete"
+            ]
+        },
         "github_com_custodia-labs_sercha-core_internal_core_domain.DocumentWithChunks": {
             "type": "object",
             "properties": {
@@ -2688,6 +3482,120 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_custodia-labs_sercha-core_internal_core_domain.DryRun": {
+            "type": "object",
+            "properties": {
+                "chunks": {
+                    "type": "integer"
+                },
+                "completed_at": {
+                    "type": "string"
+                },
+                "documents": {
+                    "description": "Documents that would be indexed",
+                    "type": "integer"
+                },
+                "duration_seconds": {
+                    "type": "number"
+                },
+                "embedding_tokens": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "errors": {
+                    "description": "First MaxSyncRunErrors errors",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.SyncRunError"
+                    }
+                },
+                "excluded": {
+                    "description": "Documents excluded by the source's rules",
+                    "type": "integer"
+                },
+                "failed": {
+                    "description": "Documents whose content could not be extracted",
+                    "type": "integer"
+                },
+                "id": {
+                    "description": "ID of the dry-run task",
+                    "type": "string"
+                },
+                "mime_types": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.DryRunMimeTypeStats"
+                    }
+                },
+                "samples": {
+                    "description": "First MaxDryRunSamples documents",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.DryRunSample"
+                    }
+                },
+                "source_id": {
+                    "type": "string"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "running, completed or failed",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.SyncStatus"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_custodia-labs_sercha-core_internal_core_domain.DryRunMimeTypeStats": {
+            "type": "object",
+            "properties": {
+                "chunks": {
+                    "type": "integer"
+                },
+                "documents": {
+                    "type": "integer"
+                },
+                "embedding_tokens": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_custodia-labs_sercha-core_internal_core_domain.DryRunSample": {
+            "type": "object",
+            "properties": {
+                "chunks": {
+                    "type": "integer"
+                },
+                "container_id": {
+                    "type": "string"
+                },
+                "embedding_tokens": {
+                    "type": "integer"
+                },
+                "excerpt": {
+                    "description": "Start of the normalised content",
+                    "type": "string"
+                },
+                "external_id": {
+                    "type": "string"
+                },
+                "mime_type": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
         "github_com_custodia-labs_sercha-core_internal_core_domain.InstallationSummary": {
             "type": "object",
             "properties": {
@@ -2728,20 +3636,61 @@ const docTemplate = `{
                 }
             }
         },
-        "github_com_custodia-labs_sercha-core_internal_core_domain.LoginResponse": {
+        "github_com_custodia-labs_sercha-core_internal_core_domain.LoginResponse": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "type": "string"
+                },
+                "refresh_token": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.UserSummary"
+                }
+            }
+        },
+        "github_com_custodia-labs_sercha-core_internal_core_domain.MetadataOperator": {
+            "type": "string",
+            "enum": [
+                "equals",
+                "not_equals",
+                "exists",
+                "not_exists",
+                "matches"
+            ],
+            "x-enum-comments": {
+                "MetadataMatches": "Value is a regular expression"
+            },
+            "x-enum-descriptions": [
+                "",
+                "",
+                "",
+                "",
+                "Value is a regular expression"
+            ],
+            "x-enum-varnames": [
+                "MetadataEquals",
+                "MetadataNotEquals",
+                "MetadataExists",
+                "MetadataNotExists",
+                "MetadataMatches"
+            ]
+        },
+        "github_com_custodia-labs_sercha-core_internal_core_domain.MetadataRule": {
             "type": "object",
             "properties": {
-                "expires_at": {
+                "key": {
                     "type": "string"
                 },
-                "refresh_token": {
-                    "type": "string"
+                "operator": {
+                    "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.MetadataOperator"
                 },
-                "token": {
+                "value": {
                     "type": "string"
-                },
-                "user": {
-                    "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.UserSummary"
                 }
             }
         },
@@ -2763,6 +3712,7 @@ const docTemplate = `{
                 "dropbox",
                 "onedrive",
                 "s3",
+                "localfs",
                 "zendesk",
                 "intercom"
             ],
@@ -2782,6 +3732,7 @@ const docTemplate = `{
                 "ProviderTypeDropbox",
                 "ProviderTypeOneDrive",
                 "ProviderTypeS3",
+                "ProviderTypeLocalFS",
                 "ProviderTypeZendesk",
                 "ProviderTypeIntercom"
             ]
@@ -2838,6 +3789,62 @@ const docTemplate = `{
                 "RoleViewer"
             ]
         },
+        "github_com_custodia-labs_sercha-core_internal_core_domain.RulesPreview": {
+            "type": "object",
+            "properties": {
+                "added": {
+                    "description": "Added are documents the current rules exclude but the proposed rules\nindex, and Removed the reverse. The lists are capped; the counts are\nthe totals.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.RulesPreviewDocument"
+                    }
+                },
+                "added_count": {
+                    "type": "integer"
+                },
+                "included": {
+                    "description": "Documents the proposed rules index",
+                    "type": "integer"
+                },
+                "removed": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.RulesPreviewDocument"
+                    }
+                },
+                "removed_count": {
+                    "type": "integer"
+                },
+                "scanned": {
+                    "description": "Documents enumerated",
+                    "type": "integer"
+                },
+                "source_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_custodia-labs_sercha-core_internal_core_domain.RulesPreviewDocument": {
+            "type": "object",
+            "properties": {
+                "container_id": {
+                    "type": "string"
+                },
+                "external_id": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "Why the excluding rules exclude it",
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
         "github_com_custodia-labs_sercha-core_internal_core_domain.SearchMode": {
             "type": "string",
             "enum": [
@@ -2919,149 +3926,373 @@ const docTemplate = `{
                 "team_id": {
                     "type": "string"
                 },
-                "updated_at": {
-                    "description": "Metadata",
+                "updated_at": {
+                    "description": "Metadata",
+                    "type": "string"
+                },
+                "updated_by": {
+                    "description": "User ID",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_custodia-labs_sercha-core_internal_core_domain.Source": {
+            "type": "object",
+            "properties": {
+                "config": {
+                    "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.SourceConfig"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "User ID of creator",
+                    "type": "string"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "installation_id": {
+                    "description": "InstallationID references the connector installation for authentication",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "provider_type": {
+                    "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.ProviderType"
+                },
+                "selected_containers": {
+                    "description": "SelectedContainers lists the container IDs to index\nEmpty means index all accessible containers\nExamples: [\"owner/repo1\", \"owner/repo2\"] for GitHub",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "sync_schedule": {
+                    "description": "SyncSchedule is when the source is synced automatically, as an\ninterval (\"5m\") or cron expression (\"0 2 * * *\"); see ParseSchedule.\nEmpty uses the team's SyncIntervalMinutes setting.",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_custodia-labs_sercha-core_internal_core_domain.SourceConfig": {
+            "type": "object",
+            "properties": {
+                "base_url": {
+                    "description": "Generic",
+                    "type": "string"
+                },
+                "branch": {
+                    "type": "string"
+                },
+                "channels": {
+                    "description": "Slack",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "credential_id": {
+                    "description": "Common fields",
+                    "type": "string"
+                },
+                "database_ids": {
+                    "description": "Notion",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "extra": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "folder_ids": {
+                    "description": "Google Drive",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "jql": {
+                    "type": "string"
+                },
+                "owner": {
+                    "description": "GitHub/GitLab",
+                    "type": "string"
+                },
+                "page_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "paths": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "project_keys": {
+                    "description": "Jira",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "repository": {
+                    "type": "string"
+                },
+                "rules": {
+                    "description": "Rules decide which documents are indexed, whatever the provider",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.SourceRules"
+                        }
+                    ]
+                },
+                "space_keys": {
+                    "description": "Confluence",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_custodia-labs_sercha-core_internal_core_domain.SourceRules": {
+            "type": "object",
+            "properties": {
+                "exclude_mime_types": {
+                    "description": "ExcludeMimeTypes are MIME types never indexed",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "exclude_paths": {
+                    "description": "ExcludePaths are glob patterns of paths never indexed, taking\nprecedence over IncludePaths",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "exclude_title_pattern": {
+                    "description": "ExcludeTitlePattern is a regular expression of titles never indexed",
+                    "type": "string"
+                },
+                "include_mime_types": {
+                    "description": "IncludeMimeTypes are the MIME types to index, where \"text/*\" matches\na whole type; empty indexes every MIME type",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "include_paths": {
+                    "description": "IncludePaths are glob patterns of the paths to index; empty indexes\nevery path. \"*\" matches within a path segment and \"**\" across\nsegments; a pattern without \"/\" matches any file or directory name.\nA document's path is its \"file_path\" metadata if set, else its Path.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "max_age_days": {
+                    "description": "MaxAgeDays excludes documents last modified in the source more than\nthis many days ago (0 for no limit). Documents without a modification\ntime pass.",
+                    "type": "integer"
+                },
+                "max_size_bytes": {
+                    "description": "MaxSizeBytes is the largest document indexed (0 for no limit). The\nsize is the connector's \"size\" metadata, or else the content length.",
+                    "type": "integer"
+                },
+                "metadata": {
+                    "description": "Metadata are predicates on the document metadata that must all hold",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.MetadataRule"
+                    }
+                },
+                "title_pattern": {
+                    "description": "TitlePattern is a regular expression titles must match",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_custodia-labs_sercha-core_internal_core_domain.SourceSummary": {
+            "type": "object",
+            "properties": {
+                "document_count": {
+                    "type": "integer"
+                },
+                "last_sync_at": {
+                    "type": "string"
+                },
+                "source": {
+                    "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.Source"
+                },
+                "sync_status": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_custodia-labs_sercha-core_internal_core_domain.SummaryEvent": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "results": {
+                    "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.SearchResult"
+                },
+                "summary": {
+                    "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.Answer"
+                },
+                "token": {
                     "type": "string"
                 },
-                "updated_by": {
-                    "description": "User ID",
-                    "type": "string"
+                "type": {
+                    "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.SummaryEventType"
                 }
             }
         },
-        "github_com_custodia-labs_sercha-core_internal_core_domain.Source": {
+        "github_com_custodia-labs_sercha-core_internal_core_domain.SummaryEventType": {
+            "type": "string",
+            "enum": [
+                "results",
+                "token",
+                "summary",
+                "error"
+            ],
+            "x-enum-comments": {
+                "SummaryEventError": "Summary could not be generated",
+                "SummaryEventResults": "Search hits, sent first",
+                "SummaryEventSummary": "Final summary with citations",
+                "SummaryEventToken": "Incremental summary text"
+            },
+            "x-enum-descriptions": [
+                "Search hits, sent first",
+                "Incremental summary text",
+                "Final summary with citations",
+                "Summary could not be generated"
+            ],
+            "x-enum-varnames": [
+                "SummaryEventResults",
+                "SummaryEventToken",
+                "SummaryEventSummary",
+                "SummaryEventError"
+            ]
+        },
+        "github_com_custodia-labs_sercha-core_internal_core_domain.SyncCheckpoint": {
             "type": "object",
             "properties": {
-                "config": {
-                    "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.SourceConfig"
-                },
-                "created_at": {
-                    "type": "string"
+                "completed_containers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "created_by": {
-                    "description": "User ID of creator",
+                "cursor": {
+                    "description": "Cursor the sync started from",
                     "type": "string"
                 },
-                "enabled": {
+                "full": {
                     "type": "boolean"
                 },
-                "id": {
-                    "type": "string"
-                },
-                "installation_id": {
-                    "description": "InstallationID references the connector installation for authentication",
-                    "type": "string"
-                },
-                "name": {
+                "next_cursor": {
+                    "description": "Cursor returned by completed containers",
                     "type": "string"
                 },
-                "provider_type": {
-                    "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.ProviderType"
-                },
-                "selected_containers": {
-                    "description": "SelectedContainers lists the container IDs to index\nEmpty means index all accessible containers\nExamples: [\"owner/repo1\", \"owner/repo2\"] for GitHub",
-                    "type": "array",
-                    "items": {
+                "page_tokens": {
+                    "description": "Next page of each container in progress",
+                    "type": "object",
+                    "additionalProperties": {
                         "type": "string"
                     }
                 },
+                "stats": {
+                    "description": "Stats up to the checkpoint",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.SyncStats"
+                        }
+                    ]
+                },
                 "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "github_com_custodia-labs_sercha-core_internal_core_domain.SourceConfig": {
+        "github_com_custodia-labs_sercha-core_internal_core_domain.SyncRun": {
             "type": "object",
             "properties": {
-                "base_url": {
-                    "description": "Generic",
-                    "type": "string"
-                },
-                "branch": {
+                "completed_at": {
                     "type": "string"
                 },
-                "channels": {
-                    "description": "Slack",
+                "containers": {
+                    "description": "Empty when the whole source is synced",
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
-                "credential_id": {
-                    "description": "Common fields",
+                "duration_seconds": {
+                    "type": "number"
+                },
+                "error": {
                     "type": "string"
                 },
-                "database_ids": {
-                    "description": "Notion",
+                "errors": {
+                    "description": "First MaxSyncRunErrors errors",
                     "type": "array",
                     "items": {
-                        "type": "string"
-                    }
-                },
-                "extra": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
+                        "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.SyncRunError"
                     }
                 },
-                "folder_ids": {
-                    "description": "Google Drive",
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "full": {
+                    "type": "boolean"
                 },
-                "jql": {
+                "id": {
                     "type": "string"
                 },
-                "owner": {
-                    "description": "GitHub/GitLab",
+                "source_id": {
                     "type": "string"
                 },
-                "page_ids": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "paths": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "started_at": {
+                    "type": "string"
                 },
-                "project_keys": {
-                    "description": "Jira",
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "stats": {
+                    "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.SyncStats"
                 },
-                "repository": {
-                    "type": "string"
+                "status": {
+                    "description": "completed, failed or cancelled",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.SyncStatus"
+                        }
+                    ]
                 },
-                "space_keys": {
-                    "description": "Confluence",
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "trigger": {
+                    "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.SyncTrigger"
                 }
             }
         },
-        "github_com_custodia-labs_sercha-core_internal_core_domain.SourceSummary": {
+        "github_com_custodia-labs_sercha-core_internal_core_domain.SyncRunError": {
             "type": "object",
             "properties": {
-                "document_count": {
-                    "type": "integer"
-                },
-                "last_sync_at": {
+                "container_id": {
                     "type": "string"
                 },
-                "source": {
-                    "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.Source"
+                "error": {
+                    "type": "string"
                 },
-                "sync_status": {
+                "external_id": {
+                    "description": "Empty for errors not tied to a document",
                     "type": "string"
                 }
             }
@@ -3069,6 +4300,14 @@ const docTemplate = `{
         "github_com_custodia-labs_sercha-core_internal_core_domain.SyncState": {
             "type": "object",
             "properties": {
+                "checkpoint": {
+                    "description": "Checkpoint is the progress of an unfinished sync, which the next sync\nresumes from. Nil once a sync finishes.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.SyncCheckpoint"
+                        }
+                    ]
+                },
                 "completed_at": {
                     "type": "string"
                 },
@@ -3111,6 +4350,14 @@ const docTemplate = `{
                 "documents_deleted": {
                     "type": "integer"
                 },
+                "documents_excluded": {
+                    "description": "Excluded by the source's rules",
+                    "type": "integer"
+                },
+                "documents_skipped": {
+                    "description": "Unchanged since last indexed",
+                    "type": "integer"
+                },
                 "documents_updated": {
                     "type": "integer"
                 },
@@ -3125,13 +4372,41 @@ const docTemplate = `{
                 "idle",
                 "running",
                 "completed",
-                "failed"
+                "failed",
+                "cancelling",
+                "cancelled"
             ],
             "x-enum-varnames": [
                 "SyncStatusIdle",
                 "SyncStatusRunning",
                 "SyncStatusCompleted",
-                "SyncStatusFailed"
+                "SyncStatusFailed",
+                "SyncStatusCancelling",
+                "SyncStatusCancelled"
+            ]
+        },
+        "github_com_custodia-labs_sercha-core_internal_core_domain.SyncTrigger": {
+            "type": "string",
+            "enum": [
+                "manual",
+                "scheduled",
+                "webhook",
+                "retry"
+            ],
+            "x-enum-comments": {
+                "SyncTriggerRetry": "Retry of failed documents"
+            },
+            "x-enum-descriptions": [
+                "",
+                "",
+                "",
+                "Retry of failed documents"
+            ],
+            "x-enum-varnames": [
+                "SyncTriggerManual",
+                "SyncTriggerScheduled",
+                "SyncTriggerWebhook",
+                "SyncTriggerRetry"
             ]
         },
         "github_com_custodia-labs_sercha-core_internal_core_domain.User": {
@@ -3436,6 +4711,31 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_custodia-labs_sercha-core_internal_core_ports_driving.CreateInstallationRequest": {
+            "description": "Request to create an installation for API key or path-based connectors",
+            "type": "object",
+            "properties": {
+                "api_key": {
+                    "description": "APIKey is the authentication credential (or path for localfs).",
+                    "type": "string",
+                    "example": "/data/test-docs"
+                },
+                "name": {
+                    "description": "Name is a human-readable name for the installation.",
+                    "type": "string",
+                    "example": "Local Test Docs"
+                },
+                "provider_type": {
+                    "description": "ProviderType is the data source provider.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.ProviderType"
+                        }
+                    ],
+                    "example": "localfs"
+                }
+            }
+        },
         "github_com_custodia-labs_sercha-core_internal_core_ports_driving.CreateSourceRequest": {
             "type": "object",
             "properties": {
@@ -3450,6 +4750,15 @@ const docTemplate = `{
                 },
                 "provider_type": {
                     "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.ProviderType"
+                },
+                "selected_containers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "sync_schedule": {
+                    "type": "string"
                 }
             }
         },
@@ -3663,6 +4972,10 @@ const docTemplate = `{
                 },
                 "name": {
                     "type": "string"
+                },
+                "sync_schedule": {
+                    "description": "SyncSchedule replaces the sync schedule; an empty string reverts to the team default",
+                    "type": "string"
                 }
             }
         },
@@ -3800,6 +5113,27 @@ const docTemplate = `{
                 }
             }
         },
+        "internal_adapters_driving_http.DocumentErrorsResponse": {
+            "description": "Paginated list of documents that failed to sync, most recently failed first",
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.DocumentError"
+                    }
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
         "internal_adapters_driving_http.DocumentStats": {
             "type": "object",
             "properties": {
@@ -3842,6 +5176,15 @@ const docTemplate = `{
                 }
             }
         },
+        "internal_adapters_driving_http.PreviewRulesRequest": {
+            "description": "Proposed rules for a source; null previews removing its rules",
+            "type": "object",
+            "properties": {
+                "rules": {
+                    "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.SourceRules"
+                }
+            }
+        },
         "internal_adapters_driving_http.SourceDocumentsResponse": {
             "description": "Paginated list of documents belonging to a source",
             "type": "object",
@@ -3893,8 +5236,34 @@ const docTemplate = `{
                     "example": "src_abc123"
                 },
                 "status": {
+                    "description": "accepted, or coalesced when a pending sync of the source already covers the request",
                     "type": "string",
                     "example": "accepted"
+                },
+                "task_id": {
+                    "type": "string",
+                    "example": "task_abc123"
+                }
+            }
+        },
+        "internal_adapters_driving_http.SyncRunsResponse": {
+            "description": "Paginated sync history of a source, newest first",
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "runs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.SyncRun"
+                    }
+                },
+                "total": {
+                    "type": "integer"
                 }
             }
         },
@@ -3974,6 +5343,39 @@ const docTemplate = `{
                 }
             }
         },
+        "internal_adapters_driving_http.answerRequest": {
+            "description": "Question answering request",
+            "type": "object",
+            "properties": {
+                "max_chunks": {
+                    "type": "integer",
+                    "example": 8
+                },
+                "mode": {
+                    "enum": [
+                        "hybrid",
+                        "text",
+                        "semantic"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_custodia-labs_sercha-core_internal_core_domain.SearchMode"
+                        }
+                    ],
+                    "example": "hybrid"
+                },
+                "query": {
+                    "type": "string",
+                    "example": "how do I rotate the API signing key?"
+                },
+                "source_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "internal_adapters_driving_http.searchRequest": {
             "description": "Search query request",
             "type": "object",